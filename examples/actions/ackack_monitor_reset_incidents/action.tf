@@ -0,0 +1,7 @@
+# Resolve the monitor's open incidents once an outage is fixed:
+#   terraform apply -invoke=action.ackack_monitor_reset_incidents.checkout
+action "ackack_monitor_reset_incidents" "checkout" {
+  config {
+    monitor_id = ackack_monitor.checkout.id
+  }
+}
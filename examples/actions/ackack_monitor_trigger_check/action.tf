@@ -0,0 +1,22 @@
+action "ackack_monitor_trigger_check" "api" {
+  config {
+    monitor_id = ackack_monitor.api.id
+    require_up = true
+  }
+}
+
+# Check the monitor after every change to it, instead of waiting for its
+# next scheduled check. Run it by hand with:
+#   terraform apply -invoke=action.ackack_monitor_trigger_check.api
+resource "ackack_monitor" "api" {
+  name = "API"
+  type = "http"
+  url  = "https://api.example.com/health"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.ackack_monitor_trigger_check.api]
+    }
+  }
+}
@@ -0,0 +1,12 @@
+data "ackack_account" "current" {}
+
+resource "ackack_monitor" "website" {
+  name              = "Website"
+  type              = "http"
+  url               = "https://example.com"
+  frequency_seconds = max(30, data.ackack_account.current.min_frequency_seconds)
+}
+
+output "remaining_monitors" {
+  value = data.ackack_account.current.remaining_monitors
+}
@@ -0,0 +1,8 @@
+data "ackack_account_health" "current" {}
+
+check "check_capacity" {
+  assert {
+    condition     = data.ackack_account_health.current.in_flight_headroom > 5
+    error_message = "Fewer than 5 in-flight checks left before the plan limit is reached."
+  }
+}
@@ -0,0 +1,10 @@
+# Deploys that landed during an incident window
+data "ackack_annotations" "incident_window" {
+  monitor_id = ackack_monitor.website.id
+  since      = "2026-01-15T09:00:00Z"
+  until      = "2026-01-15T10:30:00Z"
+}
+
+output "deploys_during_incident" {
+  value = [for a in data.ackack_annotations.incident_window.annotations : a.text]
+}
@@ -0,0 +1,13 @@
+# Monitor edits made in the dashboard or by other API clients
+data "ackack_change_feed" "out_of_band" {
+  since             = "2026-01-15T00:00:00Z"
+  resource_type     = "monitor"
+  exclude_terraform = true
+}
+
+output "out_of_band_edits" {
+  value = [
+    for c in data.ackack_change_feed.out_of_band.changes :
+    "${c.timestamp} ${coalesce(c.actor, "unknown")} ${c.action} ${c.resource_type} ${c.resource_id}"
+  ]
+}
@@ -0,0 +1,11 @@
+data "ackack_coverage" "current" {
+  system_priorities = ["critical", "high"]
+}
+
+# Fail the run when a monitor or critical system has no paging.
+check "alert_coverage" {
+  assert {
+    condition     = data.ackack_coverage.current.fully_covered
+    error_message = "Uncovered monitors: ${join(", ", data.ackack_coverage.current.uncovered_monitors[*].name)}; uncovered systems: ${join(", ", data.ackack_coverage.current.uncovered_systems[*].name)}"
+  }
+}
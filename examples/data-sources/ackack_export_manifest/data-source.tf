@@ -0,0 +1,12 @@
+data "ackack_export_manifest" "all" {}
+
+# Push the manifest to the CMDB whenever it changes.
+data "http" "cmdb_sync" {
+  url    = "https://cmdb.example.com/api/uptime-checks/sync"
+  method = "PUT"
+
+  request_headers = {
+    Content-Type = "application/json"
+  }
+  request_body = data.ackack_export_manifest.all.json
+}
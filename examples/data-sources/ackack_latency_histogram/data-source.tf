@@ -0,0 +1,16 @@
+# Share of checks in the last week that met a 300 ms latency objective
+data "ackack_latency_histogram" "api" {
+  monitor_id       = ackack_monitor.api.id
+  hours            = 168
+  bucket_bounds_ms = [100, 300, 1000]
+}
+
+locals {
+  within_objective = one([
+    for b in data.ackack_latency_histogram.api.buckets : b.cumulative_count if b.upper_bound_ms == 300
+  ])
+}
+
+output "latency_objective_ratio" {
+  value = data.ackack_latency_histogram.api.total_count == 0 ? 1 : local.within_objective / data.ackack_latency_histogram.api.total_count
+}
@@ -0,0 +1,21 @@
+data "ackack_monitor_badge" "website" {
+  monitor_id = ackack_monitor.website.id
+  style      = "flat-square"
+}
+
+data "ackack_monitor_badge" "checkout" {
+  system_id   = ackack_system.checkout.id
+  include_svg = true
+}
+
+output "readme_badges" {
+  value = join(" ", [
+    data.ackack_monitor_badge.website.markdown,
+    data.ackack_monitor_badge.checkout.markdown,
+  ])
+}
+
+resource "local_file" "checkout_badge" {
+  filename = "${path.module}/checkout-badge.svg"
+  content  = data.ackack_monitor_badge.checkout.svg
+}
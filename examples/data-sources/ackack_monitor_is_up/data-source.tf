@@ -0,0 +1,13 @@
+# Warn after every plan and apply when a dependency is unhealthy.
+# Failed assertions in check blocks are reported as warnings and never block the run.
+check "payments_api_is_up" {
+  data "ackack_monitor_is_up" "payments_api" {
+    monitor_id     = "mon_abc123"
+    allow_degraded = true
+  }
+
+  assert {
+    condition     = data.ackack_monitor_is_up.payments_api.is_up
+    error_message = data.ackack_monitor_is_up.payments_api.message
+  }
+}
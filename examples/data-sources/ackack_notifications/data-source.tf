@@ -0,0 +1,22 @@
+# Fetch up to 20 pages of notification history
+data "ackack_notifications" "recent" {
+  page_size = 100
+  all_pages = true
+  max_pages = 20
+}
+
+output "failed_notifications" {
+  value = [for n in data.ackack_notifications.recent.notifications : n.destination if n.status == "failed"]
+}
+
+# Audit failed deliveries for one monitor since a given date
+data "ackack_notifications" "website_failures" {
+  monitor_id = ackack_monitor.website.id
+  status     = "failed"
+  since      = "2026-09-01T00:00:00Z"
+  all_pages  = true
+}
+
+output "website_failed_destinations" {
+  value = distinct([for n in data.ackack_notifications.website_failures.notifications : n.destination])
+}
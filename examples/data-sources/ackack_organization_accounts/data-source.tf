@@ -0,0 +1,28 @@
+# The default provider uses an organization-level API key.
+data "ackack_organization_accounts" "all" {}
+
+output "fleet_summary" {
+  value = {
+    for a in data.ackack_organization_accounts.all.accounts :
+    a.name => "${a.monitors_down}/${a.monitor_count} down, ${a.open_incidents} open incidents"
+  }
+}
+
+output "total_monitors_down" {
+  value = data.ackack_organization_accounts.all.total_monitors_down
+}
+
+# Resources inside a child account are managed through a provider alias
+# configured with that account's API key.
+provider "ackack" {
+  alias   = "customer_a"
+  api_key = var.customer_a_api_key
+}
+
+resource "ackack_monitor" "customer_a_website" {
+  provider = ackack.customer_a
+
+  name = "Customer A Website"
+  type = "http"
+  url  = "https://customer-a.example.com"
+}
@@ -0,0 +1,19 @@
+# Probe the API right after a deployment instead of waiting for the next
+# scheduled check. The apply fails if any region reports an error.
+ephemeral "ackack_monitor_check" "post_deploy" {
+  monitor_id = ackack_monitor.api.id
+  require_up = true
+}
+
+# Or check a single region and inspect the results yourself.
+ephemeral "ackack_monitor_check" "eu" {
+  monitor_id = ackack_monitor.api.id
+  region     = "eu-west"
+
+  lifecycle {
+    postcondition {
+      condition     = alltrue([for r in self.results : r.response_time < 500])
+      error_message = "The API responded in 500ms or more."
+    }
+  }
+}
@@ -0,0 +1,18 @@
+data "ackack_monitor_results" "recent" {
+  monitor_id = ackack_monitor.checkout.id
+  limit      = 20
+}
+
+locals {
+  last_failure = [for r in data.ackack_monitor_results.recent.results : r if r.error_type != null][0]
+}
+
+# The signed URL is never written to state; pass it to something that
+# consumes ephemeral values, such as a provider configuration or a
+# write-only attribute.
+ephemeral "ackack_result_artifact" "screenshot" {
+  monitor_id  = ackack_monitor.checkout.id
+  result_id   = local.last_failure.id
+  kind        = "screenshot"
+  ttl_seconds = 900
+}
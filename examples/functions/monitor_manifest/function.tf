@@ -0,0 +1,17 @@
+resource "ackack_monitor" "website" {
+  name = "Website"
+  type = "http"
+  url  = "https://example.com"
+}
+
+output "website_manifest" {
+  value = provider::ackack::monitor_manifest(ackack_monitor.website)
+}
+
+# Decode the manifest to check monitoring standards in configuration.
+check "website_frequency" {
+  assert {
+    condition     = jsondecode(provider::ackack::monitor_manifest(ackack_monitor.website)).frequency_seconds <= 300
+    error_message = "Production monitors must run at least every 5 minutes."
+  }
+}
@@ -0,0 +1,16 @@
+variable "api_version" {
+  type    = string
+  default = "2024-06-01"
+}
+
+# The same headers always render the same JSON, however they are written.
+resource "ackack_monitor" "api" {
+  name = "API"
+  type = "http"
+  url  = "https://api.example.com/health"
+
+  headers = provider::ackack::normalize_headers({
+    "accept"        = "application/json"
+    "x-api-version" = var.api_version
+  })
+}
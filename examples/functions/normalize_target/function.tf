@@ -0,0 +1,13 @@
+variable "oncall_emails" {
+  type    = list(string)
+  default = ["Ops@Example.com", "sre@example.com "]
+}
+
+# Keys stay stable however the addresses are capitalized or padded.
+resource "ackack_alert" "oncall" {
+  for_each = toset([for email in var.oncall_emails : provider::ackack::normalize_target("email", email)])
+
+  monitor_id = ackack_monitor.website.id
+  type       = "email"
+  target     = each.value
+}
@@ -0,0 +1,19 @@
+variable "sla" {
+  type    = string
+  default = "99.95"
+}
+
+resource "ackack_slo" "api" {
+  name              = "API availability"
+  monitor_id        = ackack_monitor.api.id
+  target_percentage = var.sla
+  window_days       = 30
+
+  lifecycle {
+    postcondition {
+      # Alert before more than half of the allowed downtime is used.
+      condition     = self.error_budget_remaining_minutes > provider::ackack::sla_to_downtime(var.sla, 30) / 2
+      error_message = "The API has used more than half of its error budget."
+    }
+  }
+}
@@ -0,0 +1,14 @@
+variable "report_schedule" {
+  type    = string
+  default = "0 9 * * MON"
+
+  validation {
+    condition     = can(provider::ackack::validate_cron(var.report_schedule))
+    error_message = "The report schedule must be a valid five-field cron expression."
+  }
+}
+
+# "0 9 * * 1", however the schedule was written.
+output "report_schedule" {
+  value = provider::ackack::validate_cron(var.report_schedule)
+}
@@ -1,7 +1,28 @@
 provider "ackack" {
   # API key can be set via ACKACK_API_KEY environment variable
   # api_key = "ak_your_api_key"
+  # or from an ephemeral variable, which is never written to state or plan files:
+  # api_key = var.ackack_api_key
 
   # Endpoint defaults to https://api.ackack.io
   # endpoint = "https://api.ackack.io"
+
+  # Fail any plan that would destroy more than 20 monitors
+  # max_destroy = 20
+
+  # Send monitors applied at the same time in bulk requests, for large fleets
+  # batch_requests = true
+
+  # Fail requests instead of waiting more than 2 minutes for the rate limit to reset
+  # max_rate_limit_wait_seconds = 120
+
+  # Only allow webhook, Slack and Discord alerts to these domains
+  # allowed_webhook_domains = ["hooks.slack.com", "discord.com", "example.com"]
+
+  # Alert settings for alerts that do not set them
+  # alert_defaults = {
+  #   trigger_threshold    = 3
+  #   recovery_threshold   = 2
+  #   min_interval_minutes = 30
+  # }
 }
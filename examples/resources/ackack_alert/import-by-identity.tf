@@ -0,0 +1,9 @@
+# Import an existing alert with an import block, then run
+#   terraform plan -generate-config-out=generated.tf
+# to generate its configuration.
+import {
+  to = ackack_alert.email
+  identity = {
+    id = "alt_abc123"
+  }
+}
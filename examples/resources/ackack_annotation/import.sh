@@ -0,0 +1 @@
+terraform import ackack_annotation.deploy ann_abc123
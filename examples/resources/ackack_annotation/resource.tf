@@ -0,0 +1,6 @@
+# Deploy marker created by a release pipeline
+resource "ackack_annotation" "deploy" {
+  monitor_id = ackack_monitor.website.id
+  text       = "Deployed v${var.app_version}"
+  link       = "https://github.com/example/app/releases/tag/v${var.app_version}"
+}
@@ -0,0 +1 @@
+terraform import ackack_artifacts_bucket.main ab_abc123
@@ -0,0 +1,23 @@
+variable "artifacts_access_key_id" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+variable "artifacts_secret_access_key" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+# Store failure screenshots and HAR files in your own bucket
+resource "ackack_artifacts_bucket" "main" {
+  bucket                  = "acme-monitoring-artifacts"
+  region                  = "eu-west-1"
+  path_prefix             = "ackack/"
+  artifact_retention_days = 30
+
+  access_key_id_wo       = var.artifacts_access_key_id
+  secret_access_key_wo   = var.artifacts_secret_access_key
+  credentials_wo_version = 1
+}
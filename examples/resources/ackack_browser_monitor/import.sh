@@ -0,0 +1 @@
+terraform import ackack_browser_monitor.login bm_abc123
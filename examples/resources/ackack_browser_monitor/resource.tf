@@ -0,0 +1,29 @@
+# A login journey written as steps
+resource "ackack_browser_monitor" "login" {
+  name      = "Login journey"
+  start_url = "https://app.example.com/login"
+
+  steps = [
+    { action = "fill", selector = "#email", value = "synthetic@example.com" },
+    { action = "fill", selector = "#password", value = var.synthetic_password },
+    { action = "click", selector = "button[type=submit]" },
+    { action = "assert_visible", selector = "[data-test=dashboard]", timeout_ms = 10000 },
+  ]
+
+  viewport = {
+    width  = 1280
+    height = 800
+  }
+
+  capture_har             = true
+  artifact_retention_days = 14
+}
+
+# A checkout flow exported from the recorder, run on a mobile device
+resource "ackack_browser_monitor" "checkout_mobile" {
+  name              = "Checkout (mobile)"
+  start_url         = "https://shop.example.com"
+  recording_json    = file("${path.module}/checkout.recording.json")
+  device            = "iPhone 15"
+  frequency_seconds = 600
+}
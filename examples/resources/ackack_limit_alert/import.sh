@@ -0,0 +1 @@
+terraform import ackack_limit_alert.capacity la_abc123
@@ -0,0 +1,7 @@
+# Tell the platform team when checks start queueing or the plan is full
+resource "ackack_limit_alert" "capacity" {
+  type                 = "slack"
+  target               = "https://hooks.slack.com/services/T000/B000/XXXX"
+  events               = ["in_flight_limit", "monitor_quota"]
+  min_interval_minutes = 60
+}
@@ -0,0 +1 @@
+terraform import ackack_maintenance_window.database_upgrade mw_abc123
@@ -0,0 +1,14 @@
+resource "ackack_maintenance_window" "database_upgrade" {
+  name        = "Database upgrade"
+  description = "Postgres 17 upgrade"
+  start_time  = "2026-11-07T02:00:00Z"
+  end_time    = "2026-11-07T04:00:00Z"
+
+  system_ids  = [ackack_system.production.id]
+  monitor_ids = [ackack_monitor.status_page.id]
+}
+
+# Review every monitor the window will silence
+output "maintenance_blast_radius" {
+  value = ackack_maintenance_window.database_upgrade.effective_monitor_ids
+}
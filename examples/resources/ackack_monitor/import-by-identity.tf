@@ -0,0 +1,9 @@
+# Import an existing monitor with an import block, then run
+#   terraform plan -generate-config-out=generated.tf
+# to generate its configuration.
+import {
+  to = ackack_monitor.website
+  identity = {
+    id = "mon_abc123"
+  }
+}
@@ -9,6 +9,113 @@ resource "ackack_monitor" "website" {
 
   validate_status      = true
   expected_status_code = 200
+
+  # Only alert when at least two regions see the site down
+  regions             = ["us-east", "eu-west", "asia-southeast"]
+  min_regions_failing = 2
+
+  # Refuse to destroy the monitor while an outage is still open
+  prevent_destroy_with_open_incidents = true
+
+  # Fail the apply unless at least two regions pass the first check
+  wait_for_first_check = {
+    timeout_seconds    = 180
+    max_failed_regions = 1
+  }
+
+  # Catch slow TLS handshakes that the total latency hides
+  phase_thresholds = {
+    tls_ms  = 300
+    ttfb_ms = 800
+  }
+
+  # Stricter thresholds during business hours
+  threshold_window {
+    days       = ["mon", "tue", "wed", "thu", "fri"]
+    start_time = "09:00"
+    end_time   = "17:00"
+    timezone   = "Europe/Berlin"
+    timeout_ms = 3000
+
+    phase_thresholds = {
+      tls_ms  = 150
+      ttfb_ms = 400
+    }
+  }
+}
+
+# HTTP Monitor that fails when an error string appears in the page
+resource "ackack_monitor" "no_stack_traces" {
+  name              = "No Stack Traces"
+  type              = "http"
+  url               = "https://example.com/checkout"
+  frequency_seconds = 60
+
+  validate_body     = true
+  body_pattern      = "stack trace"
+  body_pattern_mode = "not_contains"
+}
+
+# HTTP Monitor that validates the redirect itself instead of following it
+resource "ackack_monitor" "www_redirect" {
+  name             = "www redirect"
+  type             = "http"
+  url              = "http://www.example.com"
+  follow_redirects = false
+
+  validate_status      = true
+  expected_status_code = 301
+}
+
+# HTTP Monitor probing the green origin directly, bypassing the CDN, with the
+# production Host header
+resource "ackack_monitor" "green_origin" {
+  name          = "www (green origin)"
+  type          = "http"
+  url           = "https://green.origin.example.net/healthz"
+  resolve_to_ip = "203.0.113.20"
+  host_header   = "www.example.com"
+}
+
+# HTTP Monitor for an internal tool that is only up during office hours
+resource "ackack_monitor" "intranet" {
+  name = "Intranet"
+  type = "http"
+  url  = "https://intranet.example.com/healthz"
+
+  schedule {
+    days       = ["mon", "tue", "wed", "thu", "fri"]
+    start_time = "07:00"
+    end_time   = "19:00"
+    timezone   = "Europe/Berlin"
+  }
+}
+
+# HTTP Monitor for an endpoint that requires mutual TLS
+resource "ackack_monitor" "partner_api" {
+  name = "Partner API"
+  type = "http"
+  url  = "https://partners.example.com/healthz"
+
+  client_cert_pem = file("${path.module}/certs/probe.crt")
+  client_key_pem  = file("${path.module}/certs/probe.key")
+}
+
+# HTTP Monitor that fails when the API response breaks its contract
+resource "ackack_monitor" "orders_api" {
+  name = "Orders API contract"
+  type = "http"
+  url  = "https://api.example.com/v1/orders?limit=1"
+
+  response_schema = jsonencode({
+    "$schema" = "https://json-schema.org/draft/2020-12/schema"
+    type      = "object"
+    required  = ["orders", "next_cursor"]
+    properties = {
+      orders      = { type = "array" }
+      next_cursor = { type = ["string", "null"] }
+    }
+  })
 }
 
 # DNS Monitor
@@ -19,6 +126,45 @@ resource "ackack_monitor" "dns" {
   dns_record_type   = "A"
   expected_value    = "93.184.216.34"
   frequency_seconds = 300
+
+  # Catch a broken DNSSEC chain even while the record still resolves
+  validate_dnssec = true
+}
+
+# DNS Monitor asserting the SRV records of a SIP service
+resource "ackack_monitor" "sip_srv" {
+  name            = "SIP SRV records"
+  type            = "dns"
+  url             = "_sip._udp.example.com"
+  dns_record_type = "SRV"
+
+  expected_record {
+    value    = "sip1.example.com"
+    priority = 10
+    weight   = 60
+    port     = 5060
+  }
+
+  expected_record {
+    value    = "sip2.example.com"
+    priority = 20
+    weight   = 40
+    port     = 5060
+  }
+}
+
+# DNS Monitor making sure only Let's Encrypt may issue certificates
+resource "ackack_monitor" "caa" {
+  name            = "CAA records"
+  type            = "dns"
+  url             = "example.com"
+  dns_record_type = "CAA"
+
+  expected_record {
+    value = "letsencrypt.org"
+    flags = 0
+    tag   = "issue"
+  }
 }
 
 # SSL Monitor
@@ -29,6 +175,24 @@ resource "ackack_monitor" "ssl" {
   check_expiration_threshold = true
   expiration_threshold       = 30
   frequency_seconds          = 3600
+
+  # Catch mis-served intermediates and unexpected issuer changes
+  check_chain      = true
+  expected_issuer  = "Let's Encrypt"
+  check_revocation = true
+}
+
+# SSL Monitor for a certificate on a non-standard port behind an SNI router
+resource "ackack_monitor" "ssl_admin" {
+  name              = "Admin SSL Certificate"
+  type              = "ssl"
+  domain            = "lb.example.com"
+  port              = 8443
+  server_name       = "admin.example.com"
+  frequency_seconds = 3600
+
+  # Alert when the certificate is replaced outside the PKI pipeline
+  expected_fingerprint_sha256 = "5E:FF:56:A2:AF:15:88:25:29:D8:6A:A0:9F:0C:35:E1:4F:6A:4C:A4:81:52:2C:53:24:46:E6:29:01:88:B8:77"
 }
 
 # TCP Monitor
@@ -40,3 +204,54 @@ resource "ackack_monitor" "tcp" {
   frequency_seconds = 60
   timeout_ms        = 5000
 }
+
+# TCP Monitor of a database that is restarted during planned maintenance.
+# Checks stop while an ackack_maintenance_window covering it is active.
+resource "ackack_monitor" "postgres" {
+  name                     = "Postgres"
+  type                     = "tcp"
+  host                     = "db.example.com"
+  port                     = 5432
+  pause_during_maintenance = true
+}
+
+# TCP Monitor completing a TLS handshake with a syslog-over-TLS collector
+resource "ackack_monitor" "syslog_tls" {
+  name        = "Syslog TLS"
+  type        = "tcp"
+  host        = "10.0.4.20"
+  port        = 6514
+  use_tls     = true
+  server_name = "logs.example.com"
+}
+
+# HTTP Monitor built from URL components
+resource "ackack_monitor" "api_health" {
+  for_each = toset(["api-1.internal.example.com", "api-2.internal.example.com"])
+
+  name   = "API health ${each.key}"
+  type   = "http"
+  host   = each.key
+  port   = 8443
+  path   = "/healthz"
+  scheme = "https"
+}
+
+# HTTP Monitor that stays quiet while the database it depends on is in maintenance
+resource "ackack_monitor" "app" {
+  name = "App"
+  type = "http"
+  url  = "https://app.example.com/healthz"
+
+  depends_on_monitor_ids = [ackack_monitor.tcp.id]
+  inherit_maintenance    = true
+}
+
+# HTTP Monitor that reports alerts added in the dashboard as drift
+resource "ackack_monitor" "checkout" {
+  name = "Checkout"
+  type = "http"
+  url  = "https://shop.example.com/checkout/health"
+
+  adopt_unmanaged_alerts = false
+}
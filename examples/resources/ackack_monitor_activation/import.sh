@@ -0,0 +1 @@
+terraform import ackack_monitor_activation.api mon_abc123
@@ -0,0 +1,20 @@
+# Create the monitor disabled, so the initial apply does not page anyone
+resource "ackack_monitor" "api" {
+  name         = "API"
+  type         = "http"
+  url          = "https://api.example.com/healthz"
+  start_paused = true
+}
+
+resource "ackack_alert" "api_pager" {
+  monitor_id = ackack_monitor.api.id
+  type       = "email"
+  target     = "oncall@example.com"
+}
+
+# Enable the monitor once its alerts exist
+resource "ackack_monitor_activation" "api" {
+  monitor_id = ackack_monitor.api.id
+
+  depends_on = [ackack_alert.api_pager]
+}
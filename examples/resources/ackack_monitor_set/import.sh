@@ -0,0 +1,5 @@
+# Import a comma-separated list of monitors
+terraform import ackack_monitor_set.legacy mon_abc123,mon_def456,mon_ghi789
+
+# Import every monitor of the account
+terraform import ackack_monitor_set.everything all
@@ -0,0 +1,26 @@
+# Adopt an existing fleet of monitors with a single import
+import {
+  to = ackack_monitor_set.legacy
+  id = "mon_abc123,mon_def456,mon_ghi789"
+}
+
+resource "ackack_monitor_set" "legacy" {
+  monitor_ids = ["mon_abc123", "mon_def456", "mon_ghi789"]
+}
+
+# Adopt every monitor of the account. Run `terraform plan -generate-config-out=monitors.tf`
+# to have Terraform write the resource block with the full list of monitor IDs.
+import {
+  to = ackack_monitor_set.everything
+  id = "all"
+}
+
+# Monitors removed from a set with delete_on_destroy are deleted, not just released
+resource "ackack_monitor_set" "staging" {
+  monitor_ids       = ["mon_jkl012", "mon_mno345"]
+  delete_on_destroy = true
+}
+
+output "legacy_monitor_names" {
+  value = { for id, monitor in ackack_monitor_set.legacy.monitors : id => monitor.name }
+}
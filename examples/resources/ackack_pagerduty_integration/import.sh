@@ -0,0 +1 @@
+terraform import ackack_pagerduty_integration.platform pdi_abc123
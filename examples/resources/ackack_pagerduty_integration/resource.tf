@@ -0,0 +1,18 @@
+variable "pagerduty_routing_key" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "ackack_pagerduty_integration" "platform" {
+  name                   = "Platform on-call"
+  service_name           = "platform-production"
+  routing_key_wo         = var.pagerduty_routing_key
+  routing_key_wo_version = 1
+}
+
+resource "ackack_alert" "pagerduty" {
+  monitor_id     = ackack_monitor.website.id
+  type           = "pagerduty"
+  integration_id = ackack_pagerduty_integration.platform.id
+}
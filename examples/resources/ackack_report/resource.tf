@@ -4,6 +4,8 @@ resource "ackack_report" "monthly_uptime" {
   format      = "pdf"
   start_time  = "2024-01-01T00:00:00Z"
   end_time    = "2024-01-31T23:59:59Z"
+  locale      = "de-DE"
+  timezone    = "Europe/Berlin"
 
   monitor_ids = [
     ackack_monitor.website.id,
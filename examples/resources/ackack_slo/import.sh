@@ -0,0 +1 @@
+terraform import ackack_slo.checkout slo_abc123
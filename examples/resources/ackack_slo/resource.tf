@@ -0,0 +1,16 @@
+resource "ackack_slo" "checkout" {
+  name              = "Checkout availability"
+  system_id         = ackack_system.checkout.id
+  target_percentage = 99.9
+  window_days       = 28
+}
+
+resource "ackack_slo" "api" {
+  name              = "API uptime"
+  monitor_id        = ackack_monitor.api.id
+  target_percentage = 99.5
+}
+
+output "checkout_error_budget_minutes" {
+  value = ackack_slo.checkout.error_budget_remaining_minutes
+}
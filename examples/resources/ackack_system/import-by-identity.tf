@@ -0,0 +1,9 @@
+# Import an existing system with an import block, then run
+#   terraform plan -generate-config-out=generated.tf
+# to generate its configuration.
+import {
+  to = ackack_system.production
+  identity = {
+    id = "sys_abc123"
+  }
+}
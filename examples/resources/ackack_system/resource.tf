@@ -3,12 +3,19 @@ resource "ackack_system" "production" {
   description = "All production services"
   priority    = "critical"
 
+  # Degraded once a quarter of the monitors are unhealthy, down at half
+  degraded_threshold_percent = 25
+  down_threshold_percent     = 50
+
   monitor_ids = [
     ackack_monitor.website.id,
     ackack_monitor.dns.id,
     ackack_monitor.ssl.id,
   ]
 
+  # Members without their own alerts page through these channels
+  default_alert_channel_ids = [ackack_alert.oncall.id]
+
   external_links {
     name = "Dashboard"
     url  = "https://dashboard.example.com"
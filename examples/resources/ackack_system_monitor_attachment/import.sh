@@ -0,0 +1 @@
+terraform import ackack_system_monitor_attachment.checkout sys_abc123/mon_abc123
@@ -0,0 +1,10 @@
+# An empty system shell, with membership managed by attachments
+resource "ackack_system" "payments" {
+  name     = "Payments"
+  priority = "critical"
+}
+
+resource "ackack_system_monitor_attachment" "checkout" {
+  system_id  = ackack_system.payments.id
+  monitor_id = ackack_monitor.checkout.id
+}
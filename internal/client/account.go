@@ -0,0 +1,17 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+)
+
+// GetAccount retrieves the authenticated account and its plan limits.
+func (c *Client) GetAccount(ctx context.Context) (*Account, error) {
+	var account Account
+	if err := c.get(ctx, "/api/v1/account", &account); err != nil {
+		return nil, err
+	}
+	return &account, nil
+}
@@ -6,37 +6,47 @@ package client
 import (
 	"context"
 	"fmt"
+	"net/http"
 )
 
 // CreateAlert creates a new alert.
 func (c *Client) CreateAlert(ctx context.Context, req CreateAlertRequest) (*Alert, error) {
 	var alert Alert
-	if err := c.post(ctx, "/api/v1/alerts", req, &alert); err != nil {
+	header, err := c.doRequestWithHeaders(ctx, http.MethodPost, "/api/v1/alerts", nil, req, &alert)
+	c.invalidateAlerts()
+	if err != nil {
 		return nil, err
 	}
+	alert.ETag = header.Get("ETag")
 	return &alert, nil
 }
 
 // GetAlert retrieves an alert by ID.
 func (c *Client) GetAlert(ctx context.Context, id string) (*Alert, error) {
 	var alert Alert
-	if err := c.get(ctx, fmt.Sprintf("/api/v1/alerts/%s", id), &alert); err != nil {
+	header, err := c.doRequestWithHeaders(ctx, http.MethodGet, fmt.Sprintf("/api/v1/alerts/%s", id), nil, nil, &alert)
+	if err != nil {
 		return nil, err
 	}
+	alert.ETag = header.Get("ETag")
 	return &alert, nil
 }
 
 // UpdateAlert updates an existing alert.
 func (c *Client) UpdateAlert(ctx context.Context, id string, req UpdateAlertRequest) (*Alert, error) {
 	var alert Alert
-	if err := c.put(ctx, fmt.Sprintf("/api/v1/alerts/%s", id), req, &alert); err != nil {
+	header, err := c.doRequestWithHeaders(ctx, http.MethodPut, fmt.Sprintf("/api/v1/alerts/%s", id), ifMatch(req.IfMatch), req, &alert)
+	c.invalidateAlerts()
+	if err != nil {
 		return nil, err
 	}
+	alert.ETag = header.Get("ETag")
 	return &alert, nil
 }
 
 // DeleteAlert deletes an alert by ID.
 func (c *Client) DeleteAlert(ctx context.Context, id string) error {
+	defer c.invalidateAlerts()
 	return c.delete(ctx, fmt.Sprintf("/api/v1/alerts/%s", id))
 }
 
@@ -48,3 +58,44 @@ func (c *Client) ListAlerts(ctx context.Context) ([]Alert, error) {
 	}
 	return resp.Alerts, nil
 }
+
+// SendTestNotification sends a test notification through an alert and
+// returns the outcome of its delivery.
+func (c *Client) SendTestNotification(ctx context.Context, id string) (*TestNotificationResponse, error) {
+	var resp TestNotificationResponse
+	if err := c.post(ctx, fmt.Sprintf("/api/v1/alerts/%s/test", id), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// AlertsByMonitor returns every alert, keyed by the ID of its monitor. The
+// alerts are listed once and shared between callers until an alert is
+// created, updated or deleted through the client, so reading the alerts of
+// many monitors costs a single request.
+func (c *Client) AlertsByMonitor(ctx context.Context) (map[string][]Alert, error) {
+	c.alertsMu.Lock()
+	defer c.alertsMu.Unlock()
+
+	if c.alertsByMonitor != nil {
+		return c.alertsByMonitor, nil
+	}
+
+	alerts, err := c.ListAlerts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byMonitor := make(map[string][]Alert)
+	for _, alert := range alerts {
+		byMonitor[alert.MonitorID] = append(byMonitor[alert.MonitorID], alert)
+	}
+	c.alertsByMonitor = byMonitor
+	return byMonitor, nil
+}
+
+// invalidateAlerts drops the alerts cached by AlertsByMonitor.
+func (c *Client) invalidateAlerts() {
+	c.alertsMu.Lock()
+	c.alertsByMonitor = nil
+	c.alertsMu.Unlock()
+}
@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAlertsByMonitor(t *testing.T) {
+	lists := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			lists++
+			_ = json.NewEncoder(w).Encode(ListAlertsResponse{Alerts: []Alert{
+				{ID: "alt_01", MonitorID: "mon_01"},
+				{ID: "alt_02", MonitorID: "mon_02"},
+				{ID: "alt_03", MonitorID: "mon_01"},
+			}})
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c, err := NewClient("ak_test", server.URL, "test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	ctx := context.Background()
+
+	for _, monitorID := range []string{"mon_01", "mon_02", "mon_03"} {
+		if _, err := c.AlertsByMonitor(ctx); err != nil {
+			t.Fatalf("%s: unexpected error: %s", monitorID, err)
+		}
+	}
+	byMonitor, _ := c.AlertsByMonitor(ctx)
+	if lists != 1 {
+		t.Errorf("expected the alerts to be listed once, got %d", lists)
+	}
+	if len(byMonitor["mon_01"]) != 2 || len(byMonitor["mon_02"]) != 1 || len(byMonitor["mon_03"]) != 0 {
+		t.Errorf("unexpected alerts by monitor: %v", byMonitor)
+	}
+
+	// Changing an alert drops the cached list.
+	if err := c.DeleteAlert(ctx, "alt_02"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := c.AlertsByMonitor(ctx); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if lists != 2 {
+		t.Errorf("expected the alerts to be listed again after a delete, got %d lists", lists)
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// CreateAnnotation creates a new annotation.
+func (c *Client) CreateAnnotation(ctx context.Context, req CreateAnnotationRequest) (*Annotation, error) {
+	var annotation Annotation
+	if err := c.post(ctx, "/api/v1/annotations", req, &annotation); err != nil {
+		return nil, err
+	}
+	return &annotation, nil
+}
+
+// GetAnnotation retrieves an annotation by ID.
+func (c *Client) GetAnnotation(ctx context.Context, id string) (*Annotation, error) {
+	var annotation Annotation
+	if err := c.get(ctx, fmt.Sprintf("/api/v1/annotations/%s", id), &annotation); err != nil {
+		return nil, err
+	}
+	return &annotation, nil
+}
+
+// UpdateAnnotation updates an existing annotation.
+func (c *Client) UpdateAnnotation(ctx context.Context, id string, req UpdateAnnotationRequest) (*Annotation, error) {
+	var annotation Annotation
+	if err := c.put(ctx, fmt.Sprintf("/api/v1/annotations/%s", id), req, &annotation); err != nil {
+		return nil, err
+	}
+	return &annotation, nil
+}
+
+// DeleteAnnotation deletes an annotation by ID.
+func (c *Client) DeleteAnnotation(ctx context.Context, id string) error {
+	return c.delete(ctx, fmt.Sprintf("/api/v1/annotations/%s", id))
+}
+
+// ListAnnotations retrieves annotations, optionally filtered by monitor and
+// time range. Empty filter values are omitted from the query.
+func (c *Client) ListAnnotations(ctx context.Context, monitorID, since, until string) ([]Annotation, error) {
+	path := "/api/v1/annotations"
+	query := url.Values{}
+	if monitorID != "" {
+		query.Set("monitor_id", monitorID)
+	}
+	if since != "" {
+		query.Set("since", since)
+	}
+	if until != "" {
+		query.Set("until", until)
+	}
+	if len(query) > 0 {
+		path = fmt.Sprintf("%s?%s", path, query.Encode())
+	}
+	var resp ListAnnotationsResponse
+	if err := c.get(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Annotations, nil
+}
@@ -0,0 +1,41 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// CreateArtifactsBucket configures a new artifact storage bucket.
+func (c *Client) CreateArtifactsBucket(ctx context.Context, req CreateArtifactsBucketRequest) (*ArtifactsBucket, error) {
+	var bucket ArtifactsBucket
+	if err := c.post(ctx, "/api/v1/artifacts/buckets", req, &bucket); err != nil {
+		return nil, err
+	}
+	return &bucket, nil
+}
+
+// GetArtifactsBucket retrieves an artifact storage bucket by ID.
+func (c *Client) GetArtifactsBucket(ctx context.Context, id string) (*ArtifactsBucket, error) {
+	var bucket ArtifactsBucket
+	if err := c.get(ctx, fmt.Sprintf("/api/v1/artifacts/buckets/%s", id), &bucket); err != nil {
+		return nil, err
+	}
+	return &bucket, nil
+}
+
+// UpdateArtifactsBucket updates an existing artifact storage bucket.
+func (c *Client) UpdateArtifactsBucket(ctx context.Context, id string, req UpdateArtifactsBucketRequest) (*ArtifactsBucket, error) {
+	var bucket ArtifactsBucket
+	if err := c.put(ctx, fmt.Sprintf("/api/v1/artifacts/buckets/%s", id), req, &bucket); err != nil {
+		return nil, err
+	}
+	return &bucket, nil
+}
+
+// DeleteArtifactsBucket deletes an artifact storage bucket by ID.
+func (c *Client) DeleteArtifactsBucket(ctx context.Context, id string) error {
+	return c.delete(ctx, fmt.Sprintf("/api/v1/artifacts/buckets/%s", id))
+}
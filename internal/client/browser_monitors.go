@@ -0,0 +1,41 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// CreateBrowserMonitor creates a new browser monitor.
+func (c *Client) CreateBrowserMonitor(ctx context.Context, req BrowserMonitorRequest) (*BrowserMonitor, error) {
+	var monitor BrowserMonitor
+	if err := c.post(ctx, "/api/v1/browser-monitors", req, &monitor); err != nil {
+		return nil, err
+	}
+	return &monitor, nil
+}
+
+// GetBrowserMonitor retrieves a browser monitor by ID.
+func (c *Client) GetBrowserMonitor(ctx context.Context, id string) (*BrowserMonitor, error) {
+	var monitor BrowserMonitor
+	if err := c.get(ctx, fmt.Sprintf("/api/v1/browser-monitors/%s", id), &monitor); err != nil {
+		return nil, err
+	}
+	return &monitor, nil
+}
+
+// UpdateBrowserMonitor updates an existing browser monitor.
+func (c *Client) UpdateBrowserMonitor(ctx context.Context, id string, req BrowserMonitorRequest) (*BrowserMonitor, error) {
+	var monitor BrowserMonitor
+	if err := c.put(ctx, fmt.Sprintf("/api/v1/browser-monitors/%s", id), req, &monitor); err != nil {
+		return nil, err
+	}
+	return &monitor, nil
+}
+
+// DeleteBrowserMonitor deletes a browser monitor by ID.
+func (c *Client) DeleteBrowserMonitor(ctx context.Context, id string) error {
+	return c.delete(ctx, fmt.Sprintf("/api/v1/browser-monitors/%s", id))
+}
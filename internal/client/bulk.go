@@ -0,0 +1,250 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// MaxBulkMonitors is the number of operations a single bulk request may
+	// contain.
+	MaxBulkMonitors = 100
+
+	// batchWindow is how long a monitor call waits for concurrent calls to
+	// join its batch.
+	batchWindow = 20 * time.Millisecond
+)
+
+// BulkCreateMonitors creates up to MaxBulkMonitors monitors in one request.
+// Each monitor succeeds or fails on its own; the error is only set when the
+// request as a whole failed.
+func (c *Client) BulkCreateMonitors(ctx context.Context, reqs []CreateMonitorRequest) ([]BulkMonitorResult, error) {
+	return c.bulkMonitors(ctx, "create", len(reqs), BulkCreateMonitorsRequest{Monitors: reqs})
+}
+
+// BulkUpdateMonitors updates up to MaxBulkMonitors monitors in one request.
+func (c *Client) BulkUpdateMonitors(ctx context.Context, updates []BulkMonitorUpdate) ([]BulkMonitorResult, error) {
+	return c.bulkMonitors(ctx, "update", len(updates), BulkUpdateMonitorsRequest{Monitors: updates})
+}
+
+// BulkDeleteMonitors deletes up to MaxBulkMonitors monitors in one request.
+func (c *Client) BulkDeleteMonitors(ctx context.Context, ids []string) ([]BulkMonitorResult, error) {
+	return c.bulkMonitors(ctx, "delete", len(ids), BulkDeleteMonitorsRequest{IDs: ids})
+}
+
+func (c *Client) bulkMonitors(ctx context.Context, operation string, n int, body any) ([]BulkMonitorResult, error) {
+	if n > MaxBulkMonitors {
+		return nil, fmt.Errorf("a bulk request may contain at most %d monitors, got %d", MaxBulkMonitors, n)
+	}
+
+	var resp BulkMonitorsResponse
+	if err := c.post(ctx, "/api/v1/monitors/bulk/"+operation, body, &resp); err != nil {
+		return nil, err
+	}
+	if len(resp.Results) != n {
+		return nil, fmt.Errorf("bulk %s returned %d results for %d monitors", operation, len(resp.Results), n)
+	}
+	return resp.Results, nil
+}
+
+// err returns the error of a failed operation as an *APIError, so callers
+// can handle it like the error of a single request.
+func (r BulkMonitorResult) err(c *Client) error {
+	if r.Error == nil {
+		return nil
+	}
+	message := r.Error.Message
+	if message == "" {
+		message = http.StatusText(r.Error.StatusCode)
+	}
+	return &APIError{
+		StatusCode: r.Error.StatusCode,
+		Message:    c.redact(message),
+		ErrorField: c.redact(r.Error.Error),
+	}
+}
+
+// EnableBatching makes concurrent CreateMonitor, UpdateMonitor and
+// DeleteMonitor calls share bulk requests. Terraform applies independent
+// resources in parallel, so large fleets need far fewer requests. If the
+// server turns out not to have the bulk endpoints, the client falls back to
+// single requests for the rest of the run.
+func (c *Client) EnableBatching() {
+	c.creates = &batcher[CreateMonitorRequest, *Monitor]{run: c.runCreateBatch}
+	c.updates = &batcher[BulkMonitorUpdate, *Monitor]{run: c.runUpdateBatch}
+	c.patches = &batcher[BulkMonitorUpdate, *Monitor]{run: c.runUpdateBatch}
+	c.deletes = &batcher[string, struct{}]{run: c.runDeleteBatch}
+}
+
+// batching reports whether monitor calls go through the batchers.
+func (c *Client) batching() bool {
+	return c.creates != nil && !c.batchingUnavailable.Load()
+}
+
+func (c *Client) runCreateBatch(ctx context.Context, calls []*batchCall[CreateMonitorRequest, *Monitor]) {
+	reqs := make([]CreateMonitorRequest, len(calls))
+	for i, call := range calls {
+		reqs[i] = call.req
+	}
+	results, err := c.BulkCreateMonitors(ctx, reqs)
+	finishBatch(c, ctx, calls, results, err, func(call *batchCall[CreateMonitorRequest, *Monitor], result BulkMonitorResult) {
+		call.res = result.Monitor
+		if call.res != nil {
+			call.res.ETag = result.ETag
+		}
+	}, func(call *batchCall[CreateMonitorRequest, *Monitor]) {
+		call.res, call.err = c.createMonitor(ctx, call.req)
+	})
+}
+
+func (c *Client) runUpdateBatch(ctx context.Context, calls []*batchCall[BulkMonitorUpdate, *Monitor]) {
+	updates := make([]BulkMonitorUpdate, len(calls))
+	for i, call := range calls {
+		updates[i] = call.req
+	}
+	results, err := c.BulkUpdateMonitors(ctx, updates)
+	finishBatch(c, ctx, calls, results, err, func(call *batchCall[BulkMonitorUpdate, *Monitor], result BulkMonitorResult) {
+		call.res = result.Monitor
+		if call.res != nil {
+			call.res.ETag = result.ETag
+		}
+	}, func(call *batchCall[BulkMonitorUpdate, *Monitor]) {
+		if call.req.Fields != nil {
+			call.res, call.err = c.patchMonitor(ctx, call.req)
+			return
+		}
+		call.res, call.err = c.updateMonitor(ctx, call.req.ID, call.req.UpdateMonitorRequest)
+	})
+}
+
+func (c *Client) runDeleteBatch(ctx context.Context, calls []*batchCall[string, struct{}]) {
+	ids := make([]string, len(calls))
+	for i, call := range calls {
+		ids[i] = call.req
+	}
+	results, err := c.BulkDeleteMonitors(ctx, ids)
+	finishBatch(c, ctx, calls, results, err, func(*batchCall[string, struct{}], BulkMonitorResult) {}, func(call *batchCall[string, struct{}]) {
+		call.err = c.deleteMonitor(ctx, call.req)
+	})
+}
+
+// finishBatch hands the results of a bulk request to its calls. When the
+// server has no bulk endpoint, batching is turned off and every call is sent
+// on its own instead.
+func finishBatch[Req, Res any](c *Client, ctx context.Context, calls []*batchCall[Req, Res], results []BulkMonitorResult, err error,
+	succeed func(*batchCall[Req, Res], BulkMonitorResult), single func(*batchCall[Req, Res])) {
+	if err != nil && (IsNotFoundError(err) || isMethodNotAllowedError(err)) {
+		c.batchingUnavailable.Store(true)
+
+		var wg sync.WaitGroup
+		for _, call := range calls {
+			wg.Go(func() {
+				single(call)
+				close(call.done)
+			})
+		}
+		wg.Wait()
+		return
+	}
+
+	for i, call := range calls {
+		switch {
+		case err != nil:
+			call.err = err
+		case results[i].Error != nil:
+			call.err = results[i].err(c)
+		default:
+			succeed(call, results[i])
+		}
+		close(call.done)
+	}
+}
+
+func isMethodNotAllowedError(err error) bool {
+	return hasStatus(err, http.StatusMethodNotAllowed)
+}
+
+// batchCall is a single call waiting for its batch to be sent.
+type batchCall[Req, Res any] struct {
+	req      Req
+	deadline time.Time
+	res      Res
+	err      error
+	done     chan struct{}
+}
+
+// batcher collects calls made within batchWindow of each other, up to
+// MaxBulkMonitors, and runs them together.
+type batcher[Req, Res any] struct {
+	run func(ctx context.Context, calls []*batchCall[Req, Res])
+
+	mu      sync.Mutex
+	pending []*batchCall[Req, Res]
+	timer   *time.Timer
+}
+
+// do adds req to the pending batch and waits for its result. The batch is
+// sent with the context of the call that started it, without its
+// cancellation, since other calls depend on it, but with the earliest
+// deadline of its calls; a cancelled caller stops waiting but its operation
+// may still be applied.
+func (b *batcher[Req, Res]) do(ctx context.Context, req Req) (Res, error) {
+	call := &batchCall[Req, Res]{req: req, done: make(chan struct{})}
+	call.deadline, _ = ctx.Deadline()
+
+	b.mu.Lock()
+	b.pending = append(b.pending, call)
+	switch len(b.pending) {
+	case 1:
+		batchCtx := context.WithoutCancel(ctx)
+		b.timer = time.AfterFunc(batchWindow, func() { b.flush(batchCtx) })
+	case MaxBulkMonitors:
+		b.timer.Stop()
+		calls := b.pending
+		b.pending = nil
+		go b.send(context.WithoutCancel(ctx), calls)
+	}
+	b.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.res, call.err
+	case <-ctx.Done():
+		var zero Res
+		return zero, ctx.Err()
+	}
+}
+
+func (b *batcher[Req, Res]) flush(ctx context.Context) {
+	b.mu.Lock()
+	calls := b.pending
+	b.pending = nil
+	b.mu.Unlock()
+
+	if len(calls) > 0 {
+		b.send(ctx, calls)
+	}
+}
+
+// send runs calls, limited to the earliest deadline among them.
+func (b *batcher[Req, Res]) send(ctx context.Context, calls []*batchCall[Req, Res]) {
+	var earliest time.Time
+	for _, call := range calls {
+		if !call.deadline.IsZero() && (earliest.IsZero() || call.deadline.Before(earliest)) {
+			earliest = call.deadline
+		}
+	}
+	if !earliest.IsZero() {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithDeadline(ctx, earliest)
+		defer cancel()
+	}
+
+	b.run(ctx, calls)
+}
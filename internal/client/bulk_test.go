@@ -0,0 +1,221 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCreateMonitor_Batching(t *testing.T) {
+	var bulkRequests, singleRequests atomic.Int64
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/monitors/bulk/create":
+			bulkRequests.Add(1)
+			var req BulkCreateMonitorsRequest
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			var resp BulkMonitorsResponse
+			for _, m := range req.Monitors {
+				if m.Name == "invalid" {
+					resp.Results = append(resp.Results, BulkMonitorResult{Error: &BulkItemError{StatusCode: 422, Error: "validation_error", Message: "url is required"}})
+					continue
+				}
+				resp.Results = append(resp.Results, BulkMonitorResult{Monitor: &Monitor{ID: "mon_" + m.Name, Name: m.Name}})
+			}
+			_ = json.NewEncoder(w).Encode(resp)
+		case "/api/v1/monitors":
+			singleRequests.Add(1)
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c, err := NewClient("ak_test", server.URL, "test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	c.EnableBatching()
+
+	names := []string{"a", "b", "c", "d", "invalid"}
+	monitors := make([]*Monitor, len(names))
+	errs := make([]error, len(names))
+	var wg sync.WaitGroup
+	for i, name := range names {
+		wg.Go(func() {
+			monitors[i], errs[i] = c.CreateMonitor(context.Background(), CreateMonitorRequest{Name: name})
+		})
+	}
+	wg.Wait()
+
+	if n := bulkRequests.Load(); n != 1 {
+		t.Errorf("expected 1 bulk request, got %d", n)
+	}
+	if n := singleRequests.Load(); n != 0 {
+		t.Errorf("expected no single requests, got %d", n)
+	}
+	for i, name := range names {
+		if name == "invalid" {
+			if apiErr, ok := errs[i].(*APIError); !ok || apiErr.StatusCode != 422 {
+				t.Errorf("expected a 422 API error for %s, got %v", name, errs[i])
+			}
+			continue
+		}
+		if errs[i] != nil {
+			t.Errorf("unexpected error for %s: %s", name, errs[i])
+			continue
+		}
+		if monitors[i].ID != "mon_"+name {
+			t.Errorf("expected monitor mon_%s, got %s", name, monitors[i].ID)
+		}
+	}
+}
+
+func TestDeleteMonitor_BatchingFallback(t *testing.T) {
+	var bulkRequests, singleRequests atomic.Int64
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/v1/monitors/bulk/delete":
+			bulkRequests.Add(1)
+			w.WriteHeader(http.StatusNotFound)
+		case r.Method == http.MethodDelete:
+			singleRequests.Add(1)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c, err := NewClient("ak_test", server.URL, "test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	c.EnableBatching()
+
+	var wg sync.WaitGroup
+	for i := range 3 {
+		wg.Go(func() {
+			if err := c.DeleteMonitor(context.Background(), fmt.Sprintf("mon_%d", i)); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+	}
+	wg.Wait()
+
+	// Once the bulk endpoint is known to be missing, later calls skip it.
+	if err := c.DeleteMonitor(context.Background(), "mon_3"); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	if n := bulkRequests.Load(); n != 1 {
+		t.Errorf("expected 1 bulk request, got %d", n)
+	}
+	if n := singleRequests.Load(); n != 4 {
+		t.Errorf("expected 4 single requests, got %d", n)
+	}
+}
+
+func TestUpdateMonitor_BatchingKinds(t *testing.T) {
+	var mu sync.Mutex
+	var batches [][]map[string]any
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Monitors []map[string]any `json:"monitors"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		mu.Lock()
+		batches = append(batches, req.Monitors)
+		mu.Unlock()
+
+		var resp BulkMonitorsResponse
+		for _, m := range req.Monitors {
+			resp.Results = append(resp.Results, BulkMonitorResult{Monitor: &Monitor{ID: m["id"].(string)}})
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	c, err := NewClient("ak_test", server.URL, "test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	c.EnableBatching()
+
+	var wg sync.WaitGroup
+	for i := range 2 {
+		wg.Go(func() {
+			if _, err := c.UpdateMonitor(context.Background(), fmt.Sprintf("mon_full_%d", i), UpdateMonitorRequest{Name: "full"}); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+		wg.Go(func() {
+			if _, err := c.PatchMonitor(context.Background(), fmt.Sprintf("mon_partial_%d", i), UpdateMonitorRequest{}, []string{"is_enabled"}); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+	}
+	wg.Wait()
+
+	if len(batches) != 2 {
+		t.Fatalf("expected full and partial updates in 2 requests, got %d", len(batches))
+	}
+	for _, batch := range batches {
+		_, partial := batch[0]["fields"]
+		for _, m := range batch {
+			if _, ok := m["fields"]; ok != partial {
+				t.Errorf("expected one kind of update per request, got %v", batch)
+			}
+		}
+	}
+}
+
+func TestUpdateMonitor_BatchingDeadline(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	c, err := NewClient("ak_test", server.URL, "test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	c.EnableBatching()
+
+	// The batch stops at the earliest deadline of its calls, rather than
+	// outliving them.
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := range 2 {
+		wg.Go(func() {
+			callCtx := context.Background()
+			if i == 0 {
+				callCtx = ctx
+			}
+			_, _ = c.UpdateMonitor(callCtx, fmt.Sprintf("mon_%d", i), UpdateMonitorRequest{Name: "updated"})
+		})
+	}
+	wg.Wait()
+
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("expected the batch to stop at the caller's deadline, took %s", elapsed)
+	}
+}
@@ -0,0 +1,27 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"slices"
+)
+
+// GetCapabilities retrieves the version and optional features of the server.
+func (c *Client) GetCapabilities(ctx context.Context) (*Capabilities, error) {
+	var capabilities Capabilities
+	if err := c.get(ctx, "/api/v1/capabilities", &capabilities); err != nil {
+		return nil, err
+	}
+	return &capabilities, nil
+}
+
+// SupportsFeature reports whether the server supports feature. Without
+// discovered capabilities every feature is assumed to be supported.
+func (c *Client) SupportsFeature(feature string) bool {
+	if c.Capabilities == nil {
+		return true
+	}
+	return slices.Contains(c.Capabilities.Features, feature)
+}
@@ -0,0 +1,35 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// ListChanges retrieves recent configuration changes of the account, newest
+// first, optionally filtered by start time and resource. Empty filter values
+// are omitted from the query.
+func (c *Client) ListChanges(ctx context.Context, since, resourceType, resourceID string) ([]ChangeEvent, error) {
+	path := "/api/v1/changes"
+	query := url.Values{}
+	if since != "" {
+		query.Set("since", since)
+	}
+	if resourceType != "" {
+		query.Set("resource_type", resourceType)
+	}
+	if resourceID != "" {
+		query.Set("resource_id", resourceID)
+	}
+	if len(query) > 0 {
+		path = fmt.Sprintf("%s?%s", path, query.Encode())
+	}
+	var resp ListChangesResponse
+	if err := c.get(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Changes, nil
+}
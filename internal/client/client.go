@@ -7,18 +7,47 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
+// DefaultBaseURL is the endpoint of the hosted ackack.io API.
+const DefaultBaseURL = "https://api.ackack.io"
+
+// SupportedAPIVersions is the range of server versions this client is built
+// against, as a version constraint.
+const SupportedAPIVersions = ">= 1.8.0, < 2.0.0"
+
 const (
-	defaultBaseURL = "https://api.ackack.io"
 	defaultTimeout = 30 * time.Second
 	maxRetries     = 3
 	retryBaseDelay = time.Second
+
+	// defaultMaxRetryWait is the longest Retry-After the client waits for
+	// unless MaxRetryWait is set, and defaultRetryAfter the wait when a rate
+	// limited response doesn't say.
+	defaultMaxRetryWait = 5 * time.Minute
+	defaultRetryAfter   = 60 * time.Second
+
+	// maxConcurrentDeletes bounds parallel monitor deletions, so large
+	// destroys don't run into the API's rate limits.
+	maxConcurrentDeletes = 4
+
+	// maxIdleConnsPerHost keeps enough idle connections for Terraform's
+	// parallel resource operations to reuse, instead of each request
+	// opening a new TLS connection.
+	maxIdleConnsPerHost = 32
+
+	// redacted replaces credentials in messages.
+	redacted = "[REDACTED]"
 )
 
 // Client is the ackack.io API client.
@@ -27,6 +56,47 @@ type Client struct {
 	APIKey     string
 	HTTPClient *http.Client
 	UserAgent  string
+
+	// Capabilities is set after feature discovery against self-hosted
+	// endpoints. When nil, every feature is assumed to be available.
+	Capabilities *Capabilities
+
+	// MaxDestroy is the number of monitors a single run may destroy, or 0
+	// for no limit.
+	MaxDestroy int
+
+	// MaxRetryWait is the longest the client waits for a rate limit to
+	// reset. Requests asked to wait longer fail instead. When 0, five
+	// minutes apply.
+	MaxRetryWait time.Duration
+
+	// AllowedWebhookDomains restricts the hosts webhook, Slack and Discord
+	// alerts may send to. When empty, every host is allowed.
+	AllowedWebhookDomains []string
+
+	// AlertDefaults are applied to alerts that omit the settings.
+	AlertDefaults AlertDefaults
+
+	// Observer, when set, receives the metrics of every request.
+	Observer RequestObserver
+
+	// destroys holds the IDs of the monitors counted against MaxDestroy.
+	destroysMu  sync.Mutex
+	destroys    map[string]struct{}
+	deleteSlots chan struct{}
+
+	// alertsByMonitor caches the alerts listed by AlertsByMonitor until an
+	// alert is changed through the client.
+	alertsMu        sync.Mutex
+	alertsByMonitor map[string][]Alert
+
+	// Batchers of monitor calls, set by EnableBatching. Full and partial
+	// updates are batched apart, so each bulk request holds one kind.
+	creates             *batcher[CreateMonitorRequest, *Monitor]
+	updates             *batcher[BulkMonitorUpdate, *Monitor]
+	patches             *batcher[BulkMonitorUpdate, *Monitor]
+	deletes             *batcher[string, struct{}]
+	batchingUnavailable atomic.Bool
 }
 
 // NewClient creates a new ackack.io API client.
@@ -37,7 +107,7 @@ func NewClient(apiKey, endpoint, version string) (*Client, error) {
 
 	baseURL := endpoint
 	if baseURL == "" {
-		baseURL = defaultBaseURL
+		baseURL = DefaultBaseURL
 	}
 
 	userAgent := "terraform-provider-ackack"
@@ -45,33 +115,98 @@ func NewClient(apiKey, endpoint, version string) (*Client, error) {
 		userAgent = fmt.Sprintf("terraform-provider-ackack/%s", version)
 	}
 
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxIdleConnsPerHost = maxIdleConnsPerHost
+
 	return &Client{
 		BaseURL: baseURL,
 		APIKey:  apiKey,
 		HTTPClient: &http.Client{
-			Timeout: defaultTimeout,
+			Timeout:   defaultTimeout,
+			Transport: transport,
 		},
-		UserAgent: userAgent,
+		UserAgent:   userAgent,
+		deleteSlots: make(chan struct{}, maxConcurrentDeletes),
 	}, nil
 }
 
+// RedactedBaseURL returns BaseURL with any credentials in it, such as a
+// password or a token query parameter, masked, for use in messages.
+func (c *Client) RedactedBaseURL() string {
+	return redactURL(c.BaseURL)
+}
+
+// redactURL masks the password and the query parameter values of rawURL.
+func redactURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "(invalid endpoint)"
+	}
+	if q := u.Query(); len(q) > 0 {
+		for key := range q {
+			q.Set(key, redacted)
+		}
+		u.RawQuery = q.Encode()
+	}
+	return u.Redacted()
+}
+
+// redactURLError masks credentials in the URL of a failed request, such as a
+// password or token in the endpoint, keeping the wrapped error so callers
+// can still match it with errors.Is and errors.As.
+func redactURLError(err error) error {
+	var urlErr *url.Error
+	if !errors.As(err, &urlErr) {
+		return err
+	}
+	return &url.Error{Op: urlErr.Op, URL: redactURL(urlErr.URL), Err: urlErr.Err}
+}
+
+// redact masks the API key in s, so that servers echoing credentials back
+// in error messages don't leak them into diagnostics or logs.
+func (c *Client) redact(s string) string {
+	if c.APIKey == "" {
+		return s
+	}
+	return strings.ReplaceAll(s, c.APIKey, redacted)
+}
+
 // doRequest performs an HTTP request with retries and error handling.
 func (c *Client) doRequest(ctx context.Context, method, path string, body, result any) error {
+	_, err := c.doRequestWithHeaders(ctx, method, path, nil, body, result)
+	return err
+}
+
+// doRequestWithHeaders performs an HTTP request like doRequest, adding the
+// given headers and returning the headers of a successful response.
+func (c *Client) doRequestWithHeaders(ctx context.Context, method, path string, header http.Header, body, result any) (http.Header, error) {
+	metrics := RequestMetrics{Method: method, Path: path}
+	start := time.Now()
+	respHeader, err := c.send(ctx, method, path, header, body, result, &metrics)
+	metrics.Duration = time.Since(start)
+	metrics.Err = err
+	c.observe(ctx, metrics)
+	return respHeader, err
+}
+
+// send performs the attempts of a request, recording them in metrics.
+func (c *Client) send(ctx context.Context, method, path string, header http.Header, body, result any, metrics *RequestMetrics) (http.Header, error) {
 	var bodyReader io.Reader
 	if body != nil {
 		jsonBody, err := json.Marshal(body)
 		if err != nil {
-			return fmt.Errorf("failed to marshal request body: %w", err)
+			return nil, fmt.Errorf("failed to marshal request body: %w", err)
 		}
 		bodyReader = bytes.NewReader(jsonBody)
 	}
 
 	var lastErr error
 	for attempt := range maxRetries {
+		metrics.Retries = attempt
 		if attempt > 0 {
 			select {
 			case <-ctx.Done():
-				return ctx.Err()
+				return nil, ctx.Err()
 			case <-time.After(retryBaseDelay * time.Duration(attempt)):
 			}
 		}
@@ -84,20 +219,24 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body, resul
 
 		req, err := http.NewRequestWithContext(ctx, method, c.BaseURL+path, bodyReader)
 		if err != nil {
-			return fmt.Errorf("failed to create request: %w", err)
+			return nil, fmt.Errorf("failed to create request: %w", err)
 		}
 
 		req.Header.Set("Authorization", "Bearer "+c.APIKey)
 		req.Header.Set("Content-Type", "application/json")
 		req.Header.Set("Accept", "application/json")
 		req.Header.Set("User-Agent", c.UserAgent)
+		for key, values := range header {
+			req.Header[key] = values
+		}
 
 		resp, err := c.HTTPClient.Do(req)
 		if err != nil {
-			lastErr = fmt.Errorf("request failed: %w", err)
+			lastErr = fmt.Errorf("request failed: %w", redactURLError(err))
 			continue
 		}
 
+		metrics.StatusCode = resp.StatusCode
 		respBody, err := io.ReadAll(resp.Body)
 		resp.Body.Close()
 		if err != nil {
@@ -107,21 +246,30 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body, resul
 
 		// Handle rate limiting
 		if resp.StatusCode == http.StatusTooManyRequests {
-			retryAfter := 60 // default to 60 seconds
-			if ra := resp.Header.Get("Retry-After"); ra != "" {
-				if parsed, err := strconv.Atoi(ra); err == nil {
-					retryAfter = parsed
-				}
-			}
-			lastErr = &APIError{
+			metrics.RateLimited++
+			retryAfter := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
+			apiErr := &APIError{
 				StatusCode: resp.StatusCode,
-				Message:    fmt.Sprintf("rate limited, retry after %d seconds", retryAfter),
+				Message:    fmt.Sprintf("rate limited, retry after %s", retryAfter),
+			}
+
+			// Fail rather than block the run when the server asks for an
+			// unreasonable wait or one the operation has no time left for.
+			if maxWait := c.maxRetryWait(); retryAfter > maxWait {
+				apiErr.Message = fmt.Sprintf("rate limited, retry after %s, which exceeds the maximum wait of %s", retryAfter, maxWait)
+				return nil, apiErr
+			}
+			if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < retryAfter {
+				apiErr.Message = fmt.Sprintf("rate limited, retry after %s, which exceeds the time left for the operation", retryAfter)
+				return nil, apiErr
 			}
-			// Wait for the retry-after duration
+			lastErr = apiErr
+
+			recordRateLimitWait(ctx, RateLimitWait{Method: method, Path: path, Duration: retryAfter})
 			select {
 			case <-ctx.Done():
-				return ctx.Err()
-			case <-time.After(time.Duration(retryAfter) * time.Second):
+				return nil, ctx.Err()
+			case <-time.After(retryAfter):
 			}
 			continue
 		}
@@ -130,10 +278,10 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body, resul
 		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
 			if result != nil && len(respBody) > 0 {
 				if err := json.Unmarshal(respBody, result); err != nil {
-					return fmt.Errorf("failed to unmarshal response: %w", err)
+					return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 				}
 			}
-			return nil
+			return resp.Header, nil
 		}
 
 		// Handle error responses
@@ -152,22 +300,60 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body, resul
 
 		apiErr := &APIError{
 			StatusCode: resp.StatusCode,
-			Message:    message,
-			ErrorField: errorResp.Error,
+			Message:    c.redact(message),
+			ErrorField: c.redact(errorResp.Error),
+		}
+
+		// Retired endpoints answer 410 Gone; say what to do about it rather
+		// than surfacing a bare status.
+		if resp.StatusCode == http.StatusGone {
+			apiErr.Message = fmt.Sprintf("%s %s has been retired by the ackack API (%s). "+
+				"Upgrade the ackack provider to a release that supports the current API", method, path, apiErr.Message)
 		}
 
 		// Don't retry client errors (except rate limiting which is handled above)
 		if resp.StatusCode >= 400 && resp.StatusCode < 500 {
-			return apiErr
+			return nil, apiErr
 		}
 
 		lastErr = apiErr
 	}
 
 	if lastErr != nil {
-		return lastErr
+		return nil, lastErr
+	}
+	return nil, fmt.Errorf("max retries exceeded")
+}
+
+// maxRetryWait returns MaxRetryWait, or the default when it is unset.
+func (c *Client) maxRetryWait() time.Duration {
+	if c.MaxRetryWait > 0 {
+		return c.MaxRetryWait
+	}
+	return defaultMaxRetryWait
+}
+
+// parseRetryAfter returns the wait a Retry-After header asks for, given in
+// seconds or as an HTTP date.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return defaultRetryAfter
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return max(time.Duration(seconds)*time.Second, 0)
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(date.Sub(now).Round(time.Second), 0)
+	}
+	return defaultRetryAfter
+}
+
+// ifMatch returns an If-Match header for etag, or nil when etag is empty.
+func ifMatch(etag string) http.Header {
+	if etag == "" {
+		return nil
 	}
-	return fmt.Errorf("max retries exceeded")
+	return http.Header{"If-Match": {etag}}
 }
 
 // get performs a GET request.
@@ -0,0 +1,84 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import "slices"
+
+// MonitorType is the kind of check a monitor performs.
+type MonitorType string
+
+const (
+	MonitorTypeHTTP MonitorType = "http"
+	MonitorTypeDNS  MonitorType = "dns"
+	MonitorTypeSSL  MonitorType = "ssl"
+	MonitorTypeTCP  MonitorType = "tcp"
+)
+
+// MonitorTypes lists the supported monitor types.
+var MonitorTypes = []MonitorType{MonitorTypeHTTP, MonitorTypeDNS, MonitorTypeSSL, MonitorTypeTCP}
+
+// Valid reports whether t is a supported monitor type.
+func (t MonitorType) Valid() bool {
+	return slices.Contains(MonitorTypes, t)
+}
+
+// MonitorStatus is the current state of a monitor. The API reports other
+// statuses for monitors that are passing or not yet checked.
+type MonitorStatus string
+
+const (
+	MonitorStatusDegraded MonitorStatus = "degraded"
+	MonitorStatusError    MonitorStatus = "error"
+)
+
+// AlertType is the channel an alert is delivered through.
+type AlertType string
+
+const (
+	AlertTypeEmail     AlertType = "email"
+	AlertTypeWebhook   AlertType = "webhook"
+	AlertTypeDiscord   AlertType = "discord"
+	AlertTypeSlack     AlertType = "slack"
+	AlertTypePagerDuty AlertType = "pagerduty"
+	AlertTypeOpsgenie  AlertType = "opsgenie"
+	AlertTypeSMS       AlertType = "sms"
+	AlertTypeVoice     AlertType = "voice"
+)
+
+// AlertTypes lists the supported alert types.
+var AlertTypes = []AlertType{
+	AlertTypeEmail, AlertTypeWebhook, AlertTypeDiscord, AlertTypeSlack,
+	AlertTypePagerDuty, AlertTypeOpsgenie, AlertTypeSMS, AlertTypeVoice,
+}
+
+// Valid reports whether t is a supported alert type.
+func (t AlertType) Valid() bool {
+	return slices.Contains(AlertTypes, t)
+}
+
+// Severity is the severity of an incident, or of the alerts it sends.
+type Severity string
+
+const (
+	SeverityInfo     Severity = "info"
+	SeverityWarning  Severity = "warning"
+	SeverityCritical Severity = "critical"
+)
+
+// Severities lists the supported severities, from least to most severe.
+var Severities = []Severity{SeverityInfo, SeverityWarning, SeverityCritical}
+
+// Valid reports whether s is a supported severity.
+func (s Severity) Valid() bool {
+	return slices.Contains(Severities, s)
+}
+
+// Strings returns values as strings, e.g. for schema validators.
+func Strings[T ~string](values []T) []string {
+	out := make([]string, len(values))
+	for i, v := range values {
+		out[i] = string(v)
+	}
+	return out
+}
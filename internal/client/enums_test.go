@@ -0,0 +1,28 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEnumValid(t *testing.T) {
+	if !MonitorTypeTCP.Valid() || MonitorType("browser").Valid() {
+		t.Error("unexpected monitor type validity")
+	}
+	if !AlertTypeOpsgenie.Valid() || AlertType("pager").Valid() {
+		t.Error("unexpected alert type validity")
+	}
+	if !SeverityCritical.Valid() || Severity("fatal").Valid() {
+		t.Error("unexpected severity validity")
+	}
+}
+
+func TestStrings(t *testing.T) {
+	expected := []string{"info", "warning", "critical"}
+	if got := Strings(Severities); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
@@ -4,10 +4,26 @@
 package client
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 )
 
+// Sentinel errors matched by API errors with the corresponding status code,
+// e.g. errors.Is(err, ErrNotFound). They match wrapped errors too.
+var (
+	ErrNotFound     = errors.New("not found")
+	ErrRateLimited  = errors.New("rate limited")
+	ErrUnauthorized = errors.New("unauthorized")
+)
+
+// statusSentinels maps status codes to the sentinel errors they match.
+var statusSentinels = map[int]error{
+	http.StatusNotFound:        ErrNotFound,
+	http.StatusTooManyRequests: ErrRateLimited,
+	http.StatusUnauthorized:    ErrUnauthorized,
+}
+
 // APIError represents an error response from the ackack.io API.
 type APIError struct {
 	StatusCode int
@@ -22,42 +38,58 @@ func (e *APIError) Error() string {
 	return fmt.Sprintf("API error (status %d): %s", e.StatusCode, e.Message)
 }
 
+// Is reports whether target is the sentinel error for the status code.
+func (e *APIError) Is(target error) bool {
+	sentinel, ok := statusSentinels[e.StatusCode]
+	return ok && sentinel == target
+}
+
+// hasStatus reports whether err is or wraps an APIError with the given
+// status code.
+func hasStatus(err error, statusCode int) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == statusCode
+}
+
 // IsNotFoundError returns true if the error is a 404 Not Found error.
 func IsNotFoundError(err error) bool {
-	if apiErr, ok := err.(*APIError); ok {
-		return apiErr.StatusCode == http.StatusNotFound
-	}
-	return false
+	return errors.Is(err, ErrNotFound)
 }
 
 // IsRateLimitError returns true if the error is a 429 Too Many Requests error.
 func IsRateLimitError(err error) bool {
-	if apiErr, ok := err.(*APIError); ok {
-		return apiErr.StatusCode == http.StatusTooManyRequests
-	}
-	return false
+	return errors.Is(err, ErrRateLimited)
 }
 
 // IsUnauthorizedError returns true if the error is a 401 Unauthorized error.
 func IsUnauthorizedError(err error) bool {
-	if apiErr, ok := err.(*APIError); ok {
-		return apiErr.StatusCode == http.StatusUnauthorized
-	}
-	return false
+	return errors.Is(err, ErrUnauthorized)
 }
 
 // IsForbiddenError returns true if the error is a 403 Forbidden error.
 func IsForbiddenError(err error) bool {
-	if apiErr, ok := err.(*APIError); ok {
-		return apiErr.StatusCode == http.StatusForbidden
-	}
-	return false
+	return hasStatus(err, http.StatusForbidden)
 }
 
 // IsBadRequestError returns true if the error is a 400 Bad Request error.
 func IsBadRequestError(err error) bool {
-	if apiErr, ok := err.(*APIError); ok {
-		return apiErr.StatusCode == http.StatusBadRequest
-	}
-	return false
+	return hasStatus(err, http.StatusBadRequest)
+}
+
+// IsConflictError returns true if the error is a 409 Conflict error, returned
+// when a resource with the same name already exists.
+func IsConflictError(err error) bool {
+	return hasStatus(err, http.StatusConflict)
+}
+
+// IsGoneError returns true if the error is a 410 Gone error, returned for
+// retired endpoints.
+func IsGoneError(err error) bool {
+	return hasStatus(err, http.StatusGone)
+}
+
+// IsPreconditionFailedError returns true if the error is a 412 Precondition
+// Failed error, returned when an update's If-Match ETag is out of date.
+func IsPreconditionFailedError(err error) bool {
+	return hasStatus(err, http.StatusPreconditionFailed)
 }
@@ -0,0 +1,63 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestAPIError_Is(t *testing.T) {
+	notFound := &APIError{StatusCode: http.StatusNotFound, Message: "monitor not found"}
+	wrapped := fmt.Errorf("reading monitor: %w", notFound)
+
+	if !errors.Is(wrapped, ErrNotFound) {
+		t.Error("expected a wrapped 404 to match ErrNotFound")
+	}
+	if errors.Is(wrapped, ErrRateLimited) || errors.Is(wrapped, ErrUnauthorized) {
+		t.Error("expected a 404 to match only ErrNotFound")
+	}
+	if !IsNotFoundError(wrapped) {
+		t.Error("expected IsNotFoundError to unwrap the error")
+	}
+
+	var apiErr *APIError
+	if !errors.As(wrapped, &apiErr) || apiErr.Message != "monitor not found" {
+		t.Errorf("expected errors.As to find the API error, got %v", apiErr)
+	}
+}
+
+func TestErrorHelpers(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		is     func(error) bool
+	}{
+		{"not found", http.StatusNotFound, IsNotFoundError},
+		{"rate limited", http.StatusTooManyRequests, IsRateLimitError},
+		{"unauthorized", http.StatusUnauthorized, IsUnauthorizedError},
+		{"forbidden", http.StatusForbidden, IsForbiddenError},
+		{"bad request", http.StatusBadRequest, IsBadRequestError},
+		{"conflict", http.StatusConflict, IsConflictError},
+		{"gone", http.StatusGone, IsGoneError},
+		{"precondition failed", http.StatusPreconditionFailed, IsPreconditionFailedError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := fmt.Errorf("wrapped: %w", &APIError{StatusCode: tt.status})
+			if !tt.is(err) {
+				t.Errorf("expected a wrapped %d to match", tt.status)
+			}
+			if tt.is(&APIError{StatusCode: http.StatusInternalServerError}) {
+				t.Error("expected a 500 not to match")
+			}
+			if tt.is(nil) {
+				t.Error("expected nil not to match")
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// CreatePagerDutyIntegration creates a new PagerDuty integration.
+func (c *Client) CreatePagerDutyIntegration(ctx context.Context, req CreatePagerDutyIntegrationRequest) (*PagerDutyIntegration, error) {
+	var integration PagerDutyIntegration
+	if err := c.post(ctx, "/api/v1/integrations/pagerduty", req, &integration); err != nil {
+		return nil, err
+	}
+	return &integration, nil
+}
+
+// GetPagerDutyIntegration retrieves a PagerDuty integration by ID.
+func (c *Client) GetPagerDutyIntegration(ctx context.Context, id string) (*PagerDutyIntegration, error) {
+	var integration PagerDutyIntegration
+	if err := c.get(ctx, fmt.Sprintf("/api/v1/integrations/pagerduty/%s", id), &integration); err != nil {
+		return nil, err
+	}
+	return &integration, nil
+}
+
+// UpdatePagerDutyIntegration updates an existing PagerDuty integration.
+func (c *Client) UpdatePagerDutyIntegration(ctx context.Context, id string, req UpdatePagerDutyIntegrationRequest) (*PagerDutyIntegration, error) {
+	var integration PagerDutyIntegration
+	if err := c.put(ctx, fmt.Sprintf("/api/v1/integrations/pagerduty/%s", id), req, &integration); err != nil {
+		return nil, err
+	}
+	return &integration, nil
+}
+
+// DeletePagerDutyIntegration deletes a PagerDuty integration by ID.
+func (c *Client) DeletePagerDutyIntegration(ctx context.Context, id string) error {
+	return c.delete(ctx, fmt.Sprintf("/api/v1/integrations/pagerduty/%s", id))
+}
@@ -0,0 +1,41 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// CreateLimitAlert creates a new limit alert.
+func (c *Client) CreateLimitAlert(ctx context.Context, req CreateLimitAlertRequest) (*LimitAlert, error) {
+	var alert LimitAlert
+	if err := c.post(ctx, "/api/v1/limit-alerts", req, &alert); err != nil {
+		return nil, err
+	}
+	return &alert, nil
+}
+
+// GetLimitAlert retrieves a limit alert by ID.
+func (c *Client) GetLimitAlert(ctx context.Context, id string) (*LimitAlert, error) {
+	var alert LimitAlert
+	if err := c.get(ctx, fmt.Sprintf("/api/v1/limit-alerts/%s", id), &alert); err != nil {
+		return nil, err
+	}
+	return &alert, nil
+}
+
+// UpdateLimitAlert updates an existing limit alert.
+func (c *Client) UpdateLimitAlert(ctx context.Context, id string, req UpdateLimitAlertRequest) (*LimitAlert, error) {
+	var alert LimitAlert
+	if err := c.put(ctx, fmt.Sprintf("/api/v1/limit-alerts/%s", id), req, &alert); err != nil {
+		return nil, err
+	}
+	return &alert, nil
+}
+
+// DeleteLimitAlert deletes a limit alert by ID.
+func (c *Client) DeleteLimitAlert(ctx context.Context, id string) error {
+	return c.delete(ctx, fmt.Sprintf("/api/v1/limit-alerts/%s", id))
+}
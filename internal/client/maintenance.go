@@ -0,0 +1,41 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// CreateMaintenanceWindow creates a new maintenance window.
+func (c *Client) CreateMaintenanceWindow(ctx context.Context, req CreateMaintenanceWindowRequest) (*MaintenanceWindow, error) {
+	var window MaintenanceWindow
+	if err := c.post(ctx, "/api/v1/maintenance-windows", req, &window); err != nil {
+		return nil, err
+	}
+	return &window, nil
+}
+
+// GetMaintenanceWindow retrieves a maintenance window by ID.
+func (c *Client) GetMaintenanceWindow(ctx context.Context, id string) (*MaintenanceWindow, error) {
+	var window MaintenanceWindow
+	if err := c.get(ctx, fmt.Sprintf("/api/v1/maintenance-windows/%s", id), &window); err != nil {
+		return nil, err
+	}
+	return &window, nil
+}
+
+// UpdateMaintenanceWindow updates an existing maintenance window.
+func (c *Client) UpdateMaintenanceWindow(ctx context.Context, id string, req UpdateMaintenanceWindowRequest) (*MaintenanceWindow, error) {
+	var window MaintenanceWindow
+	if err := c.put(ctx, fmt.Sprintf("/api/v1/maintenance-windows/%s", id), req, &window); err != nil {
+		return nil, err
+	}
+	return &window, nil
+}
+
+// DeleteMaintenanceWindow deletes a maintenance window by ID.
+func (c *Client) DeleteMaintenanceWindow(ctx context.Context, id string) error {
+	return c.delete(ctx, fmt.Sprintf("/api/v1/maintenance-windows/%s", id))
+}
@@ -0,0 +1,89 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RequestMetrics describes a completed API request, including its retries.
+type RequestMetrics struct {
+	Method string
+	Path   string
+
+	// StatusCode is the status of the last response, or 0 when no response
+	// was received.
+	StatusCode int
+
+	// Duration is the time from the first attempt to the result, including
+	// waits between retries.
+	Duration time.Duration
+
+	// Retries counts the attempts after the first, and RateLimited the
+	// responses that asked the client to wait.
+	Retries     int
+	RateLimited int
+
+	Err error
+}
+
+// RequestObserver receives the metrics of every request a Client makes.
+// It is called concurrently and must not block.
+type RequestObserver interface {
+	ObserveRequest(ctx context.Context, metrics RequestMetrics)
+}
+
+// RequestStats is a RequestObserver that totals the requests it observes.
+type RequestStats struct {
+	mu      sync.Mutex
+	summary RequestSummary
+}
+
+// RequestSummary totals the requests observed by RequestStats.
+type RequestSummary struct {
+	Requests    int
+	Errors      int
+	Retries     int
+	RateLimited int
+
+	// TotalDuration sums the duration of every request, and SlowestPath
+	// names the slowest one.
+	TotalDuration   time.Duration
+	SlowestDuration time.Duration
+	SlowestPath     string
+}
+
+// ObserveRequest adds metrics to the totals.
+func (s *RequestStats) ObserveRequest(_ context.Context, metrics RequestMetrics) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.summary.Requests++
+	if metrics.Err != nil {
+		s.summary.Errors++
+	}
+	s.summary.Retries += metrics.Retries
+	s.summary.RateLimited += metrics.RateLimited
+	s.summary.TotalDuration += metrics.Duration
+	if metrics.Duration > s.summary.SlowestDuration {
+		s.summary.SlowestDuration = metrics.Duration
+		s.summary.SlowestPath = metrics.Method + " " + metrics.Path
+	}
+}
+
+// Summary returns the totals so far.
+func (s *RequestStats) Summary() RequestSummary {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.summary
+}
+
+// observe reports metrics to the client's observer, if any.
+func (c *Client) observe(ctx context.Context, metrics RequestMetrics) {
+	if c.Observer != nil {
+		c.Observer.ObserveRequest(ctx, metrics)
+	}
+}
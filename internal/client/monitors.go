@@ -6,40 +6,100 @@ package client
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 )
 
 // CreateMonitor creates a new monitor.
 func (c *Client) CreateMonitor(ctx context.Context, req CreateMonitorRequest) (*Monitor, error) {
+	if c.batching() {
+		return c.creates.do(ctx, req)
+	}
+	return c.createMonitor(ctx, req)
+}
+
+func (c *Client) createMonitor(ctx context.Context, req CreateMonitorRequest) (*Monitor, error) {
 	var monitor Monitor
-	if err := c.post(ctx, "/api/v1/monitors", req, &monitor); err != nil {
+	header, err := c.doRequestWithHeaders(ctx, http.MethodPost, "/api/v1/monitors", nil, req, &monitor)
+	if err != nil {
 		return nil, err
 	}
+	monitor.ETag = header.Get("ETag")
 	return &monitor, nil
 }
 
 // GetMonitor retrieves a monitor by ID.
 func (c *Client) GetMonitor(ctx context.Context, id string) (*Monitor, error) {
 	var monitor Monitor
-	if err := c.get(ctx, fmt.Sprintf("/api/v1/monitors/%s", id), &monitor); err != nil {
+	header, err := c.doRequestWithHeaders(ctx, http.MethodGet, fmt.Sprintf("/api/v1/monitors/%s", id), nil, nil, &monitor)
+	if err != nil {
 		return nil, err
 	}
+	monitor.ETag = header.Get("ETag")
 	return &monitor, nil
 }
 
 // UpdateMonitor updates an existing monitor.
 func (c *Client) UpdateMonitor(ctx context.Context, id string, req UpdateMonitorRequest) (*Monitor, error) {
+	if c.batching() {
+		return c.updates.do(ctx, BulkMonitorUpdate{ID: id, IfMatch: req.IfMatch, UpdateMonitorRequest: req})
+	}
+	return c.updateMonitor(ctx, id, req)
+}
+
+func (c *Client) updateMonitor(ctx context.Context, id string, req UpdateMonitorRequest) (*Monitor, error) {
 	var monitor Monitor
-	if err := c.put(ctx, fmt.Sprintf("/api/v1/monitors/%s", id), req, &monitor); err != nil {
+	header, err := c.doRequestWithHeaders(ctx, http.MethodPut, fmt.Sprintf("/api/v1/monitors/%s", id), ifMatch(req.IfMatch), req, &monitor)
+	if err != nil {
 		return nil, err
 	}
+	monitor.ETag = header.Get("ETag")
 	return &monitor, nil
 }
 
 // DeleteMonitor deletes a monitor by ID.
 func (c *Client) DeleteMonitor(ctx context.Context, id string) error {
+	if c.batching() {
+		_, err := c.deletes.do(ctx, id)
+		return err
+	}
+	return c.deleteMonitor(ctx, id)
+}
+
+func (c *Client) deleteMonitor(ctx context.Context, id string) error {
+	if c.deleteSlots != nil {
+		select {
+		case c.deleteSlots <- struct{}{}:
+			defer func() { <-c.deleteSlots }()
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
 	return c.delete(ctx, fmt.Sprintf("/api/v1/monitors/%s", id))
 }
 
+// ReserveDestroy counts the destroys of the given monitors against
+// MaxDestroy and returns an error once the limit is exceeded. Each monitor
+// is counted once, so a destroy reserved when planned and again when
+// applied is not counted twice.
+func (c *Client) ReserveDestroy(ids ...string) error {
+	c.destroysMu.Lock()
+	defer c.destroysMu.Unlock()
+
+	if c.destroys == nil {
+		c.destroys = make(map[string]struct{})
+	}
+	for _, id := range ids {
+		c.destroys[id] = struct{}{}
+	}
+	if c.MaxDestroy > 0 && len(c.destroys) > c.MaxDestroy {
+		return fmt.Errorf("this run destroys more than %d monitors", c.MaxDestroy)
+	}
+	return nil
+}
+
 // ListMonitors retrieves all monitors for the authenticated user.
 func (c *Client) ListMonitors(ctx context.Context) ([]Monitor, error) {
 	var resp ListMonitorsResponse
@@ -49,11 +109,31 @@ func (c *Client) ListMonitors(ctx context.Context) ([]Monitor, error) {
 	return resp.Monitors, nil
 }
 
-// GetMonitorResults retrieves recent check results for a monitor.
-func (c *Client) GetMonitorResults(ctx context.Context, id string, limit int) ([]MonitorResult, error) {
+// ListMonitorsByStatus retrieves monitors whose current status is one of the
+// given statuses, filtered server-side in a single request.
+func (c *Client) ListMonitorsByStatus(ctx context.Context, statuses []MonitorStatus) ([]Monitor, error) {
+	query := url.Values{}
+	query.Set("status", strings.Join(Strings(statuses), ","))
+	var resp ListMonitorsResponse
+	if err := c.get(ctx, fmt.Sprintf("/api/v1/monitors?%s", query.Encode()), &resp); err != nil {
+		return nil, err
+	}
+	return resp.Monitors, nil
+}
+
+// GetMonitorResults retrieves recent check results for a monitor, optionally
+// restricted to a single region.
+func (c *Client) GetMonitorResults(ctx context.Context, id string, limit int, region string) ([]MonitorResult, error) {
 	path := fmt.Sprintf("/api/v1/monitors/%s/results", id)
+	query := url.Values{}
 	if limit > 0 {
-		path = fmt.Sprintf("%s?limit=%d", path, limit)
+		query.Set("limit", strconv.Itoa(limit))
+	}
+	if region != "" {
+		query.Set("region", region)
+	}
+	if len(query) > 0 {
+		path = fmt.Sprintf("%s?%s", path, query.Encode())
 	}
 	var resp GetResultsResponse
 	if err := c.get(ctx, path, &resp); err != nil {
@@ -62,6 +142,17 @@ func (c *Client) GetMonitorResults(ctx context.Context, id string, limit int) ([
 	return resp.Results, nil
 }
 
+// RunMonitorCheck checks a monitor immediately, outside its schedule, and
+// returns the results once the check completes. An empty region checks
+// every region the monitor runs in.
+func (c *Client) RunMonitorCheck(ctx context.Context, id, region string) ([]MonitorResult, error) {
+	var resp RunCheckResponse
+	if err := c.post(ctx, fmt.Sprintf("/api/v1/monitors/%s/check", id), RunCheckRequest{Region: region}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Results, nil
+}
+
 // GetMonitorUptime retrieves uptime percentage for a monitor.
 func (c *Client) GetMonitorUptime(ctx context.Context, id string, hours int) (*GetUptimeResponse, error) {
 	path := fmt.Sprintf("/api/v1/monitors/%s/uptime", id)
@@ -75,6 +166,31 @@ func (c *Client) GetMonitorUptime(ctx context.Context, id string, hours int) (*G
 	return &resp, nil
 }
 
+// GetMonitorLatencyHistogram retrieves the response time distribution of a
+// monitor. Zero hours and empty bounds use the server defaults.
+func (c *Client) GetMonitorLatencyHistogram(ctx context.Context, id string, hours int, boundsMs []int) (*LatencyHistogram, error) {
+	path := fmt.Sprintf("/api/v1/monitors/%s/latency-histogram", id)
+	query := url.Values{}
+	if hours > 0 {
+		query.Set("hours", strconv.Itoa(hours))
+	}
+	if len(boundsMs) > 0 {
+		bounds := make([]string, len(boundsMs))
+		for i, b := range boundsMs {
+			bounds[i] = strconv.Itoa(b)
+		}
+		query.Set("buckets", strings.Join(bounds, ","))
+	}
+	if len(query) > 0 {
+		path = fmt.Sprintf("%s?%s", path, query.Encode())
+	}
+	var resp LatencyHistogram
+	if err := c.get(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 // GetMonitorIncidents retrieves recent incidents for a monitor.
 func (c *Client) GetMonitorIncidents(ctx context.Context, id string, limit int) ([]Incident, error) {
 	path := fmt.Sprintf("/api/v1/monitors/%s/incidents", id)
@@ -88,6 +204,16 @@ func (c *Client) GetMonitorIncidents(ctx context.Context, id string, limit int)
 	return resp.Incidents, nil
 }
 
+// ResolveMonitorIncidents resolves every open incident of a monitor and
+// returns how many were resolved.
+func (c *Client) ResolveMonitorIncidents(ctx context.Context, id string) (int, error) {
+	var resp ResolveIncidentsResponse
+	if err := c.post(ctx, fmt.Sprintf("/api/v1/monitors/%s/incidents/resolve", id), nil, &resp); err != nil {
+		return 0, err
+	}
+	return resp.Resolved, nil
+}
+
 // GetMonitorHealth retrieves health information for a specific monitor.
 func (c *Client) GetMonitorHealth(ctx context.Context, id string) (*MonitorHealthInfo, error) {
 	var resp MonitorHealthInfo
@@ -105,3 +231,42 @@ func (c *Client) GetAllMonitorHealth(ctx context.Context) (*MonitorHealthRespons
 	}
 	return &resp, nil
 }
+
+// GetResultArtifactURL returns a signed URL for a result's failure artifact,
+// e.g. "screenshot" or "har", valid for ttlSeconds (or the API default when 0).
+func (c *Client) GetResultArtifactURL(ctx context.Context, monitorID string, resultID int, kind string, ttlSeconds int) (*ResultArtifactURL, error) {
+	path := fmt.Sprintf("/api/v1/monitors/%s/results/%d/artifacts/%s", monitorID, resultID, kind)
+	if ttlSeconds > 0 {
+		path = fmt.Sprintf("%s?ttl_seconds=%d", path, ttlSeconds)
+	}
+	var artifact ResultArtifactURL
+	if err := c.get(ctx, path, &artifact); err != nil {
+		return nil, err
+	}
+	return &artifact, nil
+}
+
+// GetMonitorBadge retrieves the public uptime badge for a monitor, optionally including
+// the rendered SVG.
+func (c *Client) GetMonitorBadge(ctx context.Context, id, style string, includeSVG bool) (*Badge, error) {
+	return c.getBadge(ctx, fmt.Sprintf("/api/v1/monitors/%s/badge", id), style, includeSVG)
+}
+
+// getBadge retrieves a badge from path with the given query options.
+func (c *Client) getBadge(ctx context.Context, path, style string, includeSVG bool) (*Badge, error) {
+	query := url.Values{}
+	if style != "" {
+		query.Set("style", style)
+	}
+	if includeSVG {
+		query.Set("include_svg", "true")
+	}
+	if len(query) > 0 {
+		path = fmt.Sprintf("%s?%s", path, query.Encode())
+	}
+	var badge Badge
+	if err := c.get(ctx, path, &badge); err != nil {
+		return nil, err
+	}
+	return &badge, nil
+}
@@ -6,13 +6,22 @@ package client
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"strconv"
 )
 
-// ListNotificationHistory retrieves notification history for the authenticated user.
-func (c *Client) ListNotificationHistory(ctx context.Context, page, pageSize int) (*ListNotificationHistoryResponse, error) {
+// ListNotificationHistory retrieves notification history for the
+// authenticated user, optionally narrowed by filter.
+func (c *Client) ListNotificationHistory(ctx context.Context, page, pageSize int, filter NotificationFilter) (*ListNotificationHistoryResponse, error) {
 	path := "/api/v1/notifications"
+	query := url.Values{}
 	if page > 0 || pageSize > 0 {
-		path = fmt.Sprintf("%s?page=%d&pageSize=%d", path, page, pageSize)
+		query.Set("page", strconv.Itoa(page))
+		query.Set("pageSize", strconv.Itoa(pageSize))
+	}
+	filter.setQuery(query)
+	if len(query) > 0 {
+		path = fmt.Sprintf("%s?%s", path, query.Encode())
 	}
 	var resp ListNotificationHistoryResponse
 	if err := c.get(ctx, path, &resp); err != nil {
@@ -21,6 +30,22 @@ func (c *Client) ListNotificationHistory(ctx context.Context, page, pageSize int
 	return &resp, nil
 }
 
+// setQuery adds the non-empty fields of the filter to query.
+func (f NotificationFilter) setQuery(query url.Values) {
+	for key, value := range map[string]string{
+		"monitor_id": f.MonitorID,
+		"alert_id":   f.AlertID,
+		"status":     f.Status,
+		"event_type": f.EventType,
+		"since":      f.Since,
+		"until":      f.Until,
+	} {
+		if value != "" {
+			query.Set(key, value)
+		}
+	}
+}
+
 // GetNotificationHistory retrieves a single notification history record.
 func (c *Client) GetNotificationHistory(ctx context.Context, id string) (*NotificationHistory, error) {
 	var notification NotificationHistory
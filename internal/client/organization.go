@@ -0,0 +1,18 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+)
+
+// ListOrganizationAccounts retrieves the child accounts of the organization
+// the API key belongs to, each with a summary of its monitor fleet.
+func (c *Client) ListOrganizationAccounts(ctx context.Context) ([]OrganizationAccount, error) {
+	var resp ListOrganizationAccountsResponse
+	if err := c.get(ctx, "/api/v1/organization/accounts", &resp); err != nil {
+		return nil, err
+	}
+	return resp.Accounts, nil
+}
@@ -0,0 +1,160 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// PatchMonitor updates only the given fields of a monitor, named by their
+// JSON keys. Unlike UpdateMonitor, the fields are sent even when they hold
+// zero values, and nil pointers, slices and maps are sent as null, which
+// resets the field to its default.
+func (c *Client) PatchMonitor(ctx context.Context, id string, req UpdateMonitorRequest, fields []string) (*Monitor, error) {
+	update := BulkMonitorUpdate{ID: id, IfMatch: req.IfMatch, UpdateMonitorRequest: req, Fields: fields}
+	if c.batching() {
+		return c.patches.do(ctx, update)
+	}
+	return c.patchMonitor(ctx, update)
+}
+
+func (c *Client) patchMonitor(ctx context.Context, update BulkMonitorUpdate) (*Monitor, error) {
+	var monitor Monitor
+	body := partialBody(update.UpdateMonitorRequest, update.Fields)
+	header, err := c.doRequestWithHeaders(ctx, http.MethodPatch, fmt.Sprintf("/api/v1/monitors/%s", update.ID), ifMatch(update.IfMatch), body, &monitor)
+	if err != nil {
+		return nil, err
+	}
+	monitor.ETag = header.Get("ETag")
+	return &monitor, nil
+}
+
+// SetMonitorEnabled enables or disables a monitor without changing its
+// other settings. When the server does not support partial updates, the
+// monitor is read and sent back in full with only is_enabled changed, under
+// its ETag, so a concurrent change fails the update instead of being lost.
+func (c *Client) SetMonitorEnabled(ctx context.Context, id string, enabled bool) (*Monitor, error) {
+	if c.SupportsFeature("partial_updates") {
+		return c.PatchMonitor(ctx, id, UpdateMonitorRequest{IsEnabled: &enabled}, []string{"is_enabled"})
+	}
+
+	monitor, err := c.GetMonitor(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	req := updateRequestFromMonitor(monitor)
+	req.IfMatch = monitor.ETag
+	req.IsEnabled = &enabled
+	return c.UpdateMonitor(ctx, id, req)
+}
+
+// updateRequestFromMonitor returns an update request that sets every field
+// to its value in monitor, matching fields by their JSON keys.
+func updateRequestFromMonitor(monitor *Monitor) UpdateMonitorRequest {
+	source := reflect.ValueOf(monitor).Elem()
+	sourceFields := make(map[string]reflect.Value)
+	for _, field := range reflect.VisibleFields(source.Type()) {
+		if name, ok := jsonFieldName(field); ok {
+			sourceFields[name] = source.FieldByIndex(field.Index)
+		}
+	}
+
+	var req UpdateMonitorRequest
+	target := reflect.ValueOf(&req).Elem()
+	for _, field := range reflect.VisibleFields(target.Type()) {
+		name, ok := jsonFieldName(field)
+		if !ok {
+			continue
+		}
+		value, ok := sourceFields[name]
+		if !ok {
+			continue
+		}
+		switch to := target.FieldByIndex(field.Index); {
+		case value.Type() == to.Type():
+			to.Set(value)
+		case to.Kind() == reflect.Pointer && value.Type() == to.Type().Elem():
+			pointer := reflect.New(value.Type())
+			pointer.Elem().Set(value)
+			to.Set(pointer)
+		}
+	}
+	return req
+}
+
+// ChangedMonitorFields returns the JSON keys of the fields that differ
+// between two update requests, for use with PatchMonitor.
+func ChangedMonitorFields(prior, planned UpdateMonitorRequest) []string {
+	priorValue, plannedValue := reflect.ValueOf(prior), reflect.ValueOf(planned)
+
+	var fields []string
+	for _, field := range reflect.VisibleFields(priorValue.Type()) {
+		name, ok := jsonFieldName(field)
+		if !ok {
+			continue
+		}
+		if !reflect.DeepEqual(priorValue.FieldByIndex(field.Index).Interface(), plannedValue.FieldByIndex(field.Index).Interface()) {
+			fields = append(fields, name)
+		}
+	}
+	return fields
+}
+
+// MarshalJSON sends only Fields when they are set, listing them under
+// "fields" so the server applies the update as a patch.
+func (u BulkMonitorUpdate) MarshalJSON() ([]byte, error) {
+	if u.Fields == nil {
+		type plain BulkMonitorUpdate
+		return json.Marshal(plain(u))
+	}
+
+	body := partialBody(u.UpdateMonitorRequest, u.Fields)
+	body["id"] = u.ID
+	body["fields"] = u.Fields
+	if u.IfMatch != "" {
+		body["if_match"] = u.IfMatch
+	}
+	return json.Marshal(body)
+}
+
+// partialBody returns the fields of req named in fields, ignoring omitempty.
+func partialBody(req any, fields []string) map[string]any {
+	value := reflect.ValueOf(req)
+
+	body := make(map[string]any, len(fields))
+	for _, field := range reflect.VisibleFields(value.Type()) {
+		name, ok := jsonFieldName(field)
+		if !ok || !slices.Contains(fields, name) {
+			continue
+		}
+		fieldValue := value.FieldByIndex(field.Index)
+		switch fieldValue.Kind() {
+		case reflect.Pointer, reflect.Slice, reflect.Map:
+			if fieldValue.IsNil() {
+				body[name] = nil
+				continue
+			}
+		}
+		body[name] = fieldValue.Interface()
+	}
+	return body
+}
+
+func jsonFieldName(field reflect.StructField) (string, bool) {
+	tag := field.Tag.Get("json")
+	if tag == "-" || !field.IsExported() || field.Anonymous {
+		return "", false
+	}
+	name, _, _ := strings.Cut(tag, ",")
+	if name == "" {
+		name = field.Name
+	}
+	return name, true
+}
@@ -0,0 +1,137 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestChangedMonitorFields(t *testing.T) {
+	enabled := true
+	prior := UpdateMonitorRequest{Name: "API", Retries: 3, ValidateBody: &enabled, Regions: []string{"us", "eu"}}
+	planned := UpdateMonitorRequest{Name: "API", Retries: 0, Regions: []string{"us"}}
+
+	expected := []string{"retries", "regions", "validate_body"}
+	if got := ChangedMonitorFields(prior, planned); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+	if got := ChangedMonitorFields(prior, prior); len(got) != 0 {
+		t.Errorf("expected no changes, got %q", got)
+	}
+}
+
+func TestPatchMonitor(t *testing.T) {
+	var method string
+	var body map[string]any
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		_ = json.NewEncoder(w).Encode(Monitor{ID: "mon_01"})
+	}))
+	defer server.Close()
+
+	c, err := NewClient("ak_test", server.URL, "test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	req := UpdateMonitorRequest{Name: "API", Retries: 0}
+	if _, err := c.PatchMonitor(context.Background(), "mon_01", req, []string{"retries", "validate_body"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if method != http.MethodPatch {
+		t.Errorf("expected PATCH, got %s", method)
+	}
+	// Zero values and nil pointers are sent; unlisted fields are not.
+	expected := map[string]any{"retries": float64(0), "validate_body": nil}
+	if !reflect.DeepEqual(body, expected) {
+		t.Errorf("expected body %v, got %v", expected, body)
+	}
+}
+
+func TestSetMonitorEnabled(t *testing.T) {
+	t.Run("partial updates", func(t *testing.T) {
+		var requests []string
+		var body map[string]any
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r.Method)
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			_ = json.NewEncoder(w).Encode(Monitor{ID: "mon_01", IsEnabled: true})
+		}))
+		defer server.Close()
+
+		c, err := NewClient("ak_test", server.URL, "test")
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		c.Capabilities = &Capabilities{Version: "1.9.0", Features: []string{"partial_updates"}}
+
+		if _, err := c.SetMonitorEnabled(context.Background(), "mon_01", true); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if !reflect.DeepEqual(requests, []string{http.MethodPatch}) {
+			t.Errorf("expected a single PATCH, got %v", requests)
+		}
+		if expected := map[string]any{"is_enabled": true}; !reflect.DeepEqual(body, expected) {
+			t.Errorf("expected body %v, got %v", expected, body)
+		}
+	})
+
+	t.Run("full update fallback", func(t *testing.T) {
+		var requests []string
+		var ifMatch string
+		var body map[string]any
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r.Method)
+			if r.Method == http.MethodGet {
+				w.Header().Set("ETag", `"v1"`)
+				_ = json.NewEncoder(w).Encode(Monitor{ID: "mon_01", Name: "API", Type: MonitorTypeHTTP, URL: "https://example.com", Retries: 2, Regions: []string{"us-east"}})
+				return
+			}
+			ifMatch = r.Header.Get("If-Match")
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			_ = json.NewEncoder(w).Encode(Monitor{ID: "mon_01", IsEnabled: true})
+		}))
+		defer server.Close()
+
+		c, err := NewClient("ak_test", server.URL, "test")
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		c.Capabilities = &Capabilities{Version: "1.9.0"}
+
+		if _, err := c.SetMonitorEnabled(context.Background(), "mon_01", true); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if !reflect.DeepEqual(requests, []string{http.MethodGet, http.MethodPut}) {
+			t.Errorf("expected GET then PUT, got %v", requests)
+		}
+		if ifMatch != `"v1"` {
+			t.Errorf("expected If-Match of the monitor read, got %q", ifMatch)
+		}
+		// The monitor's settings are sent back with only is_enabled changed.
+		expected := map[string]any{
+			"name":       "API",
+			"type":       "http",
+			"url":        "https://example.com",
+			"retries":    float64(2),
+			"regions":    []any{"us-east"},
+			"is_enabled": true,
+		}
+		for key, want := range expected {
+			if !reflect.DeepEqual(body[key], want) {
+				t.Errorf("%s: expected %v, got %v", key, want, body[key])
+			}
+		}
+	})
+}
@@ -0,0 +1,50 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// rateLimitNoticeThreshold is the wait above which a rate limit is recorded
+// for WithRateLimitWaits.
+const rateLimitNoticeThreshold = 5 * time.Second
+
+// RateLimitWait describes a request that waited for a rate limit to reset.
+type RateLimitWait struct {
+	Method   string
+	Path     string
+	Duration time.Duration
+}
+
+type rateLimitWaitsKey struct{}
+
+type rateLimitWaits struct {
+	mu    sync.Mutex
+	waits []RateLimitWait
+}
+
+// WithRateLimitWaits returns a context in which requests record waits of
+// more than a few seconds for a rate limit to reset, and a function that
+// returns the recorded waits.
+func WithRateLimitWaits(ctx context.Context) (context.Context, func() []RateLimitWait) {
+	recorder := &rateLimitWaits{}
+	return context.WithValue(ctx, rateLimitWaitsKey{}, recorder), func() []RateLimitWait {
+		recorder.mu.Lock()
+		defer recorder.mu.Unlock()
+		return recorder.waits
+	}
+}
+
+func recordRateLimitWait(ctx context.Context, wait RateLimitWait) {
+	recorder, ok := ctx.Value(rateLimitWaitsKey{}).(*rateLimitWaits)
+	if !ok || wait.Duration <= rateLimitNoticeThreshold {
+		return
+	}
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	recorder.waits = append(recorder.waits, wait)
+}
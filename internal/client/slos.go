@@ -0,0 +1,41 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// CreateSLO creates a new SLO.
+func (c *Client) CreateSLO(ctx context.Context, req CreateSLORequest) (*SLO, error) {
+	var slo SLO
+	if err := c.post(ctx, "/api/v1/slos", req, &slo); err != nil {
+		return nil, err
+	}
+	return &slo, nil
+}
+
+// GetSLO retrieves an SLO by ID.
+func (c *Client) GetSLO(ctx context.Context, id string) (*SLO, error) {
+	var slo SLO
+	if err := c.get(ctx, fmt.Sprintf("/api/v1/slos/%s", id), &slo); err != nil {
+		return nil, err
+	}
+	return &slo, nil
+}
+
+// UpdateSLO updates an existing SLO.
+func (c *Client) UpdateSLO(ctx context.Context, id string, req UpdateSLORequest) (*SLO, error) {
+	var slo SLO
+	if err := c.put(ctx, fmt.Sprintf("/api/v1/slos/%s", id), req, &slo); err != nil {
+		return nil, err
+	}
+	return &slo, nil
+}
+
+// DeleteSLO deletes an SLO by ID.
+func (c *Client) DeleteSLO(ctx context.Context, id string) error {
+	return c.delete(ctx, fmt.Sprintf("/api/v1/slos/%s", id))
+}
@@ -49,6 +49,15 @@ func (c *Client) ListSystems(ctx context.Context) ([]SystemWithStats, error) {
 	return resp.Systems, nil
 }
 
+// ListSystemMonitorIDs retrieves the IDs of the monitors in a system.
+func (c *Client) ListSystemMonitorIDs(ctx context.Context, id string) ([]string, error) {
+	var resp ListSystemMonitorsResponse
+	if err := c.get(ctx, fmt.Sprintf("/api/v1/systems/%s/monitors", id), &resp); err != nil {
+		return nil, err
+	}
+	return resp.MonitorIDs, nil
+}
+
 // AddMonitorsToSystem adds monitors to a system.
 func (c *Client) AddMonitorsToSystem(ctx context.Context, id string, monitorIDs []string) error {
 	req := ModifyMonitorsRequest{MonitorIDs: monitorIDs}
@@ -60,3 +69,9 @@ func (c *Client) RemoveMonitorsFromSystem(ctx context.Context, id string, monito
 	req := ModifyMonitorsRequest{MonitorIDs: monitorIDs}
 	return c.doRequest(ctx, "DELETE", fmt.Sprintf("/api/v1/systems/%s/monitors", id), req, nil)
 }
+
+// GetSystemBadge retrieves the public uptime badge for a system, optionally including
+// the rendered SVG.
+func (c *Client) GetSystemBadge(ctx context.Context, id, style string, includeSVG bool) (*Badge, error) {
+	return c.getBadge(ctx, fmt.Sprintf("/api/v1/systems/%s/badge", id), style, includeSVG)
+}
@@ -5,21 +5,30 @@ package client
 
 // Monitor represents a monitor configuration.
 type Monitor struct {
-	ID               string  `json:"id,omitempty"`
-	UserID           string  `json:"user_id,omitempty"`
-	Name             string  `json:"name,omitempty"`
-	Type             string  `json:"type,omitempty"`
-	IsEnabled        bool    `json:"is_enabled,omitempty"`
-	FrequencySeconds int     `json:"frequency_seconds,omitempty"`
-	TimeoutMs        int     `json:"timeout_ms,omitempty"`
-	Retries          int     `json:"retries,omitempty"`
-	GeneralRegion    string  `json:"general_region,omitempty"`
-	SpecificRegion   string  `json:"specific_region,omitempty"`
-	Status           string  `json:"status,omitempty"`
-	UptimePercentage float64 `json:"uptime_percentage,omitempty"`
-	LastChecked      string  `json:"last_checked,omitempty"`
-	CreatedAt        string  `json:"created_at,omitempty"`
-	UpdatedAt        string  `json:"updated_at,omitempty"`
+	// ETag identifies the version of the monitor the API returned. It is
+	// read from the response headers.
+	ETag string `json:"-"`
+
+	ID                string        `json:"id,omitempty"`
+	UserID            string        `json:"user_id,omitempty"`
+	Name              string        `json:"name,omitempty"`
+	Type              MonitorType   `json:"type,omitempty"`
+	IsEnabled         bool          `json:"is_enabled,omitempty"`
+	FrequencySeconds  int           `json:"frequency_seconds,omitempty"`
+	TimeoutMs         int           `json:"timeout_ms,omitempty"`
+	Retries           int           `json:"retries,omitempty"`
+	GeneralRegion     string        `json:"general_region,omitempty"`
+	SpecificRegion    string        `json:"specific_region,omitempty"`
+	Regions           []string      `json:"regions,omitempty"`
+	MinRegionsFailing int           `json:"min_regions_failing,omitempty"`
+	IPVersion         string        `json:"ip_version,omitempty"`
+	Status            MonitorStatus `json:"status,omitempty"`
+	UptimePercentage  float64       `json:"uptime_percentage,omitempty"`
+	LastChecked       string        `json:"last_checked,omitempty"`
+	LastErrorType     string        `json:"last_error_type,omitempty"`
+	LastErrorMessage  string        `json:"last_error_message,omitempty"`
+	CreatedAt         string        `json:"created_at,omitempty"`
+	UpdatedAt         string        `json:"updated_at,omitempty"`
 
 	// HTTP specific
 	URL                string `json:"url,omitempty"`
@@ -27,35 +36,117 @@ type Monitor struct {
 	ValidateStatus     bool   `json:"validate_status,omitempty"`
 	ValidateBody       bool   `json:"validate_body,omitempty"`
 	BodyPattern        string `json:"body_pattern,omitempty"`
+	BodyPatternMode    string `json:"body_pattern_mode,omitempty"`
+	BodyPatternIsRegex bool   `json:"body_pattern_is_regex,omitempty"`
+	ResponseSchema     string `json:"response_schema,omitempty"`
+	FollowRedirects    *bool  `json:"follow_redirects,omitempty"`
+	MaxRedirects       int    `json:"max_redirects,omitempty"`
 	Headers            string `json:"headers,omitempty"`
+	ClientCertPEM      string `json:"client_cert_pem,omitempty"`
+	ResolveToIP        string `json:"resolve_to_ip,omitempty"`
+	HostHeader         string `json:"host_header,omitempty"`
 
 	// DNS specific
-	DNSRecordType string `json:"dns_record_type,omitempty"`
-	ExpectedValue string `json:"expected_value,omitempty"`
-	Nameserver    string `json:"nameserver,omitempty"`
+	DNSRecordType   string           `json:"dns_record_type,omitempty"`
+	ExpectedValue   string           `json:"expected_value,omitempty"`
+	ExpectedRecords []ExpectedRecord `json:"expected_records,omitempty"`
+	Nameserver      string           `json:"nameserver,omitempty"`
+	ValidateDNSSEC  bool             `json:"validate_dnssec,omitempty"`
 
 	// TCP specific
-	Host string `json:"host,omitempty"`
-	Port int    `json:"port,omitempty"`
+	Host   string `json:"host,omitempty"`
+	Port   int    `json:"port,omitempty"`
+	UseTLS bool   `json:"use_tls,omitempty"`
 
 	// SSL specific
-	Domain                   string `json:"domain,omitempty"`
-	CheckExpirationThreshold bool   `json:"check_expiration_threshold,omitempty"`
-	ExpirationThreshold      int    `json:"expiration_threshold,omitempty"`
-	CheckProtocolVersion     bool   `json:"check_protocol_version,omitempty"`
-	MinimumProtocol          string `json:"minimum_protocol,omitempty"`
+	Domain                    string `json:"domain,omitempty"`
+	ServerName                string `json:"server_name,omitempty"`
+	CheckExpirationThreshold  bool   `json:"check_expiration_threshold,omitempty"`
+	ExpirationThreshold       int    `json:"expiration_threshold,omitempty"`
+	CheckProtocolVersion      bool   `json:"check_protocol_version,omitempty"`
+	MinimumProtocol           string `json:"minimum_protocol,omitempty"`
+	CheckChain                bool   `json:"check_chain,omitempty"`
+	ExpectedIssuer            string `json:"expected_issuer,omitempty"`
+	CheckRevocation           bool   `json:"check_revocation,omitempty"`
+	ExpectedFingerprintSHA256 string `json:"expected_fingerprint_sha256,omitempty"`
+
+	// Result storage
+	ResultSampling *ResultSampling `json:"result_sampling,omitempty"`
+
+	// Per-phase latency thresholds (HTTP only)
+	PhaseThresholds *PhaseTimings `json:"phase_thresholds,omitempty"`
+
+	// Time-windowed threshold overrides
+	ThresholdWindows []ThresholdWindow `json:"threshold_windows,omitempty"`
+
+	// Active hours; checks only run inside these windows
+	Schedule []ActiveHours `json:"schedule,omitempty"`
+
+	// Incident handling
+	SeverityMapping         map[string]string `json:"severity_mapping,omitempty"`
+	AutoResolveAfterMinutes int               `json:"auto_resolve_after_minutes,omitempty"`
+	ReopenWindowMinutes     int               `json:"reopen_window_minutes,omitempty"`
+
+	// Dependencies
+	DependsOnMonitorIDs []string `json:"depends_on_monitor_ids,omitempty"`
+	InheritMaintenance  bool     `json:"inherit_maintenance,omitempty"`
+
+	// Maintenance
+	PauseDuringMaintenance bool `json:"pause_during_maintenance,omitempty"`
+}
+
+// ResultSampling controls which check results are retained for a monitor.
+type ResultSampling struct {
+	SuccessSampleRate int  `json:"success_sample_rate,omitempty"`
+	StoreAllFailures  bool `json:"store_all_failures"`
+}
+
+// ThresholdWindow overrides the thresholds of a monitor during a recurring
+// time window, e.g. business hours.
+type ThresholdWindow struct {
+	Days            []string      `json:"days"`
+	StartTime       string        `json:"start_time"`
+	EndTime         string        `json:"end_time"`
+	Timezone        string        `json:"timezone"`
+	TimeoutMs       int           `json:"timeout_ms,omitempty"`
+	PhaseThresholds *PhaseTimings `json:"phase_thresholds,omitempty"`
+}
+
+// ExpectedRecord is a DNS record a monitor expects in the answer. Which
+// fields apply depends on the record type: priority for MX, priority, weight
+// and port for SRV, and flags and tag for CAA.
+type ExpectedRecord struct {
+	Value    string `json:"value"`
+	Priority *int   `json:"priority,omitempty"`
+	Weight   *int   `json:"weight,omitempty"`
+	Port     *int   `json:"port,omitempty"`
+	Flags    *int   `json:"flags,omitempty"`
+	Tag      string `json:"tag,omitempty"`
+}
+
+// PhaseTimings are the durations of the phases of an HTTP check, in
+// milliseconds. On monitors they are thresholds, where 0 means no threshold.
+type PhaseTimings struct {
+	DNSMs      int `json:"dns_ms,omitempty"`
+	ConnectMs  int `json:"connect_ms,omitempty"`
+	TLSMs      int `json:"tls_ms,omitempty"`
+	TTFBMs     int `json:"ttfb_ms,omitempty"`
+	TransferMs int `json:"transfer_ms,omitempty"`
 }
 
 // CreateMonitorRequest is the request body for creating a monitor.
 type CreateMonitorRequest struct {
-	Name             string `json:"name"`
-	Type             string `json:"type"`
-	IsEnabled        *bool  `json:"is_enabled,omitempty"`
-	FrequencySeconds int    `json:"frequency_seconds,omitempty"`
-	TimeoutMs        int    `json:"timeout_ms,omitempty"`
-	Retries          int    `json:"retries,omitempty"`
-	GeneralRegion    string `json:"general_region,omitempty"`
-	SpecificRegion   string `json:"specific_region,omitempty"`
+	Name              string      `json:"name"`
+	Type              MonitorType `json:"type"`
+	IsEnabled         *bool       `json:"is_enabled,omitempty"`
+	FrequencySeconds  int         `json:"frequency_seconds,omitempty"`
+	TimeoutMs         int         `json:"timeout_ms,omitempty"`
+	Retries           int         `json:"retries,omitempty"`
+	GeneralRegion     string      `json:"general_region,omitempty"`
+	SpecificRegion    string      `json:"specific_region,omitempty"`
+	Regions           []string    `json:"regions,omitempty"`
+	MinRegionsFailing int         `json:"min_regions_failing,omitempty"`
+	IPVersion         string      `json:"ip_version,omitempty"`
 
 	// HTTP specific
 	URL                string `json:"url,omitempty"`
@@ -63,35 +154,83 @@ type CreateMonitorRequest struct {
 	ValidateStatus     *bool  `json:"validate_status,omitempty"`
 	ValidateBody       *bool  `json:"validate_body,omitempty"`
 	BodyPattern        string `json:"body_pattern,omitempty"`
+	BodyPatternMode    string `json:"body_pattern_mode,omitempty"`
+	BodyPatternIsRegex *bool  `json:"body_pattern_is_regex,omitempty"`
+	ResponseSchema     string `json:"response_schema,omitempty"`
+	FollowRedirects    *bool  `json:"follow_redirects,omitempty"`
+	MaxRedirects       int    `json:"max_redirects,omitempty"`
 	Headers            string `json:"headers,omitempty"`
+	ClientCertPEM      string `json:"client_cert_pem,omitempty"`
+	ResolveToIP        string `json:"resolve_to_ip,omitempty"`
+	HostHeader         string `json:"host_header,omitempty"`
+	ClientKeyPEM       string `json:"client_key_pem,omitempty"`
 
 	// DNS specific
-	DNSRecordType string `json:"dns_record_type,omitempty"`
-	ExpectedValue string `json:"expected_value,omitempty"`
-	Nameserver    string `json:"nameserver,omitempty"`
+	DNSRecordType   string           `json:"dns_record_type,omitempty"`
+	ExpectedValue   string           `json:"expected_value,omitempty"`
+	ExpectedRecords []ExpectedRecord `json:"expected_records,omitempty"`
+	Nameserver      string           `json:"nameserver,omitempty"`
+	ValidateDNSSEC  *bool            `json:"validate_dnssec,omitempty"`
 
 	// TCP specific
-	Host string `json:"host,omitempty"`
-	Port int    `json:"port,omitempty"`
+	Host   string `json:"host,omitempty"`
+	Port   int    `json:"port,omitempty"`
+	UseTLS *bool  `json:"use_tls,omitempty"`
 
 	// SSL specific
-	Domain                   string `json:"domain,omitempty"`
-	CheckExpirationThreshold *bool  `json:"check_expiration_threshold,omitempty"`
-	ExpirationThreshold      int    `json:"expiration_threshold,omitempty"`
-	CheckProtocolVersion     *bool  `json:"check_protocol_version,omitempty"`
-	MinimumProtocol          string `json:"minimum_protocol,omitempty"`
+	Domain                    string `json:"domain,omitempty"`
+	ServerName                string `json:"server_name,omitempty"`
+	CheckExpirationThreshold  *bool  `json:"check_expiration_threshold,omitempty"`
+	ExpirationThreshold       int    `json:"expiration_threshold,omitempty"`
+	CheckProtocolVersion      *bool  `json:"check_protocol_version,omitempty"`
+	MinimumProtocol           string `json:"minimum_protocol,omitempty"`
+	CheckChain                *bool  `json:"check_chain,omitempty"`
+	ExpectedIssuer            string `json:"expected_issuer,omitempty"`
+	CheckRevocation           *bool  `json:"check_revocation,omitempty"`
+	ExpectedFingerprintSHA256 string `json:"expected_fingerprint_sha256,omitempty"`
+
+	// Result storage
+	ResultSampling *ResultSampling `json:"result_sampling,omitempty"`
+
+	// Per-phase latency thresholds (HTTP only)
+	PhaseThresholds *PhaseTimings `json:"phase_thresholds,omitempty"`
+
+	// Time-windowed threshold overrides
+	ThresholdWindows []ThresholdWindow `json:"threshold_windows,omitempty"`
+
+	// Active hours; checks only run inside these windows
+	Schedule []ActiveHours `json:"schedule,omitempty"`
+
+	// Incident handling
+	SeverityMapping         map[string]string `json:"severity_mapping,omitempty"`
+	AutoResolveAfterMinutes int               `json:"auto_resolve_after_minutes,omitempty"`
+	ReopenWindowMinutes     int               `json:"reopen_window_minutes,omitempty"`
+
+	// Dependencies
+	DependsOnMonitorIDs []string `json:"depends_on_monitor_ids,omitempty"`
+	InheritMaintenance  *bool    `json:"inherit_maintenance,omitempty"`
+
+	// Maintenance
+	PauseDuringMaintenance *bool `json:"pause_during_maintenance,omitempty"`
 }
 
 // UpdateMonitorRequest is the request body for updating a monitor.
 type UpdateMonitorRequest struct {
-	Name             string `json:"name,omitempty"`
-	Type             string `json:"type,omitempty"`
-	IsEnabled        *bool  `json:"is_enabled,omitempty"`
-	FrequencySeconds int    `json:"frequency_seconds,omitempty"`
-	TimeoutMs        int    `json:"timeout_ms,omitempty"`
-	Retries          int    `json:"retries,omitempty"`
-	GeneralRegion    string `json:"general_region,omitempty"`
-	SpecificRegion   string `json:"specific_region,omitempty"`
+	// IfMatch, when set, makes the update fail with 412 Precondition Failed
+	// if the monitor no longer has this ETag. It is sent as a header.
+	IfMatch string `json:"-"`
+
+	Name              string      `json:"name,omitempty"`
+	Type              MonitorType `json:"type,omitempty"`
+	IsEnabled         *bool       `json:"is_enabled,omitempty"`
+	FrequencySeconds  int         `json:"frequency_seconds,omitempty"`
+	TimeoutMs         int         `json:"timeout_ms,omitempty"`
+	Retries           int         `json:"retries,omitempty"`
+	GeneralRegion     string      `json:"general_region,omitempty"`
+	SpecificRegion    string      `json:"specific_region,omitempty"`
+	Regions           []string    `json:"regions,omitempty"`
+	MinRegionsFailing int         `json:"min_regions_failing,omitempty"`
+	IPVersion         string      `json:"ip_version,omitempty"`
 
 	// HTTP specific
 	URL                string `json:"url,omitempty"`
@@ -99,23 +238,64 @@ type UpdateMonitorRequest struct {
 	ValidateStatus     *bool  `json:"validate_status,omitempty"`
 	ValidateBody       *bool  `json:"validate_body,omitempty"`
 	BodyPattern        string `json:"body_pattern,omitempty"`
+	BodyPatternMode    string `json:"body_pattern_mode,omitempty"`
+	BodyPatternIsRegex *bool  `json:"body_pattern_is_regex,omitempty"`
+	ResponseSchema     string `json:"response_schema,omitempty"`
+	FollowRedirects    *bool  `json:"follow_redirects,omitempty"`
+	MaxRedirects       int    `json:"max_redirects,omitempty"`
 	Headers            string `json:"headers,omitempty"`
+	ClientCertPEM      string `json:"client_cert_pem,omitempty"`
+	ResolveToIP        string `json:"resolve_to_ip,omitempty"`
+	HostHeader         string `json:"host_header,omitempty"`
+	ClientKeyPEM       string `json:"client_key_pem,omitempty"`
 
 	// DNS specific
-	DNSRecordType string `json:"dns_record_type,omitempty"`
-	ExpectedValue string `json:"expected_value,omitempty"`
-	Nameserver    string `json:"nameserver,omitempty"`
+	DNSRecordType   string           `json:"dns_record_type,omitempty"`
+	ExpectedValue   string           `json:"expected_value,omitempty"`
+	ExpectedRecords []ExpectedRecord `json:"expected_records,omitempty"`
+	Nameserver      string           `json:"nameserver,omitempty"`
+	ValidateDNSSEC  *bool            `json:"validate_dnssec,omitempty"`
 
 	// TCP specific
-	Host string `json:"host,omitempty"`
-	Port int    `json:"port,omitempty"`
+	Host   string `json:"host,omitempty"`
+	Port   int    `json:"port,omitempty"`
+	UseTLS *bool  `json:"use_tls,omitempty"`
 
 	// SSL specific
-	Domain                   string `json:"domain,omitempty"`
-	CheckExpirationThreshold *bool  `json:"check_expiration_threshold,omitempty"`
-	ExpirationThreshold      int    `json:"expiration_threshold,omitempty"`
-	CheckProtocolVersion     *bool  `json:"check_protocol_version,omitempty"`
-	MinimumProtocol          string `json:"minimum_protocol,omitempty"`
+	Domain                    string `json:"domain,omitempty"`
+	ServerName                string `json:"server_name,omitempty"`
+	CheckExpirationThreshold  *bool  `json:"check_expiration_threshold,omitempty"`
+	ExpirationThreshold       int    `json:"expiration_threshold,omitempty"`
+	CheckProtocolVersion      *bool  `json:"check_protocol_version,omitempty"`
+	MinimumProtocol           string `json:"minimum_protocol,omitempty"`
+	CheckChain                *bool  `json:"check_chain,omitempty"`
+	ExpectedIssuer            string `json:"expected_issuer,omitempty"`
+	CheckRevocation           *bool  `json:"check_revocation,omitempty"`
+	ExpectedFingerprintSHA256 string `json:"expected_fingerprint_sha256,omitempty"`
+
+	// Result storage
+	ResultSampling *ResultSampling `json:"result_sampling,omitempty"`
+
+	// Per-phase latency thresholds (HTTP only)
+	PhaseThresholds *PhaseTimings `json:"phase_thresholds,omitempty"`
+
+	// Time-windowed threshold overrides
+	ThresholdWindows []ThresholdWindow `json:"threshold_windows,omitempty"`
+
+	// Active hours; checks only run inside these windows
+	Schedule []ActiveHours `json:"schedule,omitempty"`
+
+	// Incident handling
+	SeverityMapping         map[string]string `json:"severity_mapping,omitempty"`
+	AutoResolveAfterMinutes int               `json:"auto_resolve_after_minutes,omitempty"`
+	ReopenWindowMinutes     int               `json:"reopen_window_minutes,omitempty"`
+
+	// Dependencies
+	DependsOnMonitorIDs []string `json:"depends_on_monitor_ids,omitempty"`
+	InheritMaintenance  *bool    `json:"inherit_maintenance,omitempty"`
+
+	// Maintenance
+	PauseDuringMaintenance *bool `json:"pause_during_maintenance,omitempty"`
 }
 
 // ListMonitorsResponse is the response for listing monitors.
@@ -124,46 +304,219 @@ type ListMonitorsResponse struct {
 	Total    int       `json:"total"`
 }
 
+// BulkCreateMonitorsRequest is the request body for creating monitors in bulk.
+type BulkCreateMonitorsRequest struct {
+	Monitors []CreateMonitorRequest `json:"monitors"`
+}
+
+// BulkMonitorUpdate is a single update in a bulk update request.
+type BulkMonitorUpdate struct {
+	ID string `json:"id"`
+
+	// IfMatch is the ETag the monitor must still have, as for
+	// UpdateMonitorRequest.IfMatch.
+	IfMatch string `json:"if_match,omitempty"`
+
+	UpdateMonitorRequest
+
+	// Fields, when set, limits the update to these fields, as with
+	// PatchMonitor.
+	Fields []string `json:"-"`
+}
+
+// BulkUpdateMonitorsRequest is the request body for updating monitors in bulk.
+type BulkUpdateMonitorsRequest struct {
+	Monitors []BulkMonitorUpdate `json:"monitors"`
+}
+
+// BulkDeleteMonitorsRequest is the request body for deleting monitors in bulk.
+type BulkDeleteMonitorsRequest struct {
+	IDs []string `json:"ids"`
+}
+
+// BulkMonitorResult is the outcome of a single operation of a bulk request.
+// Monitor is not set for deletions.
+type BulkMonitorResult struct {
+	Monitor *Monitor       `json:"monitor,omitempty"`
+	ETag    string         `json:"etag,omitempty"`
+	Error   *BulkItemError `json:"error,omitempty"`
+}
+
+// BulkItemError describes why a single operation of a bulk request failed.
+type BulkItemError struct {
+	StatusCode int    `json:"status_code"`
+	Error      string `json:"error"`
+	Message    string `json:"message"`
+}
+
+// BulkMonitorsResponse is the response for bulk monitor requests, with one
+// result per operation in request order.
+type BulkMonitorsResponse struct {
+	Results []BulkMonitorResult `json:"results"`
+}
+
+// BrowserMonitor represents a scripted browser synthetic monitor.
+type BrowserMonitor struct {
+	ID                    string           `json:"id,omitempty"`
+	UserID                string           `json:"user_id,omitempty"`
+	Name                  string           `json:"name,omitempty"`
+	IsEnabled             bool             `json:"is_enabled"`
+	FrequencySeconds      int              `json:"frequency_seconds,omitempty"`
+	TimeoutMs             int              `json:"timeout_ms,omitempty"`
+	GeneralRegion         string           `json:"general_region,omitempty"`
+	StartURL              string           `json:"start_url,omitempty"`
+	Steps                 []BrowserStep    `json:"steps,omitempty"`
+	Recording             string           `json:"recording,omitempty"`
+	Viewport              *BrowserViewport `json:"viewport,omitempty"`
+	Device                string           `json:"device,omitempty"`
+	ScreenshotOnFailure   bool             `json:"screenshot_on_failure"`
+	CaptureHAR            bool             `json:"capture_har"`
+	ArtifactRetentionDays int              `json:"artifact_retention_days,omitempty"`
+	Status                string           `json:"status,omitempty"`
+	LastChecked           string           `json:"last_checked,omitempty"`
+	CreatedAt             string           `json:"created_at,omitempty"`
+	UpdatedAt             string           `json:"updated_at,omitempty"`
+}
+
+// BrowserStep is a single action in a browser monitor scenario.
+type BrowserStep struct {
+	Action    string `json:"action"`
+	Selector  string `json:"selector,omitempty"`
+	Value     string `json:"value,omitempty"`
+	TimeoutMs int    `json:"timeout_ms,omitempty"`
+}
+
+// BrowserViewport is the browser window size used by a browser monitor.
+type BrowserViewport struct {
+	Width  int `json:"width"`
+	Height int `json:"height"`
+}
+
+// BrowserMonitorRequest is the request body for creating or updating a
+// browser monitor.
+type BrowserMonitorRequest struct {
+	Name                  string           `json:"name"`
+	IsEnabled             *bool            `json:"is_enabled,omitempty"`
+	FrequencySeconds      int              `json:"frequency_seconds,omitempty"`
+	TimeoutMs             int              `json:"timeout_ms,omitempty"`
+	GeneralRegion         string           `json:"general_region,omitempty"`
+	StartURL              string           `json:"start_url"`
+	Steps                 []BrowserStep    `json:"steps,omitempty"`
+	Recording             string           `json:"recording,omitempty"`
+	Viewport              *BrowserViewport `json:"viewport,omitempty"`
+	Device                string           `json:"device,omitempty"`
+	ScreenshotOnFailure   *bool            `json:"screenshot_on_failure,omitempty"`
+	CaptureHAR            *bool            `json:"capture_har,omitempty"`
+	ArtifactRetentionDays int              `json:"artifact_retention_days,omitempty"`
+}
+
 // Alert represents an alert configuration.
 type Alert struct {
-	ID                 string `json:"id,omitempty"`
-	UserID             string `json:"user_id,omitempty"`
-	MonitorID          string `json:"monitor_id,omitempty"`
-	Type               string `json:"type,omitempty"`
-	Target             string `json:"target,omitempty"`
-	IsEnabled          bool   `json:"is_enabled,omitempty"`
-	TriggerThreshold   int    `json:"trigger_threshold,omitempty"`
-	RecoveryThreshold  int    `json:"recovery_threshold,omitempty"`
-	MinIntervalMinutes int    `json:"min_interval_minutes,omitempty"`
-	CustomMessage      string `json:"custom_message,omitempty"`
-	IncludeDetails     bool   `json:"include_details,omitempty"`
-	LastTriggeredAt    string `json:"last_triggered_at,omitempty"`
-	CreatedAt          string `json:"created_at,omitempty"`
-	UpdatedAt          string `json:"updated_at,omitempty"`
+	// ETag identifies the version of the alert the API returned. It is
+	// read from the response headers.
+	ETag string `json:"-"`
+
+	ID                        string            `json:"id,omitempty"`
+	UserID                    string            `json:"user_id,omitempty"`
+	MonitorID                 string            `json:"monitor_id,omitempty"`
+	Type                      AlertType         `json:"type,omitempty"`
+	Target                    string            `json:"target,omitempty"`
+	IsEnabled                 bool              `json:"is_enabled,omitempty"`
+	TriggerThreshold          int               `json:"trigger_threshold,omitempty"`
+	RecoveryThreshold         int               `json:"recovery_threshold,omitempty"`
+	MinIntervalMinutes        int               `json:"min_interval_minutes,omitempty"`
+	CustomMessage             string            `json:"custom_message,omitempty"`
+	IncludeDetails            bool              `json:"include_details,omitempty"`
+	IntegrationID             string            `json:"integration_id,omitempty"`
+	Opsgenie                  *OpsgenieConfig   `json:"opsgenie,omitempty"`
+	PhoneCountry              string            `json:"phone_country,omitempty"`
+	SenderID                  string            `json:"sender_id,omitempty"`
+	PayloadTemplate           string            `json:"payload_template,omitempty"`
+	Headers                   map[string]string `json:"headers,omitempty"`
+	Severity                  Severity          `json:"severity,omitempty"`
+	ActiveHours               *ActiveHours      `json:"active_hours,omitempty"`
+	SuppressDuringMaintenance bool              `json:"suppress_during_maintenance,omitempty"`
+	MaintenanceWindowIDs      []string          `json:"maintenance_window_ids,omitempty"`
+	ManagedBy                 string            `json:"managed_by,omitempty"`
+	LastTriggeredAt           string            `json:"last_triggered_at,omitempty"`
+	CreatedAt                 string            `json:"created_at,omitempty"`
+	UpdatedAt                 string            `json:"updated_at,omitempty"`
+}
+
+// AlertDefaults are the alert settings applied to alerts that do not set
+// them.
+type AlertDefaults struct {
+	TriggerThreshold   int
+	RecoveryThreshold  int
+	MinIntervalMinutes int
 }
 
 // CreateAlertRequest is the request body for creating an alert.
 type CreateAlertRequest struct {
-	MonitorID          string `json:"monitor_id"`
-	Type               string `json:"type"`
-	Target             string `json:"target"`
-	IsEnabled          *bool  `json:"is_enabled,omitempty"`
-	TriggerThreshold   int    `json:"trigger_threshold,omitempty"`
-	RecoveryThreshold  int    `json:"recovery_threshold,omitempty"`
-	MinIntervalMinutes int    `json:"min_interval_minutes,omitempty"`
-	CustomMessage      string `json:"custom_message,omitempty"`
-	IncludeDetails     *bool  `json:"include_details,omitempty"`
+	MonitorID                 string            `json:"monitor_id"`
+	Type                      AlertType         `json:"type"`
+	Target                    string            `json:"target,omitempty"`
+	IsEnabled                 *bool             `json:"is_enabled,omitempty"`
+	TriggerThreshold          int               `json:"trigger_threshold,omitempty"`
+	RecoveryThreshold         int               `json:"recovery_threshold,omitempty"`
+	MinIntervalMinutes        int               `json:"min_interval_minutes,omitempty"`
+	CustomMessage             string            `json:"custom_message,omitempty"`
+	IncludeDetails            *bool             `json:"include_details,omitempty"`
+	IntegrationID             string            `json:"integration_id,omitempty"`
+	Opsgenie                  *OpsgenieConfig   `json:"opsgenie,omitempty"`
+	PhoneCountry              string            `json:"phone_country,omitempty"`
+	SenderID                  string            `json:"sender_id,omitempty"`
+	PayloadTemplate           string            `json:"payload_template,omitempty"`
+	Headers                   map[string]string `json:"headers,omitempty"`
+	Severity                  Severity          `json:"severity,omitempty"`
+	ActiveHours               *ActiveHours      `json:"active_hours,omitempty"`
+	SuppressDuringMaintenance *bool             `json:"suppress_during_maintenance,omitempty"`
+	MaintenanceWindowIDs      []string          `json:"maintenance_window_ids,omitempty"`
+	ManagedBy                 string            `json:"managed_by,omitempty"`
 }
 
 // UpdateAlertRequest is the request body for updating an alert.
 type UpdateAlertRequest struct {
-	Target             string `json:"target,omitempty"`
-	IsEnabled          *bool  `json:"is_enabled,omitempty"`
-	TriggerThreshold   int    `json:"trigger_threshold,omitempty"`
-	RecoveryThreshold  int    `json:"recovery_threshold,omitempty"`
-	MinIntervalMinutes int    `json:"min_interval_minutes,omitempty"`
-	CustomMessage      string `json:"custom_message,omitempty"`
-	IncludeDetails     *bool  `json:"include_details,omitempty"`
+	// IfMatch, when set, makes the update fail with 412 Precondition Failed
+	// if the alert no longer has this ETag. It is sent as a header.
+	IfMatch string `json:"-"`
+
+	Target                    string            `json:"target,omitempty"`
+	IsEnabled                 *bool             `json:"is_enabled,omitempty"`
+	TriggerThreshold          int               `json:"trigger_threshold,omitempty"`
+	RecoveryThreshold         int               `json:"recovery_threshold,omitempty"`
+	MinIntervalMinutes        int               `json:"min_interval_minutes,omitempty"`
+	CustomMessage             string            `json:"custom_message,omitempty"`
+	IncludeDetails            *bool             `json:"include_details,omitempty"`
+	IntegrationID             string            `json:"integration_id,omitempty"`
+	Opsgenie                  *OpsgenieConfig   `json:"opsgenie,omitempty"`
+	PhoneCountry              string            `json:"phone_country,omitempty"`
+	SenderID                  string            `json:"sender_id,omitempty"`
+	PayloadTemplate           string            `json:"payload_template,omitempty"`
+	Headers                   map[string]string `json:"headers,omitempty"`
+	Severity                  Severity          `json:"severity,omitempty"`
+	ActiveHours               *ActiveHours      `json:"active_hours,omitempty"`
+	SuppressDuringMaintenance *bool             `json:"suppress_during_maintenance,omitempty"`
+	MaintenanceWindowIDs      []string          `json:"maintenance_window_ids,omitempty"`
+	ManagedBy                 string            `json:"managed_by,omitempty"`
+}
+
+// OpsgenieConfig holds the routing settings for opsgenie alerts. The API key
+// is write-only and is not returned by the API.
+type OpsgenieConfig struct {
+	APIKey   string `json:"api_key,omitempty"`
+	Team     string `json:"team,omitempty"`
+	Region   string `json:"region,omitempty"`
+	Priority string `json:"priority,omitempty"`
+}
+
+// ActiveHours restricts when an alert sends notifications, or when a
+// monitor runs checks.
+type ActiveHours struct {
+	Days      []string `json:"days"`
+	StartTime string   `json:"start_time"`
+	EndTime   string   `json:"end_time"`
+	Timezone  string   `json:"timezone"`
 }
 
 // ListAlertsResponse is the response for listing alerts.
@@ -171,6 +524,78 @@ type ListAlertsResponse struct {
 	Alerts []Alert `json:"alerts"`
 }
 
+// TestNotificationResponse is the response for sending a test notification
+// through an alert.
+type TestNotificationResponse struct {
+	Delivered    bool   `json:"delivered"`
+	ResponseCode int    `json:"response_code,omitempty"`
+	ErrorMessage string `json:"error_message,omitempty"`
+}
+
+// PagerDutyIntegration represents a PagerDuty service integration. The
+// routing key is never returned by the API.
+type PagerDutyIntegration struct {
+	ID             string `json:"id,omitempty"`
+	UserID         string `json:"user_id,omitempty"`
+	Name           string `json:"name,omitempty"`
+	ServiceName    string `json:"service_name,omitempty"`
+	RoutingKeyHint string `json:"routing_key_hint,omitempty"`
+	CreatedAt      string `json:"created_at,omitempty"`
+	UpdatedAt      string `json:"updated_at,omitempty"`
+}
+
+// CreatePagerDutyIntegrationRequest is the request body for creating a PagerDuty integration.
+type CreatePagerDutyIntegrationRequest struct {
+	Name        string `json:"name"`
+	ServiceName string `json:"service_name,omitempty"`
+	RoutingKey  string `json:"routing_key"`
+}
+
+// UpdatePagerDutyIntegrationRequest is the request body for updating a PagerDuty integration.
+type UpdatePagerDutyIntegrationRequest struct {
+	Name        string `json:"name,omitempty"`
+	ServiceName string `json:"service_name,omitempty"`
+	RoutingKey  string `json:"routing_key,omitempty"`
+}
+
+// ArtifactsBucket represents S3-compatible storage for failure artifacts
+// such as screenshots and HAR files. Credentials are never returned by the
+// API.
+type ArtifactsBucket struct {
+	ID                    string `json:"id,omitempty"`
+	UserID                string `json:"user_id,omitempty"`
+	Bucket                string `json:"bucket,omitempty"`
+	Endpoint              string `json:"endpoint,omitempty"`
+	Region                string `json:"region,omitempty"`
+	PathPrefix            string `json:"path_prefix,omitempty"`
+	ArtifactRetentionDays int    `json:"artifact_retention_days,omitempty"`
+	AccessKeyIDHint       string `json:"access_key_id_hint,omitempty"`
+	CreatedAt             string `json:"created_at,omitempty"`
+	UpdatedAt             string `json:"updated_at,omitempty"`
+}
+
+// CreateArtifactsBucketRequest is the request body for configuring artifact storage.
+type CreateArtifactsBucketRequest struct {
+	Bucket                string `json:"bucket"`
+	Endpoint              string `json:"endpoint,omitempty"`
+	Region                string `json:"region,omitempty"`
+	PathPrefix            string `json:"path_prefix,omitempty"`
+	ArtifactRetentionDays int    `json:"artifact_retention_days,omitempty"`
+	AccessKeyID           string `json:"access_key_id"`
+	SecretAccessKey       string `json:"secret_access_key"`
+}
+
+// UpdateArtifactsBucketRequest is the request body for updating artifact storage.
+type UpdateArtifactsBucketRequest struct {
+	Bucket                string `json:"bucket,omitempty"`
+	Endpoint              string `json:"endpoint,omitempty"`
+	Region                string `json:"region,omitempty"`
+	PathPrefix            string `json:"path_prefix,omitempty"`
+	ArtifactRetentionDays int    `json:"artifact_retention_days,omitempty"`
+	AccessKeyID           string `json:"access_key_id,omitempty"`
+	SecretAccessKey       string `json:"secret_access_key,omitempty"`
+}
+
 // ExternalLink represents an external link on a system.
 type ExternalLink struct {
 	Name string `json:"name,omitempty"`
@@ -179,51 +604,64 @@ type ExternalLink struct {
 
 // System represents a system grouping of monitors.
 type System struct {
-	ID            string         `json:"id,omitempty"`
-	UserID        string         `json:"user_id,omitempty"`
-	Name          string         `json:"name,omitempty"`
-	Description   string         `json:"description,omitempty"`
-	Priority      string         `json:"priority,omitempty"`
-	Status        string         `json:"status,omitempty"`
-	ExternalLinks []ExternalLink `json:"external_links,omitempty"`
-	CreatedAt     string         `json:"created_at,omitempty"`
-	UpdatedAt     string         `json:"updated_at,omitempty"`
+	ID                       string         `json:"id,omitempty"`
+	UserID                   string         `json:"user_id,omitempty"`
+	Name                     string         `json:"name,omitempty"`
+	Description              string         `json:"description,omitempty"`
+	Priority                 string         `json:"priority,omitempty"`
+	Status                   string         `json:"status,omitempty"`
+	ExternalLinks            []ExternalLink `json:"external_links,omitempty"`
+	DefaultAlertChannelIDs   []string       `json:"default_alert_channel_ids,omitempty"`
+	DegradedThresholdPercent int            `json:"degraded_threshold_percent,omitempty"`
+	DownThresholdPercent     int            `json:"down_threshold_percent,omitempty"`
+	CreatedAt                string         `json:"created_at,omitempty"`
+	UpdatedAt                string         `json:"updated_at,omitempty"`
 }
 
 // SystemWithStats represents a system with aggregated statistics.
 type SystemWithStats struct {
-	ID            string         `json:"id,omitempty"`
-	UserID        string         `json:"user_id,omitempty"`
-	Name          string         `json:"name,omitempty"`
-	Description   string         `json:"description,omitempty"`
-	Priority      string         `json:"priority,omitempty"`
-	Status        string         `json:"status,omitempty"`
-	ExternalLinks []ExternalLink `json:"external_links,omitempty"`
-	MonitorCount  int            `json:"monitor_count,omitempty"`
-	HealthyCount  int            `json:"healthy_count,omitempty"`
-	DegradedCount int            `json:"degraded_count,omitempty"`
-	ErrorCount    int            `json:"error_count,omitempty"`
-	WarningCount  int            `json:"warning_count,omitempty"`
-	OverallUptime float64        `json:"overall_uptime,omitempty"`
-	CreatedAt     string         `json:"created_at,omitempty"`
-	UpdatedAt     string         `json:"updated_at,omitempty"`
+	ID                       string         `json:"id,omitempty"`
+	UserID                   string         `json:"user_id,omitempty"`
+	Name                     string         `json:"name,omitempty"`
+	Description              string         `json:"description,omitempty"`
+	Priority                 string         `json:"priority,omitempty"`
+	Status                   string         `json:"status,omitempty"`
+	ExternalLinks            []ExternalLink `json:"external_links,omitempty"`
+	DefaultAlertChannelIDs   []string       `json:"default_alert_channel_ids,omitempty"`
+	DegradedThresholdPercent int            `json:"degraded_threshold_percent,omitempty"`
+	DownThresholdPercent     int            `json:"down_threshold_percent,omitempty"`
+	MonitorCount             int            `json:"monitor_count,omitempty"`
+	HealthyCount             int            `json:"healthy_count,omitempty"`
+	DegradedCount            int            `json:"degraded_count,omitempty"`
+	ErrorCount               int            `json:"error_count,omitempty"`
+	WarningCount             int            `json:"warning_count,omitempty"`
+	OverallUptime            float64        `json:"overall_uptime,omitempty"`
+	CreatedAt                string         `json:"created_at,omitempty"`
+	UpdatedAt                string         `json:"updated_at,omitempty"`
 }
 
 // CreateSystemRequest is the request body for creating a system.
 type CreateSystemRequest struct {
-	Name          string         `json:"name"`
-	Description   string         `json:"description,omitempty"`
-	Priority      string         `json:"priority,omitempty"`
-	ExternalLinks []ExternalLink `json:"external_links,omitempty"`
-	MonitorIDs    []string       `json:"monitor_ids"`
+	Name                     string         `json:"name"`
+	Description              string         `json:"description,omitempty"`
+	Priority                 string         `json:"priority,omitempty"`
+	ExternalLinks            []ExternalLink `json:"external_links,omitempty"`
+	DefaultAlertChannelIDs   []string       `json:"default_alert_channel_ids,omitempty"`
+	DegradedThresholdPercent *int           `json:"degraded_threshold_percent,omitempty"`
+	DownThresholdPercent     *int           `json:"down_threshold_percent,omitempty"`
+	MonitorIDs               []string       `json:"monitor_ids"`
 }
 
-// UpdateSystemRequest is the request body for updating a system.
+// UpdateSystemRequest is the request body for updating a system. Nil
+// thresholds are sent as null, which resets them to the API default.
 type UpdateSystemRequest struct {
-	Name          string         `json:"name,omitempty"`
-	Description   string         `json:"description,omitempty"`
-	Priority      string         `json:"priority,omitempty"`
-	ExternalLinks []ExternalLink `json:"external_links,omitempty"`
+	Name                     string         `json:"name,omitempty"`
+	Description              string         `json:"description,omitempty"`
+	Priority                 string         `json:"priority,omitempty"`
+	ExternalLinks            []ExternalLink `json:"external_links,omitempty"`
+	DefaultAlertChannelIDs   []string       `json:"default_alert_channel_ids"`
+	DegradedThresholdPercent *int           `json:"degraded_threshold_percent"`
+	DownThresholdPercent     *int           `json:"down_threshold_percent"`
 }
 
 // ListSystemsResponse is the response for listing systems.
@@ -232,6 +670,11 @@ type ListSystemsResponse struct {
 	Total   int               `json:"total"`
 }
 
+// ListSystemMonitorsResponse is the response for listing the monitors in a system.
+type ListSystemMonitorsResponse struct {
+	MonitorIDs []string `json:"monitor_ids"`
+}
+
 // ModifyMonitorsRequest is the request for adding/removing monitors from a system.
 type ModifyMonitorsRequest struct {
 	MonitorIDs []string `json:"monitor_ids"`
@@ -249,6 +692,8 @@ type Report struct {
 	EndTime       string   `json:"end_time,omitempty"`
 	MonitorIDs    []string `json:"monitor_ids,omitempty"`
 	Metrics       string   `json:"metrics,omitempty"`
+	Locale        string   `json:"locale,omitempty"`
+	Timezone      string   `json:"timezone,omitempty"`
 	Data          string   `json:"data,omitempty"`
 	FilePath      string   `json:"file_path,omitempty"`
 	FileSizeBytes int      `json:"file_size_bytes,omitempty"`
@@ -267,6 +712,8 @@ type CreateReportRequest struct {
 	MonitorIDs []string `json:"monitor_ids,omitempty"`
 	SystemIDs  []string `json:"system_ids,omitempty"`
 	Metrics    string   `json:"metrics,omitempty"`
+	Locale     string   `json:"locale,omitempty"`
+	Timezone   string   `json:"timezone,omitempty"`
 }
 
 // ListReportsResponse is the response for listing reports.
@@ -294,6 +741,13 @@ type MonitorResult struct {
 	DNSResponse               string `json:"dns_response,omitempty"`
 	TLSVersion                string `json:"tls_version,omitempty"`
 	CertificateExpirationDays int    `json:"certificate_expiration_days,omitempty"`
+
+	// Only set for HTTP checks.
+	Timings *PhaseTimings `json:"timings,omitempty"`
+
+	// Only set when anomaly detection is enabled for the monitor.
+	IsAnomaly         *bool    `json:"is_anomaly,omitempty"`
+	BaselineDeviation *float64 `json:"baseline_deviation,omitempty"`
 }
 
 // GetResultsResponse is the response for getting monitor results.
@@ -302,6 +756,36 @@ type GetResultsResponse struct {
 	Total   int             `json:"total"`
 }
 
+// RunCheckRequest is the request body for running an immediate check.
+type RunCheckRequest struct {
+	Region string `json:"region,omitempty"`
+}
+
+// RunCheckResponse is the response for running an immediate check, with a
+// result for each region checked.
+type RunCheckResponse struct {
+	Results []MonitorResult `json:"results"`
+}
+
+// ResolveIncidentsResponse is the response for resolving a monitor's open
+// incidents.
+type ResolveIncidentsResponse struct {
+	Resolved int `json:"resolved"`
+}
+
+// ResultArtifactURL is a short-lived signed URL for a result's failure
+// artifact.
+type ResultArtifactURL struct {
+	URL       string `json:"url"`
+	ExpiresAt string `json:"expires_at,omitempty"`
+}
+
+// Badge is a public uptime badge for a monitor or system.
+type Badge struct {
+	URL string `json:"url"`
+	SVG string `json:"svg,omitempty"`
+}
+
 // GetUptimeResponse is the response for getting monitor uptime.
 type GetUptimeResponse struct {
 	MonitorID string  `json:"monitor_id"`
@@ -309,19 +793,39 @@ type GetUptimeResponse struct {
 	Uptime    float64 `json:"uptime"`
 }
 
+// LatencyHistogram is the response time distribution of a monitor's
+// successful checks over a time window.
+type LatencyHistogram struct {
+	MonitorID  string          `json:"monitor_id"`
+	Hours      int             `json:"hours"`
+	TotalCount int             `json:"total_count"`
+	P50Ms      float64         `json:"p50_ms"`
+	P95Ms      float64         `json:"p95_ms"`
+	P99Ms      float64         `json:"p99_ms"`
+	Buckets    []LatencyBucket `json:"buckets"`
+}
+
+// LatencyBucket counts the checks with a response time above the previous
+// bucket's upper bound and at most UpperBoundMs. The last bucket has no upper
+// bound.
+type LatencyBucket struct {
+	UpperBoundMs *int `json:"upper_bound_ms"`
+	Count        int  `json:"count"`
+}
+
 // Incident represents a monitor incident.
 type Incident struct {
-	ID              string `json:"id,omitempty"`
-	MonitorID       string `json:"monitor_id,omitempty"`
-	Status          string `json:"status,omitempty"`
-	Severity        string `json:"severity,omitempty"`
-	Summary         string `json:"summary,omitempty"`
-	Details         string `json:"details,omitempty"`
-	FirstErrorID    string `json:"first_error_id,omitempty"`
-	StartedAt       string `json:"started_at,omitempty"`
-	ResolvedAt      string `json:"resolved_at,omitempty"`
-	DurationSeconds int    `json:"duration_seconds,omitempty"`
-	Notified        bool   `json:"notified,omitempty"`
+	ID              string   `json:"id,omitempty"`
+	MonitorID       string   `json:"monitor_id,omitempty"`
+	Status          string   `json:"status,omitempty"`
+	Severity        Severity `json:"severity,omitempty"`
+	Summary         string   `json:"summary,omitempty"`
+	Details         string   `json:"details,omitempty"`
+	FirstErrorID    string   `json:"first_error_id,omitempty"`
+	StartedAt       string   `json:"started_at,omitempty"`
+	ResolvedAt      string   `json:"resolved_at,omitempty"`
+	DurationSeconds int      `json:"duration_seconds,omitempty"`
+	Notified        bool     `json:"notified,omitempty"`
 }
 
 // GetIncidentsResponse is the response for getting monitor incidents.
@@ -343,6 +847,10 @@ type MonitorHealthInfo struct {
 	FailureRate     float64 `json:"failure_rate,omitempty"`
 	P95LatencyMs    int     `json:"p95_latency_ms,omitempty"`
 	StuckCount      int     `json:"stuck_count,omitempty"`
+
+	// Schedule after plan-based throttling and dampening.
+	EffectiveFrequencySeconds int    `json:"effective_frequency_seconds,omitempty"`
+	NextCheckAt               string `json:"next_check_at,omitempty"`
 }
 
 // UserHealthSummary represents health summary for a user.
@@ -384,6 +892,18 @@ type NotificationHistory struct {
 	CreatedAt        string `json:"created_at,omitempty"`
 }
 
+// NotificationFilter narrows a notification history listing. Empty fields
+// are not filtered on.
+type NotificationFilter struct {
+	MonitorID string
+	AlertID   string
+	Status    string
+	EventType string
+	// Since and Until bound the creation time, in RFC 3339 format.
+	Since string
+	Until string
+}
+
 // ListNotificationHistoryResponse is the response for listing notification history.
 type ListNotificationHistoryResponse struct {
 	Notifications []NotificationHistory `json:"notifications"`
@@ -393,6 +913,203 @@ type ListNotificationHistoryResponse struct {
 	Pages         int                   `json:"pages"`
 }
 
+// Annotation represents an event marker on a monitor's timeline.
+type Annotation struct {
+	ID        string `json:"id,omitempty"`
+	UserID    string `json:"user_id,omitempty"`
+	MonitorID string `json:"monitor_id,omitempty"`
+	Timestamp string `json:"timestamp,omitempty"`
+	Text      string `json:"text,omitempty"`
+	Link      string `json:"link,omitempty"`
+	CreatedAt string `json:"created_at,omitempty"`
+	UpdatedAt string `json:"updated_at,omitempty"`
+}
+
+// CreateAnnotationRequest is the request body for creating an annotation.
+type CreateAnnotationRequest struct {
+	MonitorID string `json:"monitor_id"`
+	Timestamp string `json:"timestamp,omitempty"`
+	Text      string `json:"text"`
+	Link      string `json:"link,omitempty"`
+}
+
+// UpdateAnnotationRequest is the request body for updating an annotation.
+// Link is always sent, so an empty link removes it.
+type UpdateAnnotationRequest struct {
+	Timestamp string `json:"timestamp,omitempty"`
+	Text      string `json:"text,omitempty"`
+	Link      string `json:"link"`
+}
+
+// ListAnnotationsResponse is the response for listing annotations.
+type ListAnnotationsResponse struct {
+	Annotations []Annotation `json:"annotations"`
+}
+
+// MaintenanceWindow represents a scheduled maintenance window for monitors
+// and systems.
+type MaintenanceWindow struct {
+	ID          string   `json:"id,omitempty"`
+	UserID      string   `json:"user_id,omitempty"`
+	Name        string   `json:"name,omitempty"`
+	Description string   `json:"description,omitempty"`
+	StartTime   string   `json:"start_time,omitempty"`
+	EndTime     string   `json:"end_time,omitempty"`
+	MonitorIDs  []string `json:"monitor_ids,omitempty"`
+	SystemIDs   []string `json:"system_ids,omitempty"`
+	CreatedAt   string   `json:"created_at,omitempty"`
+	UpdatedAt   string   `json:"updated_at,omitempty"`
+}
+
+// CreateMaintenanceWindowRequest is the request body for creating a maintenance window.
+type CreateMaintenanceWindowRequest struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	StartTime   string   `json:"start_time"`
+	EndTime     string   `json:"end_time"`
+	MonitorIDs  []string `json:"monitor_ids"`
+	SystemIDs   []string `json:"system_ids"`
+}
+
+// UpdateMaintenanceWindowRequest is the request body for updating a maintenance window.
+type UpdateMaintenanceWindowRequest struct {
+	Name        string   `json:"name,omitempty"`
+	Description string   `json:"description,omitempty"`
+	StartTime   string   `json:"start_time,omitempty"`
+	EndTime     string   `json:"end_time,omitempty"`
+	MonitorIDs  []string `json:"monitor_ids"`
+	SystemIDs   []string `json:"system_ids"`
+}
+
+// SLO represents a service level objective for a monitor or system, with
+// its current error budget.
+type SLO struct {
+	ID                          string  `json:"id,omitempty"`
+	UserID                      string  `json:"user_id,omitempty"`
+	Name                        string  `json:"name,omitempty"`
+	Description                 string  `json:"description,omitempty"`
+	TargetPercentage            float64 `json:"target_percentage,omitempty"`
+	WindowDays                  int     `json:"window_days,omitempty"`
+	MonitorID                   string  `json:"monitor_id,omitempty"`
+	SystemID                    string  `json:"system_id,omitempty"`
+	CurrentPercentage           float64 `json:"current_percentage"`
+	BurnRate                    float64 `json:"burn_rate"`
+	ErrorBudgetRemainingPercent float64 `json:"error_budget_remaining_percent"`
+	ErrorBudgetRemainingMinutes float64 `json:"error_budget_remaining_minutes"`
+	CreatedAt                   string  `json:"created_at,omitempty"`
+	UpdatedAt                   string  `json:"updated_at,omitempty"`
+}
+
+// CreateSLORequest is the request body for creating an SLO.
+type CreateSLORequest struct {
+	Name             string  `json:"name"`
+	Description      string  `json:"description,omitempty"`
+	TargetPercentage float64 `json:"target_percentage"`
+	WindowDays       int     `json:"window_days"`
+	MonitorID        string  `json:"monitor_id,omitempty"`
+	SystemID         string  `json:"system_id,omitempty"`
+}
+
+// UpdateSLORequest is the request body for updating an SLO.
+type UpdateSLORequest struct {
+	Name             string  `json:"name,omitempty"`
+	Description      string  `json:"description,omitempty"`
+	TargetPercentage float64 `json:"target_percentage,omitempty"`
+	WindowDays       int     `json:"window_days,omitempty"`
+}
+
+// LimitAlert notifies a channel when the account reaches a plan limit.
+type LimitAlert struct {
+	ID                 string   `json:"id,omitempty"`
+	UserID             string   `json:"user_id,omitempty"`
+	Type               string   `json:"type,omitempty"`
+	Target             string   `json:"target,omitempty"`
+	Events             []string `json:"events,omitempty"`
+	IsEnabled          bool     `json:"is_enabled"`
+	MinIntervalMinutes int      `json:"min_interval_minutes,omitempty"`
+	LastTriggeredAt    string   `json:"last_triggered_at,omitempty"`
+	CreatedAt          string   `json:"created_at,omitempty"`
+	UpdatedAt          string   `json:"updated_at,omitempty"`
+}
+
+// CreateLimitAlertRequest is the request body for creating a limit alert.
+type CreateLimitAlertRequest struct {
+	Type               string   `json:"type"`
+	Target             string   `json:"target"`
+	Events             []string `json:"events"`
+	IsEnabled          *bool    `json:"is_enabled,omitempty"`
+	MinIntervalMinutes int      `json:"min_interval_minutes,omitempty"`
+}
+
+// UpdateLimitAlertRequest is the request body for updating a limit alert.
+type UpdateLimitAlertRequest struct {
+	Target             string   `json:"target,omitempty"`
+	Events             []string `json:"events,omitempty"`
+	IsEnabled          *bool    `json:"is_enabled,omitempty"`
+	MinIntervalMinutes int      `json:"min_interval_minutes,omitempty"`
+}
+
+// Account represents the authenticated account and its plan limits.
+type Account struct {
+	ID                  string `json:"id,omitempty"`
+	Email               string `json:"email,omitempty"`
+	Plan                string `json:"plan,omitempty"`
+	MonitorLimit        int    `json:"monitor_limit,omitempty"`
+	MonitorCount        int    `json:"monitor_count,omitempty"`
+	RemainingMonitors   int    `json:"remaining_monitors,omitempty"`
+	AlertLimit          int    `json:"alert_limit,omitempty"`
+	AlertCount          int    `json:"alert_count,omitempty"`
+	RemainingAlerts     int    `json:"remaining_alerts,omitempty"`
+	MinFrequencySeconds int    `json:"min_frequency_seconds,omitempty"`
+}
+
+// OrganizationAccount is a child account of an organization with a summary
+// of its monitor fleet.
+type OrganizationAccount struct {
+	ID               string  `json:"id,omitempty"`
+	Name             string  `json:"name,omitempty"`
+	Email            string  `json:"email,omitempty"`
+	Plan             string  `json:"plan,omitempty"`
+	MonitorLimit     int     `json:"monitor_limit,omitempty"`
+	MonitorCount     int     `json:"monitor_count,omitempty"`
+	MonitorsUp       int     `json:"monitors_up,omitempty"`
+	MonitorsDegraded int     `json:"monitors_degraded,omitempty"`
+	MonitorsDown     int     `json:"monitors_down,omitempty"`
+	OpenIncidents    int     `json:"open_incidents,omitempty"`
+	Uptime24h        float64 `json:"uptime_24h,omitempty"`
+}
+
+// ListOrganizationAccountsResponse is the response for listing the child
+// accounts of an organization.
+type ListOrganizationAccountsResponse struct {
+	Accounts []OrganizationAccount `json:"accounts"`
+}
+
+// ChangeEvent is an entry in the account's configuration change feed.
+type ChangeEvent struct {
+	ID            string   `json:"id"`
+	Timestamp     string   `json:"timestamp"`
+	Actor         string   `json:"actor,omitempty"`
+	Source        string   `json:"source,omitempty"`
+	ResourceType  string   `json:"resource_type"`
+	ResourceID    string   `json:"resource_id"`
+	ResourceName  string   `json:"resource_name,omitempty"`
+	Action        string   `json:"action"`
+	ChangedFields []string `json:"changed_fields,omitempty"`
+}
+
+// ListChangesResponse is the response for listing configuration changes.
+type ListChangesResponse struct {
+	Changes []ChangeEvent `json:"changes"`
+}
+
+// Capabilities describes the version and optional features of an ackack
+// server.
+type Capabilities struct {
+	Version  string   `json:"version"`
+	Features []string `json:"features"`
+}
+
 // ErrorResponse is the API error response.
 type ErrorResponse struct {
 	Error   string `json:"error,omitempty"`
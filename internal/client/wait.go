@@ -0,0 +1,112 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	waitInitialInterval = 2 * time.Second
+	waitMaxInterval     = 30 * time.Second
+)
+
+// WaitForMonitorStatus polls a monitor until it reports the given status,
+// the timeout elapses, or the context is cancelled. Poll intervals start at
+// two seconds and double after each attempt, capped at thirty seconds.
+func (c *Client) WaitForMonitorStatus(ctx context.Context, id string, status MonitorStatus, timeout time.Duration) (*Monitor, error) {
+	var monitor *Monitor
+	var lastStatus MonitorStatus
+	err := poll(ctx, timeout, func(ctx context.Context) (bool, error) {
+		var err error
+		if monitor, err = c.GetMonitor(ctx, id); err != nil {
+			return false, err
+		}
+		lastStatus = monitor.Status
+		return monitor.Status == status, nil
+	}, func() error {
+		return fmt.Errorf("timed out waiting for monitor %s to reach status %q (last status %q)", id, status, lastStatus)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return monitor, nil
+}
+
+// WaitForFirstResults polls the results of a new monitor until each of the
+// given regions has reported a check, or any region has when regions is
+// empty, and returns the latest result of every region that reported. It
+// polls like WaitForMonitorStatus.
+func (c *Client) WaitForFirstResults(ctx context.Context, id string, regions []string, timeout time.Duration) ([]MonitorResult, error) {
+	var latest []MonitorResult
+	pending := regions
+	err := poll(ctx, timeout, func(ctx context.Context) (bool, error) {
+		results, err := c.GetMonitorResults(ctx, id, 100, "")
+		if err != nil {
+			return false, err
+		}
+
+		// Results are returned newest first.
+		seen := make(map[string]bool)
+		latest = nil
+		for _, result := range results {
+			if !seen[result.Region] {
+				seen[result.Region] = true
+				latest = append(latest, result)
+			}
+		}
+		pending = slices.DeleteFunc(slices.Clone(regions), func(region string) bool { return seen[region] })
+		return len(latest) > 0 && len(pending) == 0, nil
+	}, func() error {
+		if len(pending) == 0 {
+			return fmt.Errorf("timed out waiting for the first check of monitor %s", id)
+		}
+		return fmt.Errorf("timed out waiting for the first check of monitor %s in regions %s", id, strings.Join(pending, ", "))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return latest, nil
+}
+
+// poll calls check until it reports done or fails, the timeout elapses, or
+// ctx is cancelled, waiting with exponential backoff between calls. When
+// the timeout elapses, the error of timedOut is returned.
+func poll(ctx context.Context, timeout time.Duration, check func(ctx context.Context) (bool, error), timedOut func() error) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	interval := waitInitialInterval
+	for {
+		done, err := check(ctx)
+		if err != nil {
+			if errors.Is(err, context.DeadlineExceeded) {
+				return timedOut()
+			}
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return timedOut()
+			}
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+
+		interval = min(interval*2, waitMaxInterval)
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWaitForFirstResults(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(GetResultsResponse{Results: []MonitorResult{
+			{ID: 3, Region: "us-east", Status: "down"},
+			{ID: 2, Region: "eu-west", Status: "up"},
+			{ID: 1, Region: "us-east", Status: "up"},
+		}})
+	}))
+	defer server.Close()
+
+	c, err := NewClient("ak_test", server.URL, "test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	results, err := c.WaitForFirstResults(context.Background(), "m1", []string{"us-east", "eu-west"}, time.Minute)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(results) != 2 || results[0].ID != 3 || results[1].ID != 2 {
+		t.Errorf("expected the latest result of each region, got %+v", results)
+	}
+
+	_, err = c.WaitForFirstResults(context.Background(), "m1", []string{"us-east", "ap-south"}, 100*time.Millisecond)
+	if err == nil || !strings.Contains(err.Error(), "ap-south") || strings.Contains(err.Error(), "us-east") {
+		t.Errorf("expected a timeout naming the pending region, got %v", err)
+	}
+}
+
+func TestWaitForMonitorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/monitors/mon_error":
+			_ = json.NewEncoder(w).Encode(Monitor{ID: "mon_error", Status: MonitorStatusError})
+		case "/api/v1/monitors/mon_degraded":
+			_ = json.NewEncoder(w).Encode(Monitor{ID: "mon_degraded", Status: MonitorStatusDegraded})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c, err := NewClient("ak_test", server.URL, "test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	testCases := map[string]struct {
+		ctx     context.Context
+		id      string
+		timeout time.Duration
+		check   func(error) bool
+	}{
+		"reaches status": {
+			ctx: context.Background(), id: "mon_error", timeout: time.Minute,
+			check: func(err error) bool { return err == nil },
+		},
+		"monitor deleted": {
+			ctx: context.Background(), id: "mon_deleted", timeout: time.Minute,
+			check: IsNotFoundError,
+		},
+		"timeout": {
+			ctx: context.Background(), id: "mon_degraded", timeout: 100 * time.Millisecond,
+			check: func(err error) bool {
+				return err != nil && strings.Contains(err.Error(), "timed out") && strings.Contains(err.Error(), `last status "degraded"`)
+			},
+		},
+		"cancelled": {
+			ctx: cancelled, id: "mon_degraded", timeout: time.Minute,
+			check: func(err error) bool { return errors.Is(err, context.Canceled) },
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			start := time.Now()
+			monitor, err := c.WaitForMonitorStatus(tc.ctx, tc.id, MonitorStatusError, tc.timeout)
+			if !tc.check(err) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if err == nil && monitor.Status != MonitorStatusError {
+				t.Errorf("expected status %q, got %q", MonitorStatusError, monitor.Status)
+			}
+			if elapsed := time.Since(start); elapsed > 5*time.Second {
+				t.Errorf("expected the wait to stop promptly, took %s", elapsed)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package deprecation provides helpers for renaming schema attributes without
+// breaking existing configurations.
+//
+// During a rename both attributes stay in the schema: the old one carries a
+// DeprecationMessage from Message, the two are declared conflicting with
+// Conflicting, the configured value is taken from whichever is set with
+// Read, and values read back from the API are written to whichever attribute
+// the configuration uses with Write, so neither spelling shows a diff.
+package deprecation
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// Message returns the DeprecationMessage for an attribute renamed from
+// oldName to newName.
+func Message(oldName, newName string) string {
+	return fmt.Sprintf("Use %s instead. %s will be removed in the next major version of the provider.", newName, oldName)
+}
+
+// Conflicting returns a config validator that rejects configurations
+// setting both the old and the new attribute.
+func Conflicting(oldName, newName string) resource.ConfigValidator {
+	return resourcevalidator.Conflicting(path.MatchRoot(oldName), path.MatchRoot(newName))
+}
+
+// Read returns the configured value of a renamed attribute, preferring the
+// new attribute and falling back to the old one.
+func Read[T attr.Value](oldValue, newValue T) T {
+	if newValue.IsNull() {
+		return oldValue
+	}
+	return newValue
+}
+
+// Write stores value, read from the API, in the attribute the configuration
+// uses: the old attribute when it is set, the new one otherwise. The other
+// attribute is set to null.
+func Write[T attr.Value](value, null T, oldValue, newValue *T) {
+	if !(*oldValue).IsNull() {
+		*oldValue = value
+		*newValue = null
+		return
+	}
+	*oldValue = null
+	*newValue = value
+}
@@ -0,0 +1,46 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package deprecation
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRead(t *testing.T) {
+	tests := map[string]struct {
+		oldValue types.String
+		newValue types.String
+		expected types.String
+	}{
+		"new":     {types.StringNull(), types.StringValue("new"), types.StringValue("new")},
+		"old":     {types.StringValue("old"), types.StringNull(), types.StringValue("old")},
+		"neither": {types.StringNull(), types.StringNull(), types.StringNull()},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := Read(tt.oldValue, tt.newValue); !got.Equal(tt.expected) {
+				t.Errorf("expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestWrite(t *testing.T) {
+	value := types.StringValue("api")
+
+	oldValue, newValue := types.StringValue("configured"), types.StringNull()
+	Write(value, types.StringNull(), &oldValue, &newValue)
+	if !oldValue.Equal(value) || !newValue.IsNull() {
+		t.Errorf("expected the old attribute to be written, got old=%s new=%s", oldValue, newValue)
+	}
+
+	oldValue, newValue = types.StringNull(), types.StringNull()
+	Write(value, types.StringNull(), &oldValue, &newValue)
+	if !oldValue.IsNull() || !newValue.Equal(value) {
+		t.Errorf("expected the new attribute to be written, got old=%s new=%s", oldValue, newValue)
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ackack-io/terraform-provider-ackack/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ action.Action = &MonitorResetIncidentsAction{}
+var _ action.ActionWithConfigure = &MonitorResetIncidentsAction{}
+
+func NewMonitorResetIncidentsAction() action.Action {
+	return &MonitorResetIncidentsAction{}
+}
+
+// MonitorResetIncidentsAction defines the action implementation.
+type MonitorResetIncidentsAction struct {
+	client *client.Client
+}
+
+// MonitorResetIncidentsActionModel describes the action data model.
+type MonitorResetIncidentsActionModel struct {
+	MonitorID types.String `tfsdk:"monitor_id"`
+}
+
+func (a *MonitorResetIncidentsAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_monitor_reset_incidents"
+}
+
+func (a *MonitorResetIncidentsAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Resolves every open incident of a monitor, for example after fixing an outage that the monitor has not yet seen recover. " +
+			"The monitor opens a new incident if its next check still fails. Invoke it with `terraform apply -invoke`. " +
+			"Requires Terraform 1.14 or later.",
+
+		Attributes: map[string]schema.Attribute{
+			"monitor_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the monitor whose incidents to resolve.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (a *MonitorResetIncidentsAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = c
+}
+
+func (a *MonitorResetIncidentsAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
+	var data MonitorResetIncidentsActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resolved, err := a.client.ResolveMonitorIncidents(ctx, data.MonitorID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to resolve monitor incidents, got error: %s", err))
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Resolved %d open incident(s) of monitor %s", resolved, data.MonitorID.ValueString()),
+	})
+}
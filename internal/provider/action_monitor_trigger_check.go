@@ -0,0 +1,124 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ackack-io/terraform-provider-ackack/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ action.Action = &MonitorTriggerCheckAction{}
+var _ action.ActionWithConfigure = &MonitorTriggerCheckAction{}
+
+func NewMonitorTriggerCheckAction() action.Action {
+	return &MonitorTriggerCheckAction{}
+}
+
+// MonitorTriggerCheckAction defines the action implementation.
+type MonitorTriggerCheckAction struct {
+	client *client.Client
+}
+
+// MonitorTriggerCheckActionModel describes the action data model.
+type MonitorTriggerCheckActionModel struct {
+	MonitorID types.String `tfsdk:"monitor_id"`
+	Region    types.String `tfsdk:"region"`
+	RequireUp types.Bool   `tfsdk:"require_up"`
+}
+
+func (a *MonitorTriggerCheckAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_monitor_trigger_check"
+}
+
+func (a *MonitorTriggerCheckAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Checks a monitor immediately instead of waiting for its next scheduled check, and reports the result of each region. " +
+			"Invoke it with `terraform apply -invoke`, or from an `action_trigger` of the monitor to check it after every change. " +
+			"Requires Terraform 1.14 or later.",
+
+		Attributes: map[string]schema.Attribute{
+			"monitor_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the monitor to check.",
+				Required:            true,
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "The region to check from. When omitted, the monitor is checked from every region it runs in.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"require_up": schema.BoolAttribute{
+				MarkdownDescription: "Whether a failing check fails the action. Default is false, which only reports the failure.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (a *MonitorTriggerCheckAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	requireFeature(c, "on_demand_checks", "On-demand checks", &resp.Diagnostics)
+
+	a.client = c
+}
+
+func (a *MonitorTriggerCheckAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
+	var data MonitorTriggerCheckActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	results, err := a.client.RunMonitorCheck(ctx, data.MonitorID.ValueString(), data.Region.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to check monitor, got error: %s", err))
+		return
+	}
+
+	for _, result := range results {
+		message := fmt.Sprintf("%s: %s in %dms", result.Region, result.Status, result.ResponseTime)
+		if result.ErrorType != "" {
+			message = fmt.Sprintf("%s: %s %s", result.Region, result.ErrorType, result.Message)
+		}
+		resp.SendProgress(action.InvokeProgressEvent{Message: message})
+	}
+
+	failures := checkFailures(results)
+	if len(failures) == 0 {
+		return
+	}
+	if data.RequireUp.ValueBool() {
+		addCheckFailedError(&resp.Diagnostics, data.MonitorID.ValueString(), failures)
+		return
+	}
+	resp.Diagnostics.AddWarning(
+		"Monitor Check Failed",
+		fmt.Sprintf("The check of monitor %s failed in %d region(s). Set require_up to true to fail the action instead.", data.MonitorID.ValueString(), len(failures)),
+	)
+}
@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/ackack-io/terraform-provider-ackack/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// builtinAlertDefaults are the alert settings used when neither the alert
+// nor the provider's alert_defaults sets them.
+var builtinAlertDefaults = client.AlertDefaults{
+	TriggerThreshold:   1,
+	RecoveryThreshold:  1,
+	MinIntervalMinutes: 5,
+}
+
+// AlertDefaultsModel describes the alert_defaults provider attribute.
+type AlertDefaultsModel struct {
+	TriggerThreshold   types.Int64 `tfsdk:"trigger_threshold"`
+	RecoveryThreshold  types.Int64 `tfsdk:"recovery_threshold"`
+	MinIntervalMinutes types.Int64 `tfsdk:"min_interval_minutes"`
+}
+
+// alertDefaultsFromModel returns the builtin alert defaults overridden by the
+// ones set in m.
+func alertDefaultsFromModel(m *AlertDefaultsModel) client.AlertDefaults {
+	defaults := builtinAlertDefaults
+	if m == nil {
+		return defaults
+	}
+
+	if v := m.TriggerThreshold; !v.IsNull() && !v.IsUnknown() {
+		defaults.TriggerThreshold = int(v.ValueInt64())
+	}
+	if v := m.RecoveryThreshold; !v.IsNull() && !v.IsUnknown() {
+		defaults.RecoveryThreshold = int(v.ValueInt64())
+	}
+	if v := m.MinIntervalMinutes; !v.IsNull() && !v.IsUnknown() {
+		defaults.MinIntervalMinutes = int(v.ValueInt64())
+	}
+	return defaults
+}
+
+// planAlertDefaults sets the alert settings that config omits to defaults in
+// plan, so a change to the provider's defaults updates every alert that
+// relies on them.
+func planAlertDefaults(ctx context.Context, defaults client.AlertDefaults, config tfsdk.Config, plan *tfsdk.Plan) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, setting := range []struct {
+		name  string
+		value int
+	}{
+		{"trigger_threshold", defaults.TriggerThreshold},
+		{"recovery_threshold", defaults.RecoveryThreshold},
+		{"min_interval_minutes", defaults.MinIntervalMinutes},
+	} {
+		var configValue types.Int64
+		diags.Append(config.GetAttribute(ctx, path.Root(setting.name), &configValue)...)
+		if diags.HasError() {
+			return diags
+		}
+		if configValue.IsNull() {
+			diags.Append(plan.SetAttribute(ctx, path.Root(setting.name), int64(setting.value))...)
+		}
+	}
+	return diags
+}
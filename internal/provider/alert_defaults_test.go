@@ -0,0 +1,62 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestPlanAlertDefaults(t *testing.T) {
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	(&AlertResource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	configValues := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	planValues := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		configValues[name] = tftypes.NewValue(attributeType, nil)
+		planValues[name] = tftypes.NewValue(attributeType, nil)
+	}
+	configValues["trigger_threshold"] = tftypes.NewValue(tftypes.Number, 3)
+	planValues["trigger_threshold"] = tftypes.NewValue(tftypes.Number, 3)
+	planValues["recovery_threshold"] = tftypes.NewValue(tftypes.Number, tftypes.UnknownValue)
+	planValues["min_interval_minutes"] = tftypes.NewValue(tftypes.Number, tftypes.UnknownValue)
+
+	config := tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, configValues)}
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, planValues)}
+
+	// The provider sets only the trigger threshold; the other settings
+	// fall back to the builtin defaults.
+	defaults := alertDefaultsFromModel(&AlertDefaultsModel{
+		TriggerThreshold:   types.Int64Value(2),
+		RecoveryThreshold:  types.Int64Null(),
+		MinIntervalMinutes: types.Int64Null(),
+	})
+
+	if diags := planAlertDefaults(ctx, defaults, config, &plan); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := map[string]int64{
+		"trigger_threshold":    3,
+		"recovery_threshold":   1,
+		"min_interval_minutes": 5,
+	}
+	for name, want := range expected {
+		var got types.Int64
+		plan.GetAttribute(ctx, path.Root(name), &got)
+		if got.ValueInt64() != want || got.IsUnknown() {
+			t.Errorf("%s: expected %d, got %s", name, want, got)
+		}
+	}
+}
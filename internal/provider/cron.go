@@ -0,0 +1,137 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// cronField describes one field of a cron expression.
+type cronField struct {
+	name     string
+	min, max int
+	names    []string // names for min, min+1, ... when the field accepts them
+}
+
+var cronFields = []cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day-of-month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}},
+	{name: "day-of-week", min: 0, max: 7, names: []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}},
+}
+
+// cronMacros maps the supported @ shorthands to their expressions.
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// normalizeCron validates a standard five-field cron expression and returns
+// it in canonical form: macros expanded, month and weekday names replaced by
+// numbers, Sunday written as 0, steps of 1 dropped and fields separated by
+// single spaces.
+func normalizeCron(expression string) (string, error) {
+	expression = strings.TrimSpace(expression)
+	if strings.HasPrefix(expression, "@") {
+		expanded, ok := cronMacros[strings.ToLower(expression)]
+		if !ok {
+			return "", fmt.Errorf("unsupported shorthand %q, expected one of @yearly, @annually, @monthly, @weekly, @daily, @midnight or @hourly", expression)
+		}
+		return expanded, nil
+	}
+
+	fields := strings.Fields(expression)
+	if len(fields) != len(cronFields) {
+		return "", fmt.Errorf("expected 5 fields (minute hour day-of-month month day-of-week), got %d in %q", len(fields), expression)
+	}
+
+	for i, field := range fields {
+		normalized, err := normalizeCronField(field, cronFields[i])
+		if err != nil {
+			return "", err
+		}
+		fields[i] = normalized
+	}
+	return strings.Join(fields, " "), nil
+}
+
+// normalizeCronField validates and normalizes a comma-separated list of
+// values, ranges and steps.
+func normalizeCronField(field string, spec cronField) (string, error) {
+	items := strings.Split(field, ",")
+	for i, item := range items {
+		base, step, hasStep := strings.Cut(item, "/")
+		if hasStep {
+			n, err := strconv.Atoi(step)
+			if err != nil || n < 1 {
+				return "", fmt.Errorf("%s step %q must be a positive number", spec.name, step)
+			}
+			step = strconv.Itoa(n)
+		}
+
+		switch {
+		case base == "*":
+		case strings.Contains(base, "-"):
+			from, to, _ := strings.Cut(base, "-")
+			start, err := parseCronValue(from, spec)
+			if err != nil {
+				return "", err
+			}
+			end, err := parseCronValue(to, spec)
+			if err != nil {
+				return "", err
+			}
+			if start > end {
+				return "", fmt.Errorf("%s range %q starts after it ends", spec.name, base)
+			}
+			base = fmt.Sprintf("%d-%d", start, end)
+		default:
+			value, err := parseCronValue(base, spec)
+			if err != nil {
+				return "", err
+			}
+			// 7 and 0 both mean Sunday.
+			if spec.name == "day-of-week" && value == 7 {
+				value = 0
+			}
+			base = strconv.Itoa(value)
+		}
+
+		if hasStep && step != "1" {
+			items[i] = base + "/" + step
+		} else {
+			items[i] = base
+		}
+	}
+	return strings.Join(items, ","), nil
+}
+
+// parseCronValue parses a number or, where the field accepts them, a name,
+// and checks it is within the field's range.
+func parseCronValue(value string, spec cronField) (int, error) {
+	for i, name := range spec.names {
+		if strings.EqualFold(value, name) {
+			return spec.min + i, nil
+		}
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		if len(spec.names) > 0 {
+			return 0, fmt.Errorf("%s %q must be a number or one of %s", spec.name, value, strings.Join(spec.names, ", "))
+		}
+		return 0, fmt.Errorf("%s %q must be a number", spec.name, value)
+	}
+	if n < spec.min || n > spec.max {
+		return 0, fmt.Errorf("%s %d is out of range %d-%d", spec.name, n, spec.min, spec.max)
+	}
+	return n, nil
+}
@@ -0,0 +1,66 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNormalizeCron(t *testing.T) {
+	tests := []struct {
+		expression string
+		expected   string
+	}{
+		{"*/5 * * * *", "*/5 * * * *"},
+		{"  0   9 * *   mon-fri ", "0 9 * * 1-5"},
+		{"0 0 1 JAN,jul *", "0 0 1 1,7 *"},
+		{"30 2 * * 7", "30 2 * * 0"},
+		{"0 */1 1-31/1 * *", "0 * 1-31 * *"},
+		{"15 8-18/2 * * 1,3,5", "15 8-18/2 * * 1,3,5"},
+		{"@daily", "0 0 * * *"},
+		{"@Weekly", "0 0 * * 0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			got, err := normalizeCron(tt.expression)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestNormalizeCron_Invalid(t *testing.T) {
+	tests := []struct {
+		expression string
+		expected   string
+	}{
+		{"* * * *", "expected 5 fields"},
+		{"60 * * * *", "minute 60 is out of range 0-59"},
+		{"0 24 * * *", "hour 24 is out of range 0-23"},
+		{"0 0 0 * *", "day-of-month 0 is out of range 1-31"},
+		{"0 0 * FOO *", `month "FOO" must be a number or one of JAN`},
+		{"0 0 * * 5-1", `day-of-week range "5-1" starts after it ends`},
+		{"*/0 * * * *", `minute step "0" must be a positive number`},
+		{"1,,2 * * * *", `minute "" must be a number`},
+		{"@reboot", `unsupported shorthand "@reboot"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			_, err := normalizeCron(tt.expression)
+			if err == nil {
+				t.Fatalf("expected an error for %q", tt.expression)
+			}
+			if !strings.Contains(err.Error(), tt.expected) {
+				t.Errorf("expected error containing %q, got %q", tt.expected, err)
+			}
+		})
+	}
+}
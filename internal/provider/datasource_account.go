@@ -0,0 +1,144 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ackack-io/terraform-provider-ackack/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AccountDataSource{}
+
+func NewAccountDataSource() datasource.DataSource {
+	return &AccountDataSource{}
+}
+
+// AccountDataSource defines the data source implementation.
+type AccountDataSource struct {
+	client *client.Client
+}
+
+// AccountDataSourceModel describes the data source data model.
+type AccountDataSourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Email               types.String `tfsdk:"email"`
+	Plan                types.String `tfsdk:"plan"`
+	MonitorLimit        types.Int64  `tfsdk:"monitor_limit"`
+	MonitorCount        types.Int64  `tfsdk:"monitor_count"`
+	RemainingMonitors   types.Int64  `tfsdk:"remaining_monitors"`
+	AlertLimit          types.Int64  `tfsdk:"alert_limit"`
+	AlertCount          types.Int64  `tfsdk:"alert_count"`
+	RemainingAlerts     types.Int64  `tfsdk:"remaining_alerts"`
+	MinFrequencySeconds types.Int64  `tfsdk:"min_frequency_seconds"`
+}
+
+func (d *AccountDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account"
+}
+
+func (d *AccountDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to get the plan and quota information for the authenticated account.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the account.",
+				Computed:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "The email address of the account owner.",
+				Computed:            true,
+			},
+			"plan": schema.StringAttribute{
+				MarkdownDescription: "The name of the plan the account is subscribed to.",
+				Computed:            true,
+			},
+			"monitor_limit": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of monitors allowed on the plan.",
+				Computed:            true,
+			},
+			"monitor_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of monitors currently in use.",
+				Computed:            true,
+			},
+			"remaining_monitors": schema.Int64Attribute{
+				MarkdownDescription: "The number of monitors that can still be created.",
+				Computed:            true,
+			},
+			"alert_limit": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of alerts allowed on the plan.",
+				Computed:            true,
+			},
+			"alert_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of alerts currently in use.",
+				Computed:            true,
+			},
+			"remaining_alerts": schema.Int64Attribute{
+				MarkdownDescription: "The number of alerts that can still be created.",
+				Computed:            true,
+			},
+			"min_frequency_seconds": schema.Int64Attribute{
+				MarkdownDescription: "The minimum `frequency_seconds` allowed for monitors on the plan.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *AccountDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *AccountDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
+	var data AccountDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	account, err := d.client.GetAccount(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read account, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(account.ID)
+	data.Plan = types.StringValue(account.Plan)
+	data.MonitorLimit = types.Int64Value(int64(account.MonitorLimit))
+	data.MonitorCount = types.Int64Value(int64(account.MonitorCount))
+	data.RemainingMonitors = types.Int64Value(int64(account.RemainingMonitors))
+	data.AlertLimit = types.Int64Value(int64(account.AlertLimit))
+	data.AlertCount = types.Int64Value(int64(account.AlertCount))
+	data.RemainingAlerts = types.Int64Value(int64(account.RemainingAlerts))
+	data.MinFrequencySeconds = types.Int64Value(int64(account.MinFrequencySeconds))
+
+	if account.Email != "" {
+		data.Email = types.StringValue(account.Email)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
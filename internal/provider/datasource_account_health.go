@@ -0,0 +1,123 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ackack-io/terraform-provider-ackack/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AccountHealthDataSource{}
+
+func NewAccountHealthDataSource() datasource.DataSource {
+	return &AccountHealthDataSource{}
+}
+
+// AccountHealthDataSource defines the data source implementation.
+type AccountHealthDataSource struct {
+	client *client.Client
+}
+
+// AccountHealthDataSourceModel describes the data source data model.
+type AccountHealthDataSourceModel struct {
+	Plan             types.String `tfsdk:"plan"`
+	InFlightCount    types.Int64  `tfsdk:"in_flight_count"`
+	InFlightLimit    types.Int64  `tfsdk:"in_flight_limit"`
+	InFlightHeadroom types.Int64  `tfsdk:"in_flight_headroom"`
+	AtLimit          types.Bool   `tfsdk:"at_limit"`
+	ThrottledCount   types.Int64  `tfsdk:"throttled_count"`
+	DampenedCount    types.Int64  `tfsdk:"dampened_count"`
+}
+
+func (d *AccountHealthDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account_health"
+}
+
+func (d *AccountHealthDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to get the account-level check capacity: how many checks are in flight against the " +
+			"plan's limit, and how many monitors are throttled or dampened.",
+
+		Attributes: map[string]schema.Attribute{
+			"plan": schema.StringAttribute{
+				MarkdownDescription: "The account's plan.",
+				Computed:            true,
+			},
+			"in_flight_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of checks currently in progress.",
+				Computed:            true,
+			},
+			"in_flight_limit": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of checks the plan allows in progress at once.",
+				Computed:            true,
+			},
+			"in_flight_headroom": schema.Int64Attribute{
+				MarkdownDescription: "How many more checks can start before the limit is reached.",
+				Computed:            true,
+			},
+			"at_limit": schema.BoolAttribute{
+				MarkdownDescription: "Whether the account is at its in-flight limit, so further checks are delayed.",
+				Computed:            true,
+			},
+			"throttled_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of monitors currently throttled.",
+				Computed:            true,
+			},
+			"dampened_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of monitors currently dampened.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *AccountHealthDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *AccountHealthDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
+	var data AccountHealthDataSourceModel
+
+	health, err := d.client.GetAllMonitorHealth(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get account health, got error: %s", err))
+		return
+	}
+
+	summary := health.User
+	data.InFlightCount = types.Int64Value(int64(summary.InFlightCount))
+	data.InFlightLimit = types.Int64Value(int64(summary.InFlightLimit))
+	data.InFlightHeadroom = types.Int64Value(int64(max(summary.InFlightLimit-summary.InFlightCount, 0)))
+	data.AtLimit = types.BoolValue(summary.AtLimit)
+	data.ThrottledCount = types.Int64Value(int64(summary.ThrottledCount))
+	data.DampenedCount = types.Int64Value(int64(summary.DampenedCount))
+
+	if summary.Plan != "" {
+		data.Plan = types.StringValue(summary.Plan)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
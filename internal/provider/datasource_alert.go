@@ -27,19 +27,36 @@ type AlertDataSource struct {
 
 // AlertDataSourceModel describes the data source data model.
 type AlertDataSourceModel struct {
-	ID                 types.String `tfsdk:"id"`
-	MonitorID          types.String `tfsdk:"monitor_id"`
-	Type               types.String `tfsdk:"type"`
-	Target             types.String `tfsdk:"target"`
-	IsEnabled          types.Bool   `tfsdk:"is_enabled"`
-	TriggerThreshold   types.Int64  `tfsdk:"trigger_threshold"`
-	RecoveryThreshold  types.Int64  `tfsdk:"recovery_threshold"`
-	MinIntervalMinutes types.Int64  `tfsdk:"min_interval_minutes"`
-	CustomMessage      types.String `tfsdk:"custom_message"`
-	IncludeDetails     types.Bool   `tfsdk:"include_details"`
-	LastTriggeredAt    types.String `tfsdk:"last_triggered_at"`
-	CreatedAt          types.String `tfsdk:"created_at"`
-	UpdatedAt          types.String `tfsdk:"updated_at"`
+	ID                        types.String             `tfsdk:"id"`
+	MonitorID                 types.String             `tfsdk:"monitor_id"`
+	Type                      types.String             `tfsdk:"type"`
+	Target                    types.String             `tfsdk:"target"`
+	IsEnabled                 types.Bool               `tfsdk:"is_enabled"`
+	TriggerThreshold          types.Int64              `tfsdk:"trigger_threshold"`
+	RecoveryThreshold         types.Int64              `tfsdk:"recovery_threshold"`
+	MinIntervalMinutes        types.Int64              `tfsdk:"min_interval_minutes"`
+	CustomMessage             types.String             `tfsdk:"custom_message"`
+	IncludeDetails            types.Bool               `tfsdk:"include_details"`
+	IntegrationID             types.String             `tfsdk:"integration_id"`
+	Opsgenie                  *OpsgenieDataSourceModel `tfsdk:"opsgenie"`
+	PhoneCountry              types.String             `tfsdk:"phone_country"`
+	SenderID                  types.String             `tfsdk:"sender_id"`
+	PayloadTemplate           types.String             `tfsdk:"payload_template"`
+	Headers                   types.Map                `tfsdk:"headers"`
+	Severity                  types.String             `tfsdk:"severity"`
+	ActiveHours               *ActiveHoursModel        `tfsdk:"active_hours"`
+	SuppressDuringMaintenance types.Bool               `tfsdk:"suppress_during_maintenance"`
+	MaintenanceWindowIDs      types.Set                `tfsdk:"maintenance_window_ids"`
+	LastTriggeredAt           types.String             `tfsdk:"last_triggered_at"`
+	CreatedAt                 types.String             `tfsdk:"created_at"`
+	UpdatedAt                 types.String             `tfsdk:"updated_at"`
+}
+
+// OpsgenieDataSourceModel describes the opsgenie routing settings of an alert.
+type OpsgenieDataSourceModel struct {
+	Team     types.String `tfsdk:"team"`
+	Region   types.String `tfsdk:"region"`
+	Priority types.String `tfsdk:"priority"`
 }
 
 func (d *AlertDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -60,7 +77,7 @@ func (d *AlertDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 				Computed:            true,
 			},
 			"type": schema.StringAttribute{
-				MarkdownDescription: "The type of alert (email, webhook, discord, slack, pagerduty).",
+				MarkdownDescription: "The type of alert (email, webhook, discord, slack, pagerduty, opsgenie, sms, voice).",
 				Computed:            true,
 			},
 			"target": schema.StringAttribute{
@@ -91,6 +108,82 @@ func (d *AlertDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 				MarkdownDescription: "Whether to include detailed information in the alert.",
 				Computed:            true,
 			},
+			"integration_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the PagerDuty integration the alert is delivered through, if any.",
+				Computed:            true,
+			},
+			"opsgenie": schema.SingleNestedAttribute{
+				MarkdownDescription: "Opsgenie routing settings, for `opsgenie` alerts. The API key is never returned.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"team": schema.StringAttribute{
+						MarkdownDescription: "The Opsgenie team alerts are routed to.",
+						Computed:            true,
+					},
+					"region": schema.StringAttribute{
+						MarkdownDescription: "The Opsgenie region of the account.",
+						Computed:            true,
+					},
+					"priority": schema.StringAttribute{
+						MarkdownDescription: "The Opsgenie priority alerts are created with.",
+						Computed:            true,
+					},
+				},
+			},
+			"phone_country": schema.StringAttribute{
+				MarkdownDescription: "The country code used to route `sms` and `voice` alerts.",
+				Computed:            true,
+			},
+			"sender_id": schema.StringAttribute{
+				MarkdownDescription: "The sender ID shown to recipients of `sms` and `voice` alerts.",
+				Computed:            true,
+			},
+			"payload_template": schema.StringAttribute{
+				MarkdownDescription: "The request body template sent for `webhook` alerts.",
+				Computed:            true,
+			},
+			"headers": schema.MapAttribute{
+				MarkdownDescription: "Additional HTTP headers sent with `webhook` alerts.",
+				Computed:            true,
+				Sensitive:           true,
+				ElementType:         types.StringType,
+			},
+			"severity": schema.StringAttribute{
+				MarkdownDescription: "The minimum incident severity that triggers this alert, if any.",
+				Computed:            true,
+			},
+			"active_hours": schema.SingleNestedAttribute{
+				MarkdownDescription: "The days and time window the alert sends notifications in, if restricted.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"days": schema.SetAttribute{
+						MarkdownDescription: "Days of the week the alert is active.",
+						Computed:            true,
+						ElementType:         types.StringType,
+					},
+					"start_time": schema.StringAttribute{
+						MarkdownDescription: "Start of the active window in `HH:MM` format.",
+						Computed:            true,
+					},
+					"end_time": schema.StringAttribute{
+						MarkdownDescription: "End of the active window in `HH:MM` format.",
+						Computed:            true,
+					},
+					"timezone": schema.StringAttribute{
+						MarkdownDescription: "The IANA time zone the window is evaluated in.",
+						Computed:            true,
+					},
+				},
+			},
+			"suppress_during_maintenance": schema.BoolAttribute{
+				MarkdownDescription: "Whether notifications are suppressed during maintenance windows.",
+				Computed:            true,
+			},
+			"maintenance_window_ids": schema.SetAttribute{
+				MarkdownDescription: "The maintenance windows that suppress notifications, if restricted.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
 			"last_triggered_at": schema.StringAttribute{
 				MarkdownDescription: "The timestamp when the alert was last triggered.",
 				Computed:            true,
@@ -125,6 +218,9 @@ func (d *AlertDataSource) Configure(ctx context.Context, req datasource.Configur
 }
 
 func (d *AlertDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
 	var data AlertDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -139,8 +235,7 @@ func (d *AlertDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	data.MonitorID = types.StringValue(alert.MonitorID)
-	data.Type = types.StringValue(alert.Type)
-	data.Target = types.StringValue(alert.Target)
+	data.Type = types.StringValue(string(alert.Type))
 	data.IsEnabled = types.BoolValue(alert.IsEnabled)
 	data.TriggerThreshold = types.Int64Value(int64(alert.TriggerThreshold))
 	data.RecoveryThreshold = types.Int64Value(int64(alert.RecoveryThreshold))
@@ -149,6 +244,41 @@ func (d *AlertDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	data.CreatedAt = types.StringValue(alert.CreatedAt)
 	data.UpdatedAt = types.StringValue(alert.UpdatedAt)
 
+	if alert.Target != "" {
+		data.Target = types.StringValue(alert.Target)
+	}
+	if alert.IntegrationID != "" {
+		data.IntegrationID = types.StringValue(alert.IntegrationID)
+	}
+	if alert.Opsgenie != nil {
+		data.Opsgenie = &OpsgenieDataSourceModel{
+			Team:     types.StringValue(alert.Opsgenie.Team),
+			Region:   types.StringValue(alert.Opsgenie.Region),
+			Priority: types.StringValue(alert.Opsgenie.Priority),
+		}
+	}
+	if alert.PhoneCountry != "" {
+		data.PhoneCountry = types.StringValue(alert.PhoneCountry)
+	}
+	if alert.SenderID != "" {
+		data.SenderID = types.StringValue(alert.SenderID)
+	}
+	if alert.PayloadTemplate != "" {
+		data.PayloadTemplate = types.StringValue(alert.PayloadTemplate)
+	}
+	if len(alert.Headers) > 0 {
+		data.Headers = stringMapValue(alert.Headers)
+	}
+	if alert.Severity != "" {
+		data.Severity = types.StringValue(string(alert.Severity))
+	}
+	if alert.ActiveHours != nil {
+		data.ActiveHours = activeHoursFromClient(alert.ActiveHours)
+	}
+	data.SuppressDuringMaintenance = types.BoolValue(alert.SuppressDuringMaintenance)
+	if len(alert.MaintenanceWindowIDs) > 0 {
+		data.MaintenanceWindowIDs = stringSetValue(alert.MaintenanceWindowIDs)
+	}
 	if alert.CustomMessage != "" {
 		data.CustomMessage = types.StringValue(alert.CustomMessage)
 	}
@@ -108,6 +108,9 @@ func (d *AlertsDataSource) Configure(ctx context.Context, req datasource.Configu
 }
 
 func (d *AlertsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
 	var data AlertsDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -126,7 +129,7 @@ func (d *AlertsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		data.Alerts[i] = AlertListItemModel{
 			ID:        types.StringValue(alert.ID),
 			MonitorID: types.StringValue(alert.MonitorID),
-			Type:      types.StringValue(alert.Type),
+			Type:      types.StringValue(string(alert.Type)),
 			Target:    types.StringValue(alert.Target),
 			IsEnabled: types.BoolValue(alert.IsEnabled),
 			CreatedAt: types.StringValue(alert.CreatedAt),
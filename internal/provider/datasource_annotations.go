@@ -0,0 +1,179 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ackack-io/terraform-provider-ackack/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AnnotationsDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &AnnotationsDataSource{}
+
+func NewAnnotationsDataSource() datasource.DataSource {
+	return &AnnotationsDataSource{}
+}
+
+// AnnotationsDataSource defines the data source implementation.
+type AnnotationsDataSource struct {
+	client *client.Client
+}
+
+// AnnotationsDataSourceModel describes the data source data model.
+type AnnotationsDataSourceModel struct {
+	MonitorID   types.String          `tfsdk:"monitor_id"`
+	Since       types.String          `tfsdk:"since"`
+	Until       types.String          `tfsdk:"until"`
+	Annotations []AnnotationItemModel `tfsdk:"annotations"`
+}
+
+// AnnotationItemModel describes a single annotation in the list.
+type AnnotationItemModel struct {
+	ID        types.String `tfsdk:"id"`
+	MonitorID types.String `tfsdk:"monitor_id"`
+	Timestamp types.String `tfsdk:"timestamp"`
+	Text      types.String `tfsdk:"text"`
+	Link      types.String `tfsdk:"link"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+func (d *AnnotationsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_annotations"
+}
+
+func (d *AnnotationsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to list annotations, optionally filtered by monitor and time range.",
+
+		Attributes: map[string]schema.Attribute{
+			"monitor_id": schema.StringAttribute{
+				MarkdownDescription: "Only return annotations for this monitor.",
+				Optional:            true,
+			},
+			"since": schema.StringAttribute{
+				MarkdownDescription: "Only return annotations at or after this time, in RFC 3339 format.",
+				Optional:            true,
+			},
+			"until": schema.StringAttribute{
+				MarkdownDescription: "Only return annotations at or before this time, in RFC 3339 format.",
+				Optional:            true,
+			},
+			"annotations": schema.ListNestedAttribute{
+				MarkdownDescription: "List of annotations.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The unique identifier of the annotation.",
+							Computed:            true,
+						},
+						"monitor_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the monitor the annotation is attached to.",
+							Computed:            true,
+						},
+						"timestamp": schema.StringAttribute{
+							MarkdownDescription: "The time of the event.",
+							Computed:            true,
+						},
+						"text": schema.StringAttribute{
+							MarkdownDescription: "The annotation text.",
+							Computed:            true,
+						},
+						"link": schema.StringAttribute{
+							MarkdownDescription: "The URL with more details, if any.",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "The timestamp when the annotation was created.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AnnotationsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data AnnotationsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	since := parseTimeAttribute(path.Root("since"), data.Since, &resp.Diagnostics)
+	until := parseTimeAttribute(path.Root("until"), data.Until, &resp.Diagnostics)
+	if since.IsZero() || until.IsZero() {
+		return
+	}
+
+	if until.Before(since) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("until"),
+			"Invalid Time Range",
+			fmt.Sprintf("The until time (%s) must not be before since (%s).", data.Until.ValueString(), data.Since.ValueString()),
+		)
+	}
+}
+
+func (d *AnnotationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	requireFeature(c, "annotations", "Annotations", &resp.Diagnostics)
+
+	d.client = c
+}
+
+func (d *AnnotationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
+	var data AnnotationsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	annotations, err := d.client.ListAnnotations(ctx, data.MonitorID.ValueString(), data.Since.ValueString(), data.Until.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list annotations, got error: %s", err))
+		return
+	}
+
+	data.Annotations = make([]AnnotationItemModel, len(annotations))
+	for i, annotation := range annotations {
+		data.Annotations[i] = AnnotationItemModel{
+			ID:        types.StringValue(annotation.ID),
+			MonitorID: types.StringValue(annotation.MonitorID),
+			Timestamp: types.StringValue(annotation.Timestamp),
+			Text:      types.StringValue(annotation.Text),
+			CreatedAt: types.StringValue(annotation.CreatedAt),
+		}
+		if annotation.Link != "" {
+			data.Annotations[i].Link = types.StringValue(annotation.Link)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,193 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ackack-io/terraform-provider-ackack/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ChangeFeedDataSource{}
+
+func NewChangeFeedDataSource() datasource.DataSource {
+	return &ChangeFeedDataSource{}
+}
+
+// ChangeFeedDataSource defines the data source implementation.
+type ChangeFeedDataSource struct {
+	client *client.Client
+}
+
+// ChangeFeedDataSourceModel describes the data source data model.
+type ChangeFeedDataSourceModel struct {
+	Since            types.String      `tfsdk:"since"`
+	ResourceType     types.String      `tfsdk:"resource_type"`
+	ResourceID       types.String      `tfsdk:"resource_id"`
+	ExcludeTerraform types.Bool        `tfsdk:"exclude_terraform"`
+	Changes          []ChangeItemModel `tfsdk:"changes"`
+}
+
+// ChangeItemModel describes a single configuration change in the feed.
+type ChangeItemModel struct {
+	ID            types.String `tfsdk:"id"`
+	Timestamp     types.String `tfsdk:"timestamp"`
+	Actor         types.String `tfsdk:"actor"`
+	Source        types.String `tfsdk:"source"`
+	ResourceType  types.String `tfsdk:"resource_type"`
+	ResourceID    types.String `tfsdk:"resource_id"`
+	ResourceName  types.String `tfsdk:"resource_name"`
+	Action        types.String `tfsdk:"action"`
+	ChangedFields types.List   `tfsdk:"changed_fields"`
+}
+
+func (d *ChangeFeedDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_change_feed"
+}
+
+func (d *ChangeFeedDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to list recent configuration changes of the account: who changed which " +
+			"resource, when, and through which channel. Scheduled pipelines can use it to detect and report edits made outside " +
+			"Terraform between applies.",
+
+		Attributes: map[string]schema.Attribute{
+			"since": schema.StringAttribute{
+				MarkdownDescription: "Only return changes at or after this time, in RFC 3339 format. " +
+					"When omitted, the server's default look-back period applies.",
+				Optional: true,
+			},
+			"resource_type": schema.StringAttribute{
+				MarkdownDescription: "Only return changes to resources of this type, e.g. `monitor` or `alert`.",
+				Optional:            true,
+			},
+			"resource_id": schema.StringAttribute{
+				MarkdownDescription: "Only return changes to the resource with this ID.",
+				Optional:            true,
+			},
+			"exclude_terraform": schema.BoolAttribute{
+				MarkdownDescription: "Whether to omit changes made through this provider, leaving only out-of-band edits. Default is false.",
+				Optional:            true,
+			},
+			"changes": schema.ListNestedAttribute{
+				MarkdownDescription: "List of changes, newest first.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The unique identifier of the change.",
+							Computed:            true,
+						},
+						"timestamp": schema.StringAttribute{
+							MarkdownDescription: "The time of the change.",
+							Computed:            true,
+						},
+						"actor": schema.StringAttribute{
+							MarkdownDescription: "The user or API key that made the change, if known.",
+							Computed:            true,
+						},
+						"source": schema.StringAttribute{
+							MarkdownDescription: "The channel the change came through, e.g. `ui`, `api` or `terraform`.",
+							Computed:            true,
+						},
+						"resource_type": schema.StringAttribute{
+							MarkdownDescription: "The type of the changed resource.",
+							Computed:            true,
+						},
+						"resource_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the changed resource.",
+							Computed:            true,
+						},
+						"resource_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the changed resource at the time of the change, if it has one.",
+							Computed:            true,
+						},
+						"action": schema.StringAttribute{
+							MarkdownDescription: "What happened to the resource: `create`, `update` or `delete`.",
+							Computed:            true,
+						},
+						"changed_fields": schema.ListAttribute{
+							MarkdownDescription: "The fields an update changed.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ChangeFeedDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	requireFeature(c, "change_feed", "Change feeds", &resp.Diagnostics)
+
+	d.client = c
+}
+
+func (d *ChangeFeedDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
+	var data ChangeFeedDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	changes, err := d.client.ListChanges(ctx, data.Since.ValueString(), data.ResourceType.ValueString(), data.ResourceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list changes, got error: %s", err))
+		return
+	}
+
+	data.Changes = make([]ChangeItemModel, 0, len(changes))
+	for _, change := range changes {
+		if data.ExcludeTerraform.ValueBool() && change.Source == "terraform" {
+			continue
+		}
+
+		changedFields, diags := types.ListValueFrom(ctx, types.StringType, change.ChangedFields)
+		resp.Diagnostics.Append(diags...)
+
+		item := ChangeItemModel{
+			ID:            types.StringValue(change.ID),
+			Timestamp:     types.StringValue(change.Timestamp),
+			ResourceType:  types.StringValue(change.ResourceType),
+			ResourceID:    types.StringValue(change.ResourceID),
+			Action:        types.StringValue(change.Action),
+			ChangedFields: changedFields,
+		}
+		if change.Actor != "" {
+			item.Actor = types.StringValue(change.Actor)
+		}
+		if change.Source != "" {
+			item.Source = types.StringValue(change.Source)
+		}
+		if change.ResourceName != "" {
+			item.ResourceName = types.StringValue(change.ResourceName)
+		}
+		data.Changes = append(data.Changes, item)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,268 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/ackack-io/terraform-provider-ackack/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CoverageDataSource{}
+
+// defaultPagingAlertTypes lists the alert types that page someone.
+var defaultPagingAlertTypes = client.Strings([]client.AlertType{client.AlertTypePagerDuty, client.AlertTypeOpsgenie, client.AlertTypeSMS, client.AlertTypeVoice})
+
+func NewCoverageDataSource() datasource.DataSource {
+	return &CoverageDataSource{}
+}
+
+// CoverageDataSource defines the data source implementation.
+type CoverageDataSource struct {
+	client *client.Client
+}
+
+// CoverageDataSourceModel describes the data source data model.
+type CoverageDataSourceModel struct {
+	SystemPriorities  types.List                 `tfsdk:"system_priorities"`
+	PagingAlertTypes  types.List                 `tfsdk:"paging_alert_types"`
+	IncludeDisabled   types.Bool                 `tfsdk:"include_disabled"`
+	UncoveredMonitors []CoverageMonitorItemModel `tfsdk:"uncovered_monitors"`
+	UncoveredSystems  []CoverageSystemItemModel  `tfsdk:"uncovered_systems"`
+	FullyCovered      types.Bool                 `tfsdk:"fully_covered"`
+}
+
+// CoverageMonitorItemModel describes a monitor without an enabled alert.
+type CoverageMonitorItemModel struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+	Type types.String `tfsdk:"type"`
+}
+
+// CoverageSystemItemModel describes a system without paging coverage.
+type CoverageSystemItemModel struct {
+	ID       types.String `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	Priority types.String `tfsdk:"priority"`
+}
+
+func (d *CoverageDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_coverage"
+}
+
+func (d *CoverageDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to report alert coverage gaps: monitors without any enabled alert, and systems of the given " +
+			"priorities where no monitor has an enabled paging alert. Monitors without alerts of their own are covered by the enabled " +
+			"`default_alert_channel_ids` of their systems. Combine it with a `check` block or a postcondition to fail CI " +
+			"when new monitors ship without paging.",
+
+		Attributes: map[string]schema.Attribute{
+			"system_priorities": schema.ListAttribute{
+				MarkdownDescription: "System priorities that require paging coverage. Defaults to `[\"critical\"]`.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"paging_alert_types": schema.ListAttribute{
+				MarkdownDescription: "Alert types that count as paging. Defaults to `[\"pagerduty\", \"opsgenie\", \"sms\", \"voice\"]`.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"include_disabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether disabled monitors are checked for coverage. Default is false.",
+				Optional:            true,
+			},
+			"uncovered_monitors": schema.ListNestedAttribute{
+				MarkdownDescription: "Monitors without any enabled alert.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The unique identifier of the monitor.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the monitor.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of monitor.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"uncovered_systems": schema.ListNestedAttribute{
+				MarkdownDescription: "Systems of the requested priorities where no monitor has an enabled paging alert.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The unique identifier of the system.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the system.",
+							Computed:            true,
+						},
+						"priority": schema.StringAttribute{
+							MarkdownDescription: "The priority of the system.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"fully_covered": schema.BoolAttribute{
+				MarkdownDescription: "Whether there are no uncovered monitors or systems.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *CoverageDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *CoverageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
+	var data CoverageDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	priorities := []string{"critical"}
+	if !data.SystemPriorities.IsNull() {
+		resp.Diagnostics.Append(data.SystemPriorities.ElementsAs(ctx, &priorities, false)...)
+	}
+	pagingTypes := defaultPagingAlertTypes
+	if !data.PagingAlertTypes.IsNull() {
+		resp.Diagnostics.Append(data.PagingAlertTypes.ElementsAs(ctx, &pagingTypes, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	monitors, err := d.client.ListMonitors(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list monitors, got error: %s", err))
+		return
+	}
+
+	alerts, err := d.client.ListAlerts(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list alerts, got error: %s", err))
+		return
+	}
+
+	enabled := make(map[string]client.Alert)
+	alerted := make(map[string]bool)
+	paged := make(map[string]bool)
+	for _, alert := range alerts {
+		if !alert.IsEnabled {
+			continue
+		}
+		enabled[alert.ID] = alert
+		alerted[alert.MonitorID] = true
+		if slices.Contains(pagingTypes, string(alert.Type)) {
+			paged[alert.MonitorID] = true
+		}
+	}
+
+	systems, err := d.client.ListSystems(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list systems, got error: %s", err))
+		return
+	}
+
+	// Members without alerts of their own are notified through the default
+	// alert channels of their systems, so those channels cover them too.
+	members := make(map[string][]string)
+	inheritedAlerted := make(map[string]bool)
+	inheritedPaged := make(map[string]bool)
+	for _, system := range systems {
+		if len(system.DefaultAlertChannelIDs) == 0 && !slices.Contains(priorities, system.Priority) {
+			continue
+		}
+
+		monitorIDs, err := d.client.ListSystemMonitorIDs(ctx, system.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list monitors for system %s, got error: %s", system.ID, err))
+			return
+		}
+		members[system.ID] = monitorIDs
+
+		var channels, paging bool
+		for _, id := range system.DefaultAlertChannelIDs {
+			if alert, ok := enabled[id]; ok {
+				channels = true
+				paging = paging || slices.Contains(pagingTypes, string(alert.Type))
+			}
+		}
+		for _, id := range monitorIDs {
+			if alerted[id] {
+				continue
+			}
+			inheritedAlerted[id] = inheritedAlerted[id] || channels
+			inheritedPaged[id] = inheritedPaged[id] || paging
+		}
+	}
+
+	data.UncoveredMonitors = []CoverageMonitorItemModel{}
+	for _, monitor := range monitors {
+		if alerted[monitor.ID] || inheritedAlerted[monitor.ID] {
+			continue
+		}
+		if !monitor.IsEnabled && !data.IncludeDisabled.ValueBool() {
+			continue
+		}
+		data.UncoveredMonitors = append(data.UncoveredMonitors, CoverageMonitorItemModel{
+			ID:   types.StringValue(monitor.ID),
+			Name: types.StringValue(monitor.Name),
+			Type: types.StringValue(string(monitor.Type)),
+		})
+	}
+
+	data.UncoveredSystems = []CoverageSystemItemModel{}
+	for _, system := range systems {
+		if !slices.Contains(priorities, system.Priority) {
+			continue
+		}
+		if slices.ContainsFunc(members[system.ID], func(id string) bool { return paged[id] || inheritedPaged[id] }) {
+			continue
+		}
+		data.UncoveredSystems = append(data.UncoveredSystems, CoverageSystemItemModel{
+			ID:       types.StringValue(system.ID),
+			Name:     types.StringValue(system.Name),
+			Priority: types.StringValue(system.Priority),
+		})
+	}
+
+	data.FullyCovered = types.BoolValue(len(data.UncoveredMonitors) == 0 && len(data.UncoveredSystems) == 0)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
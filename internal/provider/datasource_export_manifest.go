@@ -0,0 +1,181 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/ackack-io/terraform-provider-ackack/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ExportManifestDataSource{}
+
+// exportManifestVersion is bumped whenever the shape of the manifest changes
+// incompatibly.
+const exportManifestVersion = 1
+
+func NewExportManifestDataSource() datasource.DataSource {
+	return &ExportManifestDataSource{}
+}
+
+// ExportManifestDataSource defines the data source implementation.
+type ExportManifestDataSource struct {
+	client *client.Client
+}
+
+// ExportManifestDataSourceModel describes the data source data model.
+type ExportManifestDataSourceModel struct {
+	MonitorCount types.Int64  `tfsdk:"monitor_count"`
+	JSON         types.String `tfsdk:"json"`
+}
+
+// exportManifest is the JSON document returned in the json attribute.
+type exportManifest struct {
+	Version  int                     `json:"version"`
+	Monitors []exportManifestMonitor `json:"monitors"`
+}
+
+// exportManifestMonitor is a single monitor in the export manifest.
+type exportManifestMonitor struct {
+	ID        string   `json:"id"`
+	Name      string   `json:"name"`
+	Type      string   `json:"type"`
+	Target    string   `json:"target"`
+	IsEnabled bool     `json:"is_enabled"`
+	SystemIDs []string `json:"system_ids"`
+}
+
+func (d *ExportManifestDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_export_manifest"
+}
+
+func (d *ExportManifestDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to export every monitor as a JSON document with a stable shape, for syncing into a CMDB " +
+			"through the `http` or `external` providers. Monitors are sorted by ID and each lists its ID, name, type, target, " +
+			"enabled state and the systems it belongs to.",
+
+		Attributes: map[string]schema.Attribute{
+			"monitor_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of monitors in the manifest.",
+				Computed:            true,
+			},
+			"json": schema.StringAttribute{
+				MarkdownDescription: "The manifest as JSON, in the form " +
+					"`{\"version\": 1, \"monitors\": [{\"id\", \"name\", \"type\", \"target\", \"is_enabled\", \"system_ids\"}]}`. " +
+					"The target is the URL for HTTP and DNS monitors, the domain (with `:port` when not 443) for SSL monitors and `host:port` for TCP monitors.",
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *ExportManifestDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *ExportManifestDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
+	var data ExportManifestDataSourceModel
+
+	monitors, err := d.client.ListMonitors(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list monitors, got error: %s", err))
+		return
+	}
+
+	systems, err := d.client.ListSystems(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list systems, got error: %s", err))
+		return
+	}
+
+	memberships := make(map[string][]string)
+	for _, system := range systems {
+		monitorIDs, err := d.client.ListSystemMonitorIDs(ctx, system.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list monitors for system %s, got error: %s", system.ID, err))
+			return
+		}
+		for _, id := range monitorIDs {
+			memberships[id] = append(memberships[id], system.ID)
+		}
+	}
+
+	manifest := exportManifest{
+		Version:  exportManifestVersion,
+		Monitors: make([]exportManifestMonitor, 0, len(monitors)),
+	}
+	for _, monitor := range monitors {
+		systemIDs := memberships[monitor.ID]
+		if systemIDs == nil {
+			systemIDs = []string{}
+		}
+		slices.Sort(systemIDs)
+
+		manifest.Monitors = append(manifest.Monitors, exportManifestMonitor{
+			ID:        monitor.ID,
+			Name:      monitor.Name,
+			Type:      string(monitor.Type),
+			Target:    monitorTarget(&monitor),
+			IsEnabled: monitor.IsEnabled,
+			SystemIDs: systemIDs,
+		})
+	}
+	slices.SortFunc(manifest.Monitors, func(a, b exportManifestMonitor) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	out, err := json.Marshal(manifest)
+	if err != nil {
+		resp.Diagnostics.AddError("Encoding Error", fmt.Sprintf("Unable to encode export manifest: %s", err))
+		return
+	}
+
+	data.MonitorCount = types.Int64Value(int64(len(manifest.Monitors)))
+	data.JSON = types.StringValue(string(out))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// monitorTarget returns what a monitor checks, in a form that identifies it
+// regardless of monitor type.
+func monitorTarget(monitor *client.Monitor) string {
+	switch monitor.Type {
+	case client.MonitorTypeSSL:
+		if monitor.Port != 0 && monitor.Port != 443 {
+			return net.JoinHostPort(monitor.Domain, strconv.Itoa(monitor.Port))
+		}
+		return monitor.Domain
+	case client.MonitorTypeTCP:
+		return net.JoinHostPort(monitor.Host, strconv.Itoa(monitor.Port))
+	default:
+		return monitor.URL
+	}
+}
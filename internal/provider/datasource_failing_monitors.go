@@ -0,0 +1,157 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ackack-io/terraform-provider-ackack/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &FailingMonitorsDataSource{}
+
+func NewFailingMonitorsDataSource() datasource.DataSource {
+	return &FailingMonitorsDataSource{}
+}
+
+// FailingMonitorsDataSource defines the data source implementation.
+type FailingMonitorsDataSource struct {
+	client *client.Client
+}
+
+// FailingMonitorsDataSourceModel describes the data source data model.
+type FailingMonitorsDataSourceModel struct {
+	IncludeDegraded types.Bool                    `tfsdk:"include_degraded"`
+	Monitors        []FailingMonitorListItemModel `tfsdk:"monitors"`
+}
+
+// FailingMonitorListItemModel describes a single failing monitor.
+type FailingMonitorListItemModel struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Type             types.String `tfsdk:"type"`
+	Status           types.String `tfsdk:"status"`
+	LastErrorType    types.String `tfsdk:"last_error_type"`
+	LastErrorMessage types.String `tfsdk:"last_error_message"`
+	LastChecked      types.String `tfsdk:"last_checked"`
+}
+
+func (d *FailingMonitorsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_failing_monitors"
+}
+
+func (d *FailingMonitorsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to list monitors that are currently failing, together with their latest error.",
+
+		Attributes: map[string]schema.Attribute{
+			"include_degraded": schema.BoolAttribute{
+				MarkdownDescription: "Whether to include monitors in the `degraded` state in addition to `error`. Default is true.",
+				Optional:            true,
+			},
+			"monitors": schema.ListNestedAttribute{
+				MarkdownDescription: "List of failing monitors.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The unique identifier of the monitor.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the monitor.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of monitor.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The current status of the monitor.",
+							Computed:            true,
+						},
+						"last_error_type": schema.StringAttribute{
+							MarkdownDescription: "The type of the most recent error.",
+							Computed:            true,
+						},
+						"last_error_message": schema.StringAttribute{
+							MarkdownDescription: "The message of the most recent error.",
+							Computed:            true,
+						},
+						"last_checked": schema.StringAttribute{
+							MarkdownDescription: "The timestamp of the last check.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *FailingMonitorsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *FailingMonitorsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
+	var data FailingMonitorsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	statuses := []client.MonitorStatus{client.MonitorStatusError}
+	if data.IncludeDegraded.IsNull() || data.IncludeDegraded.ValueBool() {
+		statuses = append(statuses, client.MonitorStatusDegraded)
+	}
+
+	monitors, err := d.client.ListMonitorsByStatus(ctx, statuses)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list failing monitors, got error: %s", err))
+		return
+	}
+
+	data.Monitors = make([]FailingMonitorListItemModel, len(monitors))
+	for i, monitor := range monitors {
+		data.Monitors[i] = FailingMonitorListItemModel{
+			ID:     types.StringValue(monitor.ID),
+			Name:   types.StringValue(monitor.Name),
+			Type:   types.StringValue(string(monitor.Type)),
+			Status: types.StringValue(string(monitor.Status)),
+		}
+		if monitor.LastErrorType != "" {
+			data.Monitors[i].LastErrorType = types.StringValue(monitor.LastErrorType)
+		}
+		if monitor.LastErrorMessage != "" {
+			data.Monitors[i].LastErrorMessage = types.StringValue(monitor.LastErrorMessage)
+		}
+		if monitor.LastChecked != "" {
+			data.Monitors[i].LastChecked = types.StringValue(monitor.LastChecked)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
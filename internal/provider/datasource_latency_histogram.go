@@ -0,0 +1,200 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/ackack-io/terraform-provider-ackack/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &LatencyHistogramDataSource{}
+
+func NewLatencyHistogramDataSource() datasource.DataSource {
+	return &LatencyHistogramDataSource{}
+}
+
+// LatencyHistogramDataSource defines the data source implementation.
+type LatencyHistogramDataSource struct {
+	client *client.Client
+}
+
+// LatencyHistogramDataSourceModel describes the data source data model.
+type LatencyHistogramDataSourceModel struct {
+	MonitorID      types.String         `tfsdk:"monitor_id"`
+	Hours          types.Int64          `tfsdk:"hours"`
+	BucketBoundsMs types.Set            `tfsdk:"bucket_bounds_ms"`
+	TotalCount     types.Int64          `tfsdk:"total_count"`
+	P50Ms          types.Float64        `tfsdk:"p50_ms"`
+	P95Ms          types.Float64        `tfsdk:"p95_ms"`
+	P99Ms          types.Float64        `tfsdk:"p99_ms"`
+	Buckets        []LatencyBucketModel `tfsdk:"buckets"`
+}
+
+// LatencyBucketModel describes a single histogram bucket.
+type LatencyBucketModel struct {
+	UpperBoundMs    types.Int64 `tfsdk:"upper_bound_ms"`
+	Count           types.Int64 `tfsdk:"count"`
+	CumulativeCount types.Int64 `tfsdk:"cumulative_count"`
+}
+
+func (d *LatencyHistogramDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_latency_histogram"
+}
+
+func (d *LatencyHistogramDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to get the response time distribution of a monitor's successful checks over a " +
+			"time window, bucketed like a Prometheus histogram. It is meant for SLO burn-rate and latency objective calculations " +
+			"in downstream tooling without pulling raw results.",
+
+		Attributes: map[string]schema.Attribute{
+			"monitor_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the monitor.",
+				Required:            true,
+			},
+			"hours": schema.Int64Attribute{
+				MarkdownDescription: "The time window in hours. Default is 24.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 2160),
+				},
+			},
+			"bucket_bounds_ms": schema.SetAttribute{
+				MarkdownDescription: "The upper bounds of the buckets, in milliseconds. A final bucket without an upper bound is " +
+					"always added. When omitted, the server's default bounds are used.",
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(50),
+					setvalidator.ValueInt64sAre(int64validator.AtLeast(1)),
+				},
+			},
+			"total_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of successful checks in the window.",
+				Computed:            true,
+			},
+			"p50_ms": schema.Float64Attribute{
+				MarkdownDescription: "The median response time, in milliseconds.",
+				Computed:            true,
+			},
+			"p95_ms": schema.Float64Attribute{
+				MarkdownDescription: "The 95th percentile response time, in milliseconds.",
+				Computed:            true,
+			},
+			"p99_ms": schema.Float64Attribute{
+				MarkdownDescription: "The 99th percentile response time, in milliseconds.",
+				Computed:            true,
+			},
+			"buckets": schema.ListNestedAttribute{
+				MarkdownDescription: "The buckets, ordered by upper bound.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"upper_bound_ms": schema.Int64Attribute{
+							MarkdownDescription: "The inclusive upper bound of the bucket, in milliseconds. Null for the last bucket.",
+							Computed:            true,
+						},
+						"count": schema.Int64Attribute{
+							MarkdownDescription: "The number of checks slower than the previous bucket's bound and at most this bucket's bound.",
+							Computed:            true,
+						},
+						"cumulative_count": schema.Int64Attribute{
+							MarkdownDescription: "The number of checks at most this bucket's bound.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *LatencyHistogramDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *LatencyHistogramDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
+	var data LatencyHistogramDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hours := 0
+	if !data.Hours.IsNull() {
+		hours = int(data.Hours.ValueInt64())
+	}
+
+	var bounds []int64
+	resp.Diagnostics.Append(data.BucketBoundsMs.ElementsAs(ctx, &bounds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	slices.Sort(bounds)
+	boundsMs := make([]int, len(bounds))
+	for i, b := range bounds {
+		boundsMs[i] = int(b)
+	}
+
+	histogram, err := d.client.GetMonitorLatencyHistogram(ctx, data.MonitorID.ValueString(), hours, boundsMs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get monitor latency histogram, got error: %s", err))
+		return
+	}
+
+	data.Hours = types.Int64Value(int64(histogram.Hours))
+	data.TotalCount = types.Int64Value(int64(histogram.TotalCount))
+	data.P50Ms = types.Float64Value(histogram.P50Ms)
+	data.P95Ms = types.Float64Value(histogram.P95Ms)
+	data.P99Ms = types.Float64Value(histogram.P99Ms)
+	data.Buckets = latencyBucketsFromClient(histogram.Buckets)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// latencyBucketsFromClient converts buckets and adds their running totals.
+func latencyBucketsFromClient(buckets []client.LatencyBucket) []LatencyBucketModel {
+	result := make([]LatencyBucketModel, len(buckets))
+	cumulative := 0
+	for i, bucket := range buckets {
+		cumulative += bucket.Count
+		result[i] = LatencyBucketModel{
+			UpperBoundMs:    types.Int64Null(),
+			Count:           types.Int64Value(int64(bucket.Count)),
+			CumulativeCount: types.Int64Value(int64(cumulative)),
+		}
+		if bucket.UpperBoundMs != nil {
+			result[i].UpperBoundMs = types.Int64Value(int64(*bucket.UpperBoundMs))
+		}
+	}
+	return result
+}
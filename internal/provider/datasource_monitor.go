@@ -27,20 +27,23 @@ type MonitorDataSource struct {
 
 // MonitorDataSourceModel describes the data source data model.
 type MonitorDataSourceModel struct {
-	ID               types.String  `tfsdk:"id"`
-	Name             types.String  `tfsdk:"name"`
-	Type             types.String  `tfsdk:"type"`
-	IsEnabled        types.Bool    `tfsdk:"is_enabled"`
-	FrequencySeconds types.Int64   `tfsdk:"frequency_seconds"`
-	TimeoutMs        types.Int64   `tfsdk:"timeout_ms"`
-	Retries          types.Int64   `tfsdk:"retries"`
-	GeneralRegion    types.String  `tfsdk:"general_region"`
-	SpecificRegion   types.String  `tfsdk:"specific_region"`
-	Status           types.String  `tfsdk:"status"`
-	UptimePercentage types.Float64 `tfsdk:"uptime_percentage"`
-	LastChecked      types.String  `tfsdk:"last_checked"`
-	CreatedAt        types.String  `tfsdk:"created_at"`
-	UpdatedAt        types.String  `tfsdk:"updated_at"`
+	ID                types.String  `tfsdk:"id"`
+	Name              types.String  `tfsdk:"name"`
+	Type              types.String  `tfsdk:"type"`
+	IsEnabled         types.Bool    `tfsdk:"is_enabled"`
+	FrequencySeconds  types.Int64   `tfsdk:"frequency_seconds"`
+	TimeoutMs         types.Int64   `tfsdk:"timeout_ms"`
+	Retries           types.Int64   `tfsdk:"retries"`
+	GeneralRegion     types.String  `tfsdk:"general_region"`
+	SpecificRegion    types.String  `tfsdk:"specific_region"`
+	Regions           types.Set     `tfsdk:"regions"`
+	MinRegionsFailing types.Int64   `tfsdk:"min_regions_failing"`
+	IPVersion         types.String  `tfsdk:"ip_version"`
+	Status            types.String  `tfsdk:"status"`
+	UptimePercentage  types.Float64 `tfsdk:"uptime_percentage"`
+	LastChecked       types.String  `tfsdk:"last_checked"`
+	CreatedAt         types.String  `tfsdk:"created_at"`
+	UpdatedAt         types.String  `tfsdk:"updated_at"`
 
 	// HTTP specific
 	URL                types.String `tfsdk:"url"`
@@ -48,23 +51,45 @@ type MonitorDataSourceModel struct {
 	ValidateStatus     types.Bool   `tfsdk:"validate_status"`
 	ValidateBody       types.Bool   `tfsdk:"validate_body"`
 	BodyPattern        types.String `tfsdk:"body_pattern"`
+	BodyPatternMode    types.String `tfsdk:"body_pattern_mode"`
+	BodyPatternIsRegex types.Bool   `tfsdk:"body_pattern_is_regex"`
+	ResponseSchema     types.String `tfsdk:"response_schema"`
+	ResolveToIP        types.String `tfsdk:"resolve_to_ip"`
+	HostHeader         types.String `tfsdk:"host_header"`
+	FollowRedirects    types.Bool   `tfsdk:"follow_redirects"`
+	MaxRedirects       types.Int64  `tfsdk:"max_redirects"`
 	Headers            types.String `tfsdk:"headers"`
 
 	// DNS specific
-	DNSRecordType types.String `tfsdk:"dns_record_type"`
-	ExpectedValue types.String `tfsdk:"expected_value"`
-	Nameserver    types.String `tfsdk:"nameserver"`
+	DNSRecordType  types.String `tfsdk:"dns_record_type"`
+	ExpectedValue  types.String `tfsdk:"expected_value"`
+	Nameserver     types.String `tfsdk:"nameserver"`
+	ValidateDNSSEC types.Bool   `tfsdk:"validate_dnssec"`
 
 	// TCP specific
-	Host types.String `tfsdk:"host"`
-	Port types.Int64  `tfsdk:"port"`
+	Host   types.String `tfsdk:"host"`
+	Port   types.Int64  `tfsdk:"port"`
+	UseTLS types.Bool   `tfsdk:"use_tls"`
 
 	// SSL specific
-	Domain                   types.String `tfsdk:"domain"`
-	CheckExpirationThreshold types.Bool   `tfsdk:"check_expiration_threshold"`
-	ExpirationThreshold      types.Int64  `tfsdk:"expiration_threshold"`
-	CheckProtocolVersion     types.Bool   `tfsdk:"check_protocol_version"`
-	MinimumProtocol          types.String `tfsdk:"minimum_protocol"`
+	Domain                    types.String `tfsdk:"domain"`
+	ServerName                types.String `tfsdk:"server_name"`
+	CheckExpirationThreshold  types.Bool   `tfsdk:"check_expiration_threshold"`
+	ExpirationThreshold       types.Int64  `tfsdk:"expiration_threshold"`
+	CheckProtocolVersion      types.Bool   `tfsdk:"check_protocol_version"`
+	MinimumProtocol           types.String `tfsdk:"minimum_protocol"`
+	CheckChain                types.Bool   `tfsdk:"check_chain"`
+	ExpectedIssuer            types.String `tfsdk:"expected_issuer"`
+	CheckRevocation           types.Bool   `tfsdk:"check_revocation"`
+	ExpectedFingerprintSHA256 types.String `tfsdk:"expected_fingerprint_sha256"`
+
+	// Result storage
+	ResultSampling *ResultSamplingModel `tfsdk:"result_sampling"`
+
+	// Incident handling
+	SeverityMapping         types.Map   `tfsdk:"severity_mapping"`
+	AutoResolveAfterMinutes types.Int64 `tfsdk:"auto_resolve_after_minutes"`
+	ReopenWindowMinutes     types.Int64 `tfsdk:"reopen_window_minutes"`
 }
 
 func (d *MonitorDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -112,6 +137,19 @@ func (d *MonitorDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 				MarkdownDescription: "The specific region for monitoring.",
 				Computed:            true,
 			},
+			"regions": schema.SetAttribute{
+				MarkdownDescription: "The regions checks run from.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"min_regions_failing": schema.Int64Attribute{
+				MarkdownDescription: "How many regions must fail before the monitor is marked down.",
+				Computed:            true,
+			},
+			"ip_version": schema.StringAttribute{
+				MarkdownDescription: "The IP version checks connect over: `ipv4`, `ipv6` or `any`.",
+				Computed:            true,
+			},
 			"status": schema.StringAttribute{
 				MarkdownDescription: "The current status of the monitor.",
 				Computed:            true,
@@ -152,6 +190,34 @@ func (d *MonitorDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 				MarkdownDescription: "The pattern to match in the response body.",
 				Computed:            true,
 			},
+			"body_pattern_mode": schema.StringAttribute{
+				MarkdownDescription: "How `body_pattern` is matched: `contains` or `not_contains`.",
+				Computed:            true,
+			},
+			"body_pattern_is_regex": schema.BoolAttribute{
+				MarkdownDescription: "Whether `body_pattern` is a regular expression rather than a plain substring.",
+				Computed:            true,
+			},
+			"response_schema": schema.StringAttribute{
+				MarkdownDescription: "The JSON Schema document the response body must validate against.",
+				Computed:            true,
+			},
+			"resolve_to_ip": schema.StringAttribute{
+				MarkdownDescription: "The address HTTP checks connect to instead of resolving the URL's host.",
+				Computed:            true,
+			},
+			"host_header": schema.StringAttribute{
+				MarkdownDescription: "The Host header HTTP checks send instead of the URL's host.",
+				Computed:            true,
+			},
+			"follow_redirects": schema.BoolAttribute{
+				MarkdownDescription: "Whether redirects are followed to the final page.",
+				Computed:            true,
+			},
+			"max_redirects": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of redirects followed.",
+				Computed:            true,
+			},
 			"headers": schema.StringAttribute{
 				MarkdownDescription: "HTTP headers as a JSON string.",
 				Computed:            true,
@@ -168,18 +234,30 @@ func (d *MonitorDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 				MarkdownDescription: "The nameserver to query.",
 				Computed:            true,
 			},
+			"validate_dnssec": schema.BoolAttribute{
+				MarkdownDescription: "Whether the DNSSEC chain of trust is validated.",
+				Computed:            true,
+			},
 			"host": schema.StringAttribute{
 				MarkdownDescription: "The host to connect to (TCP monitors).",
 				Computed:            true,
 			},
 			"port": schema.Int64Attribute{
-				MarkdownDescription: "The port to connect to (TCP monitors).",
+				MarkdownDescription: "The port to connect to (TCP and SSL monitors).",
+				Computed:            true,
+			},
+			"use_tls": schema.BoolAttribute{
+				MarkdownDescription: "Whether TCP checks complete a TLS handshake after connecting.",
 				Computed:            true,
 			},
 			"domain": schema.StringAttribute{
 				MarkdownDescription: "The domain to check SSL certificate for.",
 				Computed:            true,
 			},
+			"server_name": schema.StringAttribute{
+				MarkdownDescription: "The server name sent in the TLS SNI extension (SSL monitors).",
+				Computed:            true,
+			},
 			"check_expiration_threshold": schema.BoolAttribute{
 				MarkdownDescription: "Whether to check if the certificate is expiring soon.",
 				Computed:            true,
@@ -196,6 +274,49 @@ func (d *MonitorDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 				MarkdownDescription: "The minimum TLS protocol version.",
 				Computed:            true,
 			},
+			"check_chain": schema.BoolAttribute{
+				MarkdownDescription: "Whether the certificate chain is verified.",
+				Computed:            true,
+			},
+			"expected_issuer": schema.StringAttribute{
+				MarkdownDescription: "The expected issuer of the certificate.",
+				Computed:            true,
+			},
+			"check_revocation": schema.BoolAttribute{
+				MarkdownDescription: "Whether the certificate's revocation status is checked.",
+				Computed:            true,
+			},
+			"expected_fingerprint_sha256": schema.StringAttribute{
+				MarkdownDescription: "The pinned SHA-256 fingerprint of the leaf certificate.",
+				Computed:            true,
+			},
+			"result_sampling": schema.SingleNestedAttribute{
+				MarkdownDescription: "Which check results are stored.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"success_sample_rate": schema.Int64Attribute{
+						MarkdownDescription: "One in every N successful results is stored.",
+						Computed:            true,
+					},
+					"store_all_failures": schema.BoolAttribute{
+						MarkdownDescription: "Whether every failed result is stored.",
+						Computed:            true,
+					},
+				},
+			},
+			"severity_mapping": schema.MapAttribute{
+				MarkdownDescription: "Failure conditions mapped to the severity of the incident they open.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"auto_resolve_after_minutes": schema.Int64Attribute{
+				MarkdownDescription: "How long the monitor must stay healthy before an open incident is resolved, in minutes.",
+				Computed:            true,
+			},
+			"reopen_window_minutes": schema.Int64Attribute{
+				MarkdownDescription: "Window after resolution, in minutes, during which a new failure reopens the previous incident.",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -218,6 +339,9 @@ func (d *MonitorDataSource) Configure(ctx context.Context, req datasource.Config
 }
 
 func (d *MonitorDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
 	var data MonitorDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -232,12 +356,12 @@ func (d *MonitorDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	}
 
 	data.Name = types.StringValue(monitor.Name)
-	data.Type = types.StringValue(monitor.Type)
+	data.Type = types.StringValue(string(monitor.Type))
 	data.IsEnabled = types.BoolValue(monitor.IsEnabled)
 	data.FrequencySeconds = types.Int64Value(int64(monitor.FrequencySeconds))
 	data.TimeoutMs = types.Int64Value(int64(monitor.TimeoutMs))
 	data.Retries = types.Int64Value(int64(monitor.Retries))
-	data.Status = types.StringValue(monitor.Status)
+	data.Status = types.StringValue(string(monitor.Status))
 	data.UptimePercentage = types.Float64Value(monitor.UptimePercentage)
 	data.CreatedAt = types.StringValue(monitor.CreatedAt)
 	data.UpdatedAt = types.StringValue(monitor.UpdatedAt)
@@ -248,6 +372,16 @@ func (d *MonitorDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	if monitor.SpecificRegion != "" {
 		data.SpecificRegion = types.StringValue(monitor.SpecificRegion)
 	}
+	data.Regions = types.SetNull(types.StringType)
+	if len(monitor.Regions) > 0 {
+		data.Regions = stringSetValue(monitor.Regions)
+	}
+	if monitor.MinRegionsFailing != 0 {
+		data.MinRegionsFailing = types.Int64Value(int64(monitor.MinRegionsFailing))
+	}
+	if monitor.IPVersion != "" {
+		data.IPVersion = types.StringValue(monitor.IPVersion)
+	}
 	if monitor.LastChecked != "" {
 		data.LastChecked = types.StringValue(monitor.LastChecked)
 	}
@@ -262,6 +396,23 @@ func (d *MonitorDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	if monitor.BodyPattern != "" {
 		data.BodyPattern = types.StringValue(monitor.BodyPattern)
 	}
+	if monitor.BodyPatternMode != "" {
+		data.BodyPatternMode = types.StringValue(monitor.BodyPatternMode)
+	}
+	data.BodyPatternIsRegex = types.BoolValue(monitor.BodyPatternIsRegex)
+	if monitor.ResponseSchema != "" {
+		data.ResponseSchema = types.StringValue(monitor.ResponseSchema)
+	}
+	if monitor.ResolveToIP != "" {
+		data.ResolveToIP = types.StringValue(monitor.ResolveToIP)
+	}
+	if monitor.HostHeader != "" {
+		data.HostHeader = types.StringValue(monitor.HostHeader)
+	}
+	data.FollowRedirects = types.BoolPointerValue(monitor.FollowRedirects)
+	if monitor.MaxRedirects != 0 {
+		data.MaxRedirects = types.Int64Value(int64(monitor.MaxRedirects))
+	}
 	if monitor.Headers != "" {
 		data.Headers = types.StringValue(monitor.Headers)
 	}
@@ -274,15 +425,20 @@ func (d *MonitorDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	if monitor.Nameserver != "" {
 		data.Nameserver = types.StringValue(monitor.Nameserver)
 	}
+	data.ValidateDNSSEC = types.BoolValue(monitor.ValidateDNSSEC)
 	if monitor.Host != "" {
 		data.Host = types.StringValue(monitor.Host)
 	}
 	if monitor.Port != 0 {
 		data.Port = types.Int64Value(int64(monitor.Port))
 	}
+	data.UseTLS = types.BoolValue(monitor.UseTLS)
 	if monitor.Domain != "" {
 		data.Domain = types.StringValue(monitor.Domain)
 	}
+	if monitor.ServerName != "" {
+		data.ServerName = types.StringValue(monitor.ServerName)
+	}
 	data.CheckExpirationThreshold = types.BoolValue(monitor.CheckExpirationThreshold)
 	if monitor.ExpirationThreshold != 0 {
 		data.ExpirationThreshold = types.Int64Value(int64(monitor.ExpirationThreshold))
@@ -291,6 +447,26 @@ func (d *MonitorDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	if monitor.MinimumProtocol != "" {
 		data.MinimumProtocol = types.StringValue(monitor.MinimumProtocol)
 	}
+	data.CheckChain = types.BoolValue(monitor.CheckChain)
+	if monitor.ExpectedIssuer != "" {
+		data.ExpectedIssuer = types.StringValue(monitor.ExpectedIssuer)
+	}
+	data.CheckRevocation = types.BoolValue(monitor.CheckRevocation)
+	if monitor.ExpectedFingerprintSHA256 != "" {
+		data.ExpectedFingerprintSHA256 = types.StringValue(monitor.ExpectedFingerprintSHA256)
+	}
+	if monitor.ResultSampling != nil {
+		data.ResultSampling = resultSamplingFromClient(monitor.ResultSampling)
+	}
+	if len(monitor.SeverityMapping) > 0 {
+		data.SeverityMapping = stringMapValue(monitor.SeverityMapping)
+	}
+	if monitor.AutoResolveAfterMinutes != 0 {
+		data.AutoResolveAfterMinutes = types.Int64Value(int64(monitor.AutoResolveAfterMinutes))
+	}
+	if monitor.ReopenWindowMinutes != 0 {
+		data.ReopenWindowMinutes = types.Int64Value(int64(monitor.ReopenWindowMinutes))
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -0,0 +1,146 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ackack-io/terraform-provider-ackack/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &MonitorBadgeDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &MonitorBadgeDataSource{}
+
+func NewMonitorBadgeDataSource() datasource.DataSource {
+	return &MonitorBadgeDataSource{}
+}
+
+// MonitorBadgeDataSource defines the data source implementation.
+type MonitorBadgeDataSource struct {
+	client *client.Client
+}
+
+// MonitorBadgeDataSourceModel describes the data source data model.
+type MonitorBadgeDataSourceModel struct {
+	MonitorID  types.String `tfsdk:"monitor_id"`
+	SystemID   types.String `tfsdk:"system_id"`
+	Style      types.String `tfsdk:"style"`
+	IncludeSVG types.Bool   `tfsdk:"include_svg"`
+	URL        types.String `tfsdk:"url"`
+	Markdown   types.String `tfsdk:"markdown"`
+	SVG        types.String `tfsdk:"svg"`
+}
+
+func (d *MonitorBadgeDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_monitor_badge"
+}
+
+func (d *MonitorBadgeDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to get the public uptime badge of a monitor or system, for embedding in READMEs and dashboards.",
+
+		Attributes: map[string]schema.Attribute{
+			"monitor_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the monitor. Exactly one of `monitor_id` or `system_id` must be set.",
+				Optional:            true,
+			},
+			"system_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the system. Exactly one of `monitor_id` or `system_id` must be set.",
+				Optional:            true,
+			},
+			"style": schema.StringAttribute{
+				MarkdownDescription: "The badge style. Valid values: `flat`, `flat-square`, `plastic`. When omitted, the API default is used.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("flat", "flat-square", "plastic"),
+				},
+			},
+			"include_svg": schema.BoolAttribute{
+				MarkdownDescription: "Whether to also return the rendered SVG in `svg`. Default is false.",
+				Optional:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The public URL of the badge image.",
+				Computed:            true,
+			},
+			"markdown": schema.StringAttribute{
+				MarkdownDescription: "A Markdown image snippet embedding the badge.",
+				Computed:            true,
+			},
+			"svg": schema.StringAttribute{
+				MarkdownDescription: "The SVG content of the badge, when `include_svg` is true.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *MonitorBadgeDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("monitor_id"),
+			path.MatchRoot("system_id"),
+		),
+	}
+}
+
+func (d *MonitorBadgeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	requireFeature(c, "badges", "Status badges", &resp.Diagnostics)
+
+	d.client = c
+}
+
+func (d *MonitorBadgeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
+	var data MonitorBadgeDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var badge *client.Badge
+	var err error
+	if !data.SystemID.IsNull() {
+		badge, err = d.client.GetSystemBadge(ctx, data.SystemID.ValueString(), data.Style.ValueString(), data.IncludeSVG.ValueBool())
+	} else {
+		badge, err = d.client.GetMonitorBadge(ctx, data.MonitorID.ValueString(), data.Style.ValueString(), data.IncludeSVG.ValueBool())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get badge, got error: %s", err))
+		return
+	}
+
+	data.URL = types.StringValue(badge.URL)
+	data.Markdown = types.StringValue(fmt.Sprintf("![Uptime](%s)", badge.URL))
+	if badge.SVG != "" {
+		data.SVG = types.StringValue(badge.SVG)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -120,6 +120,9 @@ func (d *MonitorHealthDataSource) Configure(ctx context.Context, req datasource.
 }
 
 func (d *MonitorHealthDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
 	var data MonitorHealthDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
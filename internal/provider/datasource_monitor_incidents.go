@@ -128,6 +128,9 @@ func (d *MonitorIncidentsDataSource) Configure(ctx context.Context, req datasour
 }
 
 func (d *MonitorIncidentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
 	var data MonitorIncidentsDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -151,7 +154,7 @@ func (d *MonitorIncidentsDataSource) Read(ctx context.Context, req datasource.Re
 		data.Incidents[i] = IncidentItemModel{
 			ID:              types.StringValue(incident.ID),
 			Status:          types.StringValue(incident.Status),
-			Severity:        types.StringValue(incident.Severity),
+			Severity:        types.StringValue(string(incident.Severity)),
 			StartedAt:       types.StringValue(incident.StartedAt),
 			DurationSeconds: types.Int64Value(int64(incident.DurationSeconds)),
 			Notified:        types.BoolValue(incident.Notified),
@@ -0,0 +1,145 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ackack-io/terraform-provider-ackack/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &MonitorIsUpDataSource{}
+
+func NewMonitorIsUpDataSource() datasource.DataSource {
+	return &MonitorIsUpDataSource{}
+}
+
+// MonitorIsUpDataSource defines the data source implementation.
+type MonitorIsUpDataSource struct {
+	client *client.Client
+}
+
+// MonitorIsUpDataSourceModel describes the data source data model.
+type MonitorIsUpDataSourceModel struct {
+	MonitorID     types.String `tfsdk:"monitor_id"`
+	AllowDegraded types.Bool   `tfsdk:"allow_degraded"`
+	IsUp          types.Bool   `tfsdk:"is_up"`
+	Status        types.String `tfsdk:"status"`
+	Message       types.String `tfsdk:"message"`
+}
+
+func (d *MonitorIsUpDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_monitor_is_up"
+}
+
+func (d *MonitorIsUpDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to assert that a monitor is up. It is meant for `check` blocks: an unhealthy monitor " +
+			"does not fail the data source, it sets `is_up` to false with a readable `message`, so an `assert` on `is_up` reports a warning " +
+			"after every plan and apply without blocking the run.",
+
+		Attributes: map[string]schema.Attribute{
+			"monitor_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the monitor.",
+				Required:            true,
+			},
+			"allow_degraded": schema.BoolAttribute{
+				MarkdownDescription: "Whether a monitor in the `degraded` state counts as up. Default is false.",
+				Optional:            true,
+			},
+			"is_up": schema.BoolAttribute{
+				MarkdownDescription: "Whether the monitor is enabled and its last check succeeded.",
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The current status of the monitor.",
+				Computed:            true,
+			},
+			"message": schema.StringAttribute{
+				MarkdownDescription: "A one-line description of the monitor's state, including the last error when it is not up. " +
+					"Suitable as the `error_message` of an `assert`.",
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *MonitorIsUpDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *MonitorIsUpDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
+	var data MonitorIsUpDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	monitor, err := d.client.GetMonitor(ctx, data.MonitorID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read monitor, got error: %s", err))
+		return
+	}
+
+	data.Status = types.StringValue(string(monitor.Status))
+	data.IsUp = types.BoolValue(monitorIsUp(monitor, data.AllowDegraded.ValueBool()))
+	data.Message = types.StringValue(monitorIsUpMessage(monitor, data.IsUp.ValueBool()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// monitorIsUp reports whether a monitor is enabled and not failing.
+func monitorIsUp(monitor *client.Monitor, allowDegraded bool) bool {
+	if !monitor.IsEnabled {
+		return false
+	}
+	switch monitor.Status {
+	case client.MonitorStatusError:
+		return false
+	case client.MonitorStatusDegraded:
+		return allowDegraded
+	}
+	return true
+}
+
+// monitorIsUpMessage describes the state of a monitor in one line.
+func monitorIsUpMessage(monitor *client.Monitor, isUp bool) string {
+	switch {
+	case isUp:
+		return fmt.Sprintf("Monitor %q is up.", monitor.Name)
+	case !monitor.IsEnabled:
+		return fmt.Sprintf("Monitor %q is disabled.", monitor.Name)
+	}
+
+	message := fmt.Sprintf("Monitor %q is not up (status %s)", monitor.Name, monitor.Status)
+	if monitor.LastErrorMessage != "" {
+		message += ": " + monitor.LastErrorMessage
+	}
+	if monitor.LastChecked != "" {
+		message += fmt.Sprintf(" (last checked %s)", monitor.LastChecked)
+	}
+	return message + "."
+}
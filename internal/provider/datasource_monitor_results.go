@@ -27,25 +27,40 @@ type MonitorResultsDataSource struct {
 
 // MonitorResultsDataSourceModel describes the data source data model.
 type MonitorResultsDataSourceModel struct {
-	MonitorID types.String             `tfsdk:"monitor_id"`
-	Limit     types.Int64              `tfsdk:"limit"`
-	Results   []MonitorResultItemModel `tfsdk:"results"`
+	MonitorID        types.String             `tfsdk:"monitor_id"`
+	Limit            types.Int64              `tfsdk:"limit"`
+	Region           types.String             `tfsdk:"region"`
+	ExcludeWorkerIDs types.Set                `tfsdk:"exclude_worker_ids"`
+	Results          []MonitorResultItemModel `tfsdk:"results"`
 }
 
 // MonitorResultItemModel describes a single check result.
 type MonitorResultItemModel struct {
-	ID                        types.Int64  `tfsdk:"id"`
-	Status                    types.String `tfsdk:"status"`
-	ResponseTime              types.Int64  `tfsdk:"response_time"`
-	ResponseSizeBytes         types.Int64  `tfsdk:"response_size_bytes"`
-	Timestamp                 types.String `tfsdk:"timestamp"`
-	Region                    types.String `tfsdk:"region"`
-	Message                   types.String `tfsdk:"message"`
-	ErrorType                 types.String `tfsdk:"error_type"`
-	StatusCode                types.Int64  `tfsdk:"status_code"`
-	DNSResponse               types.String `tfsdk:"dns_response"`
-	TLSVersion                types.String `tfsdk:"tls_version"`
-	CertificateExpirationDays types.Int64  `tfsdk:"certificate_expiration_days"`
+	ID                        types.Int64         `tfsdk:"id"`
+	Status                    types.String        `tfsdk:"status"`
+	ResponseTime              types.Int64         `tfsdk:"response_time"`
+	ResponseSizeBytes         types.Int64         `tfsdk:"response_size_bytes"`
+	Timestamp                 types.String        `tfsdk:"timestamp"`
+	Region                    types.String        `tfsdk:"region"`
+	WorkerID                  types.String        `tfsdk:"worker_id"`
+	Message                   types.String        `tfsdk:"message"`
+	ErrorType                 types.String        `tfsdk:"error_type"`
+	StatusCode                types.Int64         `tfsdk:"status_code"`
+	DNSResponse               types.String        `tfsdk:"dns_response"`
+	TLSVersion                types.String        `tfsdk:"tls_version"`
+	CertificateExpirationDays types.Int64         `tfsdk:"certificate_expiration_days"`
+	Timings                   *ResultTimingsModel `tfsdk:"timings"`
+	IsAnomaly                 types.Bool          `tfsdk:"is_anomaly"`
+	BaselineDeviation         types.Float64       `tfsdk:"baseline_deviation"`
+}
+
+// ResultTimingsModel describes the phase timings of an HTTP check.
+type ResultTimingsModel struct {
+	DNSMs      types.Int64 `tfsdk:"dns_ms"`
+	ConnectMs  types.Int64 `tfsdk:"connect_ms"`
+	TLSMs      types.Int64 `tfsdk:"tls_ms"`
+	TTFBMs     types.Int64 `tfsdk:"ttfb_ms"`
+	TransferMs types.Int64 `tfsdk:"transfer_ms"`
 }
 
 func (d *MonitorResultsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -65,6 +80,15 @@ func (d *MonitorResultsDataSource) Schema(ctx context.Context, req datasource.Sc
 				MarkdownDescription: "Maximum number of results to return. Default is 100, max is 1000.",
 				Optional:            true,
 			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "Only return results from checks performed in this region.",
+				Optional:            true,
+			},
+			"exclude_worker_ids": schema.SetAttribute{
+				MarkdownDescription: "Worker IDs whose results are dropped from `results`, e.g. to exclude a known-bad probe.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
 			"results": schema.ListNestedAttribute{
 				MarkdownDescription: "List of check results.",
 				Computed:            true,
@@ -94,6 +118,10 @@ func (d *MonitorResultsDataSource) Schema(ctx context.Context, req datasource.Sc
 							MarkdownDescription: "The region where the check was performed.",
 							Computed:            true,
 						},
+						"worker_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the worker (probe) that performed the check.",
+							Computed:            true,
+						},
 						"message": schema.StringAttribute{
 							MarkdownDescription: "Any message associated with the check.",
 							Computed:            true,
@@ -118,6 +146,41 @@ func (d *MonitorResultsDataSource) Schema(ctx context.Context, req datasource.Sc
 							MarkdownDescription: "Days until certificate expiration (for SSL monitors).",
 							Computed:            true,
 						},
+						"timings": schema.SingleNestedAttribute{
+							MarkdownDescription: "How long each phase of the request took, in milliseconds (for HTTP monitors).",
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+								"dns_ms": schema.Int64Attribute{
+									MarkdownDescription: "DNS lookup time.",
+									Computed:            true,
+								},
+								"connect_ms": schema.Int64Attribute{
+									MarkdownDescription: "TCP connect time.",
+									Computed:            true,
+								},
+								"tls_ms": schema.Int64Attribute{
+									MarkdownDescription: "TLS handshake time.",
+									Computed:            true,
+								},
+								"ttfb_ms": schema.Int64Attribute{
+									MarkdownDescription: "Time to first byte.",
+									Computed:            true,
+								},
+								"transfer_ms": schema.Int64Attribute{
+									MarkdownDescription: "Response body download time.",
+									Computed:            true,
+								},
+							},
+						},
+						"is_anomaly": schema.BoolAttribute{
+							MarkdownDescription: "Whether the anomaly detector flagged this result. Null when anomaly detection is not enabled for the monitor.",
+							Computed:            true,
+						},
+						"baseline_deviation": schema.Float64Attribute{
+							MarkdownDescription: "How far the response time deviates from the detector's baseline, in standard deviations. " +
+								"Null when anomaly detection is not enabled for the monitor.",
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -143,6 +206,9 @@ func (d *MonitorResultsDataSource) Configure(ctx context.Context, req datasource
 }
 
 func (d *MonitorResultsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
 	var data MonitorResultsDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -155,15 +221,30 @@ func (d *MonitorResultsDataSource) Read(ctx context.Context, req datasource.Read
 		limit = int(data.Limit.ValueInt64())
 	}
 
-	results, err := d.client.GetMonitorResults(ctx, data.MonitorID.ValueString(), limit)
+	excluded := make(map[string]bool)
+	if !data.ExcludeWorkerIDs.IsNull() {
+		var workerIDs []string
+		resp.Diagnostics.Append(data.ExcludeWorkerIDs.ElementsAs(ctx, &workerIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		for _, id := range workerIDs {
+			excluded[id] = true
+		}
+	}
+
+	results, err := d.client.GetMonitorResults(ctx, data.MonitorID.ValueString(), limit, data.Region.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get monitor results, got error: %s", err))
 		return
 	}
 
-	data.Results = make([]MonitorResultItemModel, len(results))
-	for i, result := range results {
-		data.Results[i] = MonitorResultItemModel{
+	data.Results = make([]MonitorResultItemModel, 0, len(results))
+	for _, result := range results {
+		if excluded[result.WorkerID] {
+			continue
+		}
+		item := MonitorResultItemModel{
 			ID:                types.Int64Value(int64(result.ID)),
 			Status:            types.StringValue(result.Status),
 			ResponseTime:      types.Int64Value(int64(result.ResponseTime)),
@@ -171,26 +252,41 @@ func (d *MonitorResultsDataSource) Read(ctx context.Context, req datasource.Read
 			Timestamp:         types.StringValue(result.Timestamp),
 		}
 		if result.Region != "" {
-			data.Results[i].Region = types.StringValue(result.Region)
+			item.Region = types.StringValue(result.Region)
+		}
+		if result.WorkerID != "" {
+			item.WorkerID = types.StringValue(result.WorkerID)
 		}
 		if result.Message != "" {
-			data.Results[i].Message = types.StringValue(result.Message)
+			item.Message = types.StringValue(result.Message)
 		}
 		if result.ErrorType != "" {
-			data.Results[i].ErrorType = types.StringValue(result.ErrorType)
+			item.ErrorType = types.StringValue(result.ErrorType)
 		}
 		if result.StatusCode != 0 {
-			data.Results[i].StatusCode = types.Int64Value(int64(result.StatusCode))
+			item.StatusCode = types.Int64Value(int64(result.StatusCode))
 		}
 		if result.DNSResponse != "" {
-			data.Results[i].DNSResponse = types.StringValue(result.DNSResponse)
+			item.DNSResponse = types.StringValue(result.DNSResponse)
 		}
 		if result.TLSVersion != "" {
-			data.Results[i].TLSVersion = types.StringValue(result.TLSVersion)
+			item.TLSVersion = types.StringValue(result.TLSVersion)
 		}
 		if result.CertificateExpirationDays != 0 {
-			data.Results[i].CertificateExpirationDays = types.Int64Value(int64(result.CertificateExpirationDays))
+			item.CertificateExpirationDays = types.Int64Value(int64(result.CertificateExpirationDays))
+		}
+		if result.Timings != nil {
+			item.Timings = &ResultTimingsModel{
+				DNSMs:      types.Int64Value(int64(result.Timings.DNSMs)),
+				ConnectMs:  types.Int64Value(int64(result.Timings.ConnectMs)),
+				TLSMs:      types.Int64Value(int64(result.Timings.TLSMs)),
+				TTFBMs:     types.Int64Value(int64(result.Timings.TTFBMs)),
+				TransferMs: types.Int64Value(int64(result.Timings.TransferMs)),
+			}
 		}
+		item.IsAnomaly = types.BoolPointerValue(result.IsAnomaly)
+		item.BaselineDeviation = types.Float64PointerValue(result.BaselineDeviation)
+		data.Results = append(data.Results, item)
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
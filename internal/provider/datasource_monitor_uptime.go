@@ -75,6 +75,9 @@ func (d *MonitorUptimeDataSource) Configure(ctx context.Context, req datasource.
 }
 
 func (d *MonitorUptimeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
 	var data MonitorUptimeDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
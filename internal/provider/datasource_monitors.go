@@ -113,6 +113,9 @@ func (d *MonitorsDataSource) Configure(ctx context.Context, req datasource.Confi
 }
 
 func (d *MonitorsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
 	var data MonitorsDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -131,9 +134,9 @@ func (d *MonitorsDataSource) Read(ctx context.Context, req datasource.ReadReques
 		data.Monitors[i] = MonitorListItemModel{
 			ID:               types.StringValue(monitor.ID),
 			Name:             types.StringValue(monitor.Name),
-			Type:             types.StringValue(monitor.Type),
+			Type:             types.StringValue(string(monitor.Type)),
 			IsEnabled:        types.BoolValue(monitor.IsEnabled),
-			Status:           types.StringValue(monitor.Status),
+			Status:           types.StringValue(string(monitor.Status)),
 			UptimePercentage: types.Float64Value(monitor.UptimePercentage),
 			CreatedAt:        types.StringValue(monitor.CreatedAt),
 		}
@@ -8,13 +8,17 @@ import (
 	"fmt"
 
 	"github.com/ackack-io/terraform-provider-ackack/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ datasource.DataSource = &NotificationsDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &NotificationsDataSource{}
 
 func NewNotificationsDataSource() datasource.DataSource {
 	return &NotificationsDataSource{}
@@ -29,6 +33,15 @@ type NotificationsDataSource struct {
 type NotificationsDataSourceModel struct {
 	Page          types.Int64             `tfsdk:"page"`
 	PageSize      types.Int64             `tfsdk:"page_size"`
+	AllPages      types.Bool              `tfsdk:"all_pages"`
+	MaxPages      types.Int64             `tfsdk:"max_pages"`
+	MonitorID     types.String            `tfsdk:"monitor_id"`
+	AlertID       types.String            `tfsdk:"alert_id"`
+	Status        types.String            `tfsdk:"status"`
+	EventType     types.String            `tfsdk:"event_type"`
+	Since         types.String            `tfsdk:"since"`
+	Until         types.String            `tfsdk:"until"`
+	Truncated     types.Bool              `tfsdk:"truncated"`
 	Total         types.Int64             `tfsdk:"total"`
 	TotalPages    types.Int64             `tfsdk:"total_pages"`
 	Notifications []NotificationItemModel `tfsdk:"notifications"`
@@ -69,6 +82,45 @@ func (d *NotificationsDataSource) Schema(ctx context.Context, req datasource.Sch
 				MarkdownDescription: "The page size. Default is 50, max is 100.",
 				Optional:            true,
 			},
+			"all_pages": schema.BoolAttribute{
+				MarkdownDescription: "Whether to fetch every page from `page` onwards, up to `max_pages`, instead of a single page. Default is false.",
+				Optional:            true,
+			},
+			"max_pages": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of pages to fetch when `all_pages` is true. Default is 10.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"monitor_id": schema.StringAttribute{
+				MarkdownDescription: "Only return notifications for this monitor.",
+				Optional:            true,
+			},
+			"alert_id": schema.StringAttribute{
+				MarkdownDescription: "Only return notifications sent through this alert.",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Only return notifications with this status, e.g. `failed` to audit failed deliveries.",
+				Optional:            true,
+			},
+			"event_type": schema.StringAttribute{
+				MarkdownDescription: "Only return notifications for this event type.",
+				Optional:            true,
+			},
+			"since": schema.StringAttribute{
+				MarkdownDescription: "Only return notifications created at or after this time, in RFC 3339 format.",
+				Optional:            true,
+			},
+			"until": schema.StringAttribute{
+				MarkdownDescription: "Only return notifications created at or before this time, in RFC 3339 format.",
+				Optional:            true,
+			},
+			"truncated": schema.BoolAttribute{
+				MarkdownDescription: "Whether more pages were available than `max_pages` allowed to fetch.",
+				Computed:            true,
+			},
 			"total": schema.Int64Attribute{
 				MarkdownDescription: "Total number of notifications.",
 				Computed:            true,
@@ -145,6 +197,29 @@ func (d *NotificationsDataSource) Schema(ctx context.Context, req datasource.Sch
 	}
 }
 
+func (d *NotificationsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data NotificationsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	since := parseTimeAttribute(path.Root("since"), data.Since, &resp.Diagnostics)
+	until := parseTimeAttribute(path.Root("until"), data.Until, &resp.Diagnostics)
+	if since.IsZero() || until.IsZero() {
+		return
+	}
+
+	if until.Before(since) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("until"),
+			"Invalid Time Range",
+			fmt.Sprintf("The until time (%s) must not be before since (%s).", data.Until.ValueString(), data.Since.ValueString()),
+		)
+	}
+}
+
 func (d *NotificationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -163,6 +238,9 @@ func (d *NotificationsDataSource) Configure(ctx context.Context, req datasource.
 }
 
 func (d *NotificationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
 	var data NotificationsDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -179,19 +257,52 @@ func (d *NotificationsDataSource) Read(ctx context.Context, req datasource.ReadR
 		pageSize = int(data.PageSize.ValueInt64())
 	}
 
-	notificationsResp, err := d.client.ListNotificationHistory(ctx, page, pageSize)
+	maxPages := 1
+	if data.AllPages.ValueBool() {
+		maxPages = 10
+		if !data.MaxPages.IsNull() {
+			maxPages = int(data.MaxPages.ValueInt64())
+		}
+	}
+
+	filter := client.NotificationFilter{
+		MonitorID: data.MonitorID.ValueString(),
+		AlertID:   data.AlertID.ValueString(),
+		Status:    data.Status.ValueString(),
+		EventType: data.EventType.ValueString(),
+		Since:     data.Since.ValueString(),
+		Until:     data.Until.ValueString(),
+	}
+
+	notificationsResp, err := d.client.ListNotificationHistory(ctx, page, pageSize, filter)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list notifications, got error: %s", err))
 		return
 	}
 
+	notifications := notificationsResp.Notifications
+	lastPage := notificationsResp.Page
+	for fetched := 1; fetched < maxPages && lastPage < notificationsResp.Pages; fetched++ {
+		pageResp, err := d.client.ListNotificationHistory(ctx, lastPage+1, pageSize, filter)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list notifications, got error: %s", err))
+			return
+		}
+		if len(pageResp.Notifications) == 0 {
+			break
+		}
+		notifications = append(notifications, pageResp.Notifications...)
+		lastPage = pageResp.Page
+	}
+
 	data.Page = types.Int64Value(int64(notificationsResp.Page))
 	data.PageSize = types.Int64Value(int64(notificationsResp.PageSize))
 	data.Total = types.Int64Value(int64(notificationsResp.Total))
 	data.TotalPages = types.Int64Value(int64(notificationsResp.Pages))
+	data.Truncated = types.BoolValue(data.AllPages.ValueBool() && lastPage < notificationsResp.Pages)
 
-	data.Notifications = make([]NotificationItemModel, len(notificationsResp.Notifications))
-	for i, notification := range notificationsResp.Notifications {
+	data.Notifications = make([]NotificationItemModel, len(notifications))
+	for i, notification := range notifications {
 		data.Notifications[i] = NotificationItemModel{
 			ID:               types.StringValue(notification.ID),
 			NotificationType: types.StringValue(notification.NotificationType),
@@ -0,0 +1,186 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ackack-io/terraform-provider-ackack/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &OrganizationAccountsDataSource{}
+
+func NewOrganizationAccountsDataSource() datasource.DataSource {
+	return &OrganizationAccountsDataSource{}
+}
+
+// OrganizationAccountsDataSource defines the data source implementation.
+type OrganizationAccountsDataSource struct {
+	client *client.Client
+}
+
+// OrganizationAccountsDataSourceModel describes the data source data model.
+type OrganizationAccountsDataSourceModel struct {
+	Accounts           []OrganizationAccountListItemModel `tfsdk:"accounts"`
+	TotalMonitors      types.Int64                        `tfsdk:"total_monitors"`
+	TotalMonitorsDown  types.Int64                        `tfsdk:"total_monitors_down"`
+	TotalOpenIncidents types.Int64                        `tfsdk:"total_open_incidents"`
+}
+
+// OrganizationAccountListItemModel describes a single child account.
+type OrganizationAccountListItemModel struct {
+	ID               types.String  `tfsdk:"id"`
+	Name             types.String  `tfsdk:"name"`
+	Email            types.String  `tfsdk:"email"`
+	Plan             types.String  `tfsdk:"plan"`
+	MonitorLimit     types.Int64   `tfsdk:"monitor_limit"`
+	MonitorCount     types.Int64   `tfsdk:"monitor_count"`
+	MonitorsUp       types.Int64   `tfsdk:"monitors_up"`
+	MonitorsDegraded types.Int64   `tfsdk:"monitors_degraded"`
+	MonitorsDown     types.Int64   `tfsdk:"monitors_down"`
+	OpenIncidents    types.Int64   `tfsdk:"open_incidents"`
+	Uptime24h        types.Float64 `tfsdk:"uptime_24h"`
+}
+
+func (d *OrganizationAccountsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_accounts"
+}
+
+func (d *OrganizationAccountsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to list the child accounts of an organization with a summary of each account's monitor fleet. " +
+			"It requires an organization-level API key. To manage resources inside a child account, configure a provider alias with that account's API key.",
+
+		Attributes: map[string]schema.Attribute{
+			"accounts": schema.ListNestedAttribute{
+				MarkdownDescription: "List of child accounts.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The unique identifier of the account.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the account.",
+							Computed:            true,
+						},
+						"email": schema.StringAttribute{
+							MarkdownDescription: "The email address of the account owner.",
+							Computed:            true,
+						},
+						"plan": schema.StringAttribute{
+							MarkdownDescription: "The name of the plan the account is subscribed to.",
+							Computed:            true,
+						},
+						"monitor_limit": schema.Int64Attribute{
+							MarkdownDescription: "The maximum number of monitors allowed by the plan.",
+							Computed:            true,
+						},
+						"monitor_count": schema.Int64Attribute{
+							MarkdownDescription: "The number of monitors in the account.",
+							Computed:            true,
+						},
+						"monitors_up": schema.Int64Attribute{
+							MarkdownDescription: "The number of monitors that are up.",
+							Computed:            true,
+						},
+						"monitors_degraded": schema.Int64Attribute{
+							MarkdownDescription: "The number of monitors that are degraded.",
+							Computed:            true,
+						},
+						"monitors_down": schema.Int64Attribute{
+							MarkdownDescription: "The number of monitors that are down.",
+							Computed:            true,
+						},
+						"open_incidents": schema.Int64Attribute{
+							MarkdownDescription: "The number of open incidents in the account.",
+							Computed:            true,
+						},
+						"uptime_24h": schema.Float64Attribute{
+							MarkdownDescription: "The uptime percentage across the account's monitors over the last 24 hours.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"total_monitors": schema.Int64Attribute{
+				MarkdownDescription: "The number of monitors across all child accounts.",
+				Computed:            true,
+			},
+			"total_monitors_down": schema.Int64Attribute{
+				MarkdownDescription: "The number of down monitors across all child accounts.",
+				Computed:            true,
+			},
+			"total_open_incidents": schema.Int64Attribute{
+				MarkdownDescription: "The number of open incidents across all child accounts.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *OrganizationAccountsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	requireFeature(c, "organizations", "Organizations", &resp.Diagnostics)
+
+	d.client = c
+}
+
+func (d *OrganizationAccountsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
+	var data OrganizationAccountsDataSourceModel
+
+	accounts, err := d.client.ListOrganizationAccounts(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list organization accounts, got error: %s", err))
+		return
+	}
+
+	var totalMonitors, totalDown, totalIncidents int
+	data.Accounts = make([]OrganizationAccountListItemModel, 0, len(accounts))
+	for _, account := range accounts {
+		data.Accounts = append(data.Accounts, OrganizationAccountListItemModel{
+			ID:               types.StringValue(account.ID),
+			Name:             types.StringValue(account.Name),
+			Email:            types.StringValue(account.Email),
+			Plan:             types.StringValue(account.Plan),
+			MonitorLimit:     types.Int64Value(int64(account.MonitorLimit)),
+			MonitorCount:     types.Int64Value(int64(account.MonitorCount)),
+			MonitorsUp:       types.Int64Value(int64(account.MonitorsUp)),
+			MonitorsDegraded: types.Int64Value(int64(account.MonitorsDegraded)),
+			MonitorsDown:     types.Int64Value(int64(account.MonitorsDown)),
+			OpenIncidents:    types.Int64Value(int64(account.OpenIncidents)),
+			Uptime24h:        types.Float64Value(account.Uptime24h),
+		})
+		totalMonitors += account.MonitorCount
+		totalDown += account.MonitorsDown
+		totalIncidents += account.OpenIncidents
+	}
+
+	data.TotalMonitors = types.Int64Value(int64(totalMonitors))
+	data.TotalMonitorsDown = types.Int64Value(int64(totalDown))
+	data.TotalOpenIncidents = types.Int64Value(int64(totalIncidents))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -28,19 +28,21 @@ type SystemDataSource struct {
 
 // SystemDataSourceModel describes the data source data model.
 type SystemDataSourceModel struct {
-	ID            types.String  `tfsdk:"id"`
-	Name          types.String  `tfsdk:"name"`
-	Description   types.String  `tfsdk:"description"`
-	Priority      types.String  `tfsdk:"priority"`
-	Status        types.String  `tfsdk:"status"`
-	ExternalLinks types.List    `tfsdk:"external_links"`
-	MonitorCount  types.Int64   `tfsdk:"monitor_count"`
-	HealthyCount  types.Int64   `tfsdk:"healthy_count"`
-	DegradedCount types.Int64   `tfsdk:"degraded_count"`
-	ErrorCount    types.Int64   `tfsdk:"error_count"`
-	OverallUptime types.Float64 `tfsdk:"overall_uptime"`
-	CreatedAt     types.String  `tfsdk:"created_at"`
-	UpdatedAt     types.String  `tfsdk:"updated_at"`
+	ID                       types.String  `tfsdk:"id"`
+	Name                     types.String  `tfsdk:"name"`
+	Description              types.String  `tfsdk:"description"`
+	Priority                 types.String  `tfsdk:"priority"`
+	DegradedThresholdPercent types.Int64   `tfsdk:"degraded_threshold_percent"`
+	DownThresholdPercent     types.Int64   `tfsdk:"down_threshold_percent"`
+	Status                   types.String  `tfsdk:"status"`
+	ExternalLinks            types.List    `tfsdk:"external_links"`
+	MonitorCount             types.Int64   `tfsdk:"monitor_count"`
+	HealthyCount             types.Int64   `tfsdk:"healthy_count"`
+	DegradedCount            types.Int64   `tfsdk:"degraded_count"`
+	ErrorCount               types.Int64   `tfsdk:"error_count"`
+	OverallUptime            types.Float64 `tfsdk:"overall_uptime"`
+	CreatedAt                types.String  `tfsdk:"created_at"`
+	UpdatedAt                types.String  `tfsdk:"updated_at"`
 }
 
 func (d *SystemDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -68,6 +70,14 @@ func (d *SystemDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 				MarkdownDescription: "The priority of the system.",
 				Computed:            true,
 			},
+			"degraded_threshold_percent": schema.Int64Attribute{
+				MarkdownDescription: "The percentage of unhealthy member monitors at which the system is considered degraded.",
+				Computed:            true,
+			},
+			"down_threshold_percent": schema.Int64Attribute{
+				MarkdownDescription: "The percentage of unhealthy member monitors at which the system is considered down.",
+				Computed:            true,
+			},
 			"status": schema.StringAttribute{
 				MarkdownDescription: "The current status of the system.",
 				Computed:            true,
@@ -138,6 +148,9 @@ func (d *SystemDataSource) Configure(ctx context.Context, req datasource.Configu
 }
 
 func (d *SystemDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
 	var data SystemDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -167,6 +180,12 @@ func (d *SystemDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	if system.Priority != "" {
 		data.Priority = types.StringValue(system.Priority)
 	}
+	if system.DegradedThresholdPercent != 0 {
+		data.DegradedThresholdPercent = types.Int64Value(int64(system.DegradedThresholdPercent))
+	}
+	if system.DownThresholdPercent != 0 {
+		data.DownThresholdPercent = types.Int64Value(int64(system.DownThresholdPercent))
+	}
 
 	// Convert external links
 	if len(system.ExternalLinks) > 0 {
@@ -108,6 +108,9 @@ func (d *SystemsDataSource) Configure(ctx context.Context, req datasource.Config
 }
 
 func (d *SystemsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
 	var data SystemsDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -0,0 +1,130 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/ackack-io/terraform-provider-ackack/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ExpectedRecordModel describes a DNS record a monitor expects in the answer.
+type ExpectedRecordModel struct {
+	Value    types.String `tfsdk:"value"`
+	Priority types.Int64  `tfsdk:"priority"`
+	Weight   types.Int64  `tfsdk:"weight"`
+	Port     types.Int64  `tfsdk:"port"`
+	Flags    types.Int64  `tfsdk:"flags"`
+	Tag      types.String `tfsdk:"tag"`
+}
+
+// caaTags are the accepted values of expected_record.tag.
+var caaTags = []string{"issue", "issuewild", "iodef"}
+
+// expectedRecordFields lists the structured expected_record attributes each
+// record type requires. Record types that are not listed only take value.
+var expectedRecordFields = map[string][]string{
+	"MX":  {"priority"},
+	"SRV": {"priority", "weight", "port"},
+	"CAA": {"flags", "tag"},
+}
+
+// validateExpectedRecords reports expected_record blocks whose structured
+// attributes do not match the record type. Unknown record types are skipped.
+func validateExpectedRecords(recordType types.String, records []ExpectedRecordModel, diags *diag.Diagnostics) {
+	if recordType.IsNull() || recordType.IsUnknown() {
+		return
+	}
+	rrType := strings.ToUpper(recordType.ValueString())
+	required := expectedRecordFields[rrType]
+
+	for i, record := range records {
+		fields := map[string]attr.Value{
+			"priority": record.Priority,
+			"weight":   record.Weight,
+			"port":     record.Port,
+			"flags":    record.Flags,
+			"tag":      record.Tag,
+		}
+		for _, name := range []string{"priority", "weight", "port", "flags", "tag"} {
+			recordPath := path.Root("expected_record").AtListIndex(i).AtName(name)
+			isRequired := slices.Contains(required, name)
+			switch {
+			case isRequired && fields[name].IsNull():
+				diags.AddAttributeError(
+					recordPath,
+					"Missing Attribute Configuration",
+					fmt.Sprintf("The %s attribute is required for %s records.", name, rrType),
+				)
+			case !isRequired && !fields[name].IsNull():
+				diags.AddAttributeError(
+					recordPath,
+					"Invalid Attribute Combination",
+					fmt.Sprintf("The %s attribute cannot be used with %s records.", name, rrType),
+				)
+			}
+		}
+	}
+}
+
+func expectedRecordsToClient(records []ExpectedRecordModel) []client.ExpectedRecord {
+	if len(records) == 0 {
+		return nil
+	}
+	result := make([]client.ExpectedRecord, 0, len(records))
+	for _, m := range records {
+		result = append(result, client.ExpectedRecord{
+			Value:    m.Value.ValueString(),
+			Priority: intPointer(m.Priority),
+			Weight:   intPointer(m.Weight),
+			Port:     intPointer(m.Port),
+			Flags:    intPointer(m.Flags),
+			Tag:      m.Tag.ValueString(),
+		})
+	}
+	return result
+}
+
+func expectedRecordsFromClient(records []client.ExpectedRecord) []ExpectedRecordModel {
+	result := make([]ExpectedRecordModel, 0, len(records))
+	for _, c := range records {
+		m := ExpectedRecordModel{
+			Value:    types.StringValue(c.Value),
+			Priority: types.Int64PointerValue(int64Pointer(c.Priority)),
+			Weight:   types.Int64PointerValue(int64Pointer(c.Weight)),
+			Port:     types.Int64PointerValue(int64Pointer(c.Port)),
+			Flags:    types.Int64PointerValue(int64Pointer(c.Flags)),
+			Tag:      types.StringNull(),
+		}
+		if c.Tag != "" {
+			m.Tag = types.StringValue(c.Tag)
+		}
+		result = append(result, m)
+	}
+	return result
+}
+
+// intPointer returns a pointer to the value of v, or nil when v is null.
+func intPointer(v types.Int64) *int {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	i := int(v.ValueInt64())
+	return &i
+}
+
+// int64Pointer widens an optional int.
+func int64Pointer(v *int) *int64 {
+	if v == nil {
+		return nil
+	}
+	i := int64(*v)
+	return &i
+}
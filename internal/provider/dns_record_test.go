@@ -0,0 +1,64 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testExpectedRecord(value string) ExpectedRecordModel {
+	return ExpectedRecordModel{
+		Value:    types.StringValue(value),
+		Priority: types.Int64Null(),
+		Weight:   types.Int64Null(),
+		Port:     types.Int64Null(),
+		Flags:    types.Int64Null(),
+		Tag:      types.StringNull(),
+	}
+}
+
+func TestValidateExpectedRecords(t *testing.T) {
+	mx := testExpectedRecord("mx1.example.com")
+	mx.Priority = types.Int64Value(10)
+
+	srv := testExpectedRecord("sip.example.com")
+	srv.Priority = types.Int64Value(10)
+	srv.Weight = types.Int64Value(60)
+	srv.Port = types.Int64Value(5060)
+
+	caa := testExpectedRecord("letsencrypt.org")
+	caa.Flags = types.Int64Value(0)
+	caa.Tag = types.StringValue("issue")
+
+	testCases := map[string]struct {
+		recordType types.String
+		records    []ExpectedRecordModel
+		errors     int
+	}{
+		"a":                   {recordType: types.StringValue("A"), records: []ExpectedRecordModel{testExpectedRecord("192.0.2.1")}},
+		"mx":                  {recordType: types.StringValue("MX"), records: []ExpectedRecordModel{mx}},
+		"lowercase mx":        {recordType: types.StringValue("mx"), records: []ExpectedRecordModel{mx}},
+		"srv":                 {recordType: types.StringValue("SRV"), records: []ExpectedRecordModel{srv}},
+		"caa":                 {recordType: types.StringValue("CAA"), records: []ExpectedRecordModel{caa}},
+		"mx without priority": {recordType: types.StringValue("MX"), records: []ExpectedRecordModel{testExpectedRecord("mx1.example.com")}, errors: 1},
+		"srv without fields":  {recordType: types.StringValue("SRV"), records: []ExpectedRecordModel{testExpectedRecord("sip.example.com")}, errors: 3},
+		"srv as mx":           {recordType: types.StringValue("MX"), records: []ExpectedRecordModel{srv}, errors: 2},
+		"caa as txt":          {recordType: types.StringValue("TXT"), records: []ExpectedRecordModel{caa}, errors: 2},
+		"unknown record type": {recordType: types.StringUnknown(), records: []ExpectedRecordModel{caa}},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			validateExpectedRecords(tc.recordType, tc.records, &diags)
+
+			if got := diags.ErrorsCount(); got != tc.errors {
+				t.Errorf("expected %d errors, got %d: %v", tc.errors, got, diags)
+			}
+		})
+	}
+}
@@ -0,0 +1,215 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ackack-io/terraform-provider-ackack/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &MonitorCheckEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &MonitorCheckEphemeralResource{}
+
+func NewMonitorCheckEphemeralResource() ephemeral.EphemeralResource {
+	return &MonitorCheckEphemeralResource{}
+}
+
+// MonitorCheckEphemeralResource defines the ephemeral resource implementation.
+type MonitorCheckEphemeralResource struct {
+	client *client.Client
+}
+
+// MonitorCheckEphemeralResourceModel describes the ephemeral resource data model.
+type MonitorCheckEphemeralResourceModel struct {
+	MonitorID types.String              `tfsdk:"monitor_id"`
+	Region    types.String              `tfsdk:"region"`
+	RequireUp types.Bool                `tfsdk:"require_up"`
+	IsUp      types.Bool                `tfsdk:"is_up"`
+	Results   []MonitorCheckResultModel `tfsdk:"results"`
+}
+
+// MonitorCheckResultModel describes the result of the check in one region.
+type MonitorCheckResultModel struct {
+	Region       types.String `tfsdk:"region"`
+	Status       types.String `tfsdk:"status"`
+	ResponseTime types.Int64  `tfsdk:"response_time"`
+	StatusCode   types.Int64  `tfsdk:"status_code"`
+	ErrorType    types.String `tfsdk:"error_type"`
+	Message      types.String `tfsdk:"message"`
+	Timestamp    types.String `tfsdk:"timestamp"`
+}
+
+func (e *MonitorCheckEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_monitor_check"
+}
+
+func (e *MonitorCheckEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this ephemeral resource to check a monitor immediately instead of waiting for its next scheduled check, " +
+			"for example to gate a deployment pipeline on a fresh probe. A new check runs every time Terraform opens the resource, " +
+			"and its results are never stored in Terraform state or plan files. Requires Terraform 1.10 or later.",
+
+		Attributes: map[string]schema.Attribute{
+			"monitor_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the monitor to check.",
+				Required:            true,
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "The region to check from. When omitted, the monitor is checked from every region it runs in.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"require_up": schema.BoolAttribute{
+				MarkdownDescription: "Whether a failing check is an error. When true, the run fails with the failing regions and their errors. Default is false.",
+				Optional:            true,
+			},
+			"is_up": schema.BoolAttribute{
+				MarkdownDescription: "Whether the check passed in every region checked.",
+				Computed:            true,
+			},
+			"results": schema.ListNestedAttribute{
+				MarkdownDescription: "The result of the check in each region.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"region": schema.StringAttribute{
+							MarkdownDescription: "The region the check ran in.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The check status.",
+							Computed:            true,
+						},
+						"response_time": schema.Int64Attribute{
+							MarkdownDescription: "The response time in milliseconds.",
+							Computed:            true,
+						},
+						"status_code": schema.Int64Attribute{
+							MarkdownDescription: "The HTTP status code returned, for HTTP monitors.",
+							Computed:            true,
+						},
+						"error_type": schema.StringAttribute{
+							MarkdownDescription: "The type of error, if the check failed.",
+							Computed:            true,
+						},
+						"message": schema.StringAttribute{
+							MarkdownDescription: "The error message, if the check failed.",
+							Computed:            true,
+						},
+						"timestamp": schema.StringAttribute{
+							MarkdownDescription: "The timestamp of the check.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (e *MonitorCheckEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	requireFeature(c, "on_demand_checks", "On-demand checks", &resp.Diagnostics)
+
+	e.client = c
+}
+
+func (e *MonitorCheckEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
+	var data MonitorCheckEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	results, err := e.client.RunMonitorCheck(ctx, data.MonitorID.ValueString(), data.Region.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to check monitor, got error: %s", err))
+		return
+	}
+
+	data.Results = make([]MonitorCheckResultModel, 0, len(results))
+	for _, result := range results {
+		item := MonitorCheckResultModel{
+			Region:       types.StringValue(result.Region),
+			Status:       types.StringValue(result.Status),
+			ResponseTime: types.Int64Value(int64(result.ResponseTime)),
+			StatusCode:   types.Int64Null(),
+			ErrorType:    types.StringNull(),
+			Message:      types.StringNull(),
+			Timestamp:    types.StringValue(result.Timestamp),
+		}
+		if result.StatusCode != 0 {
+			item.StatusCode = types.Int64Value(int64(result.StatusCode))
+		}
+		if result.ErrorType != "" {
+			item.ErrorType = types.StringValue(result.ErrorType)
+		}
+		if result.Message != "" {
+			item.Message = types.StringValue(result.Message)
+		}
+		data.Results = append(data.Results, item)
+	}
+	failures := checkFailures(results)
+	data.IsUp = types.BoolValue(len(failures) == 0)
+
+	if data.RequireUp.ValueBool() && len(failures) > 0 {
+		addCheckFailedError(&resp.Diagnostics, data.MonitorID.ValueString(), failures)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// checkFailures describes the failing results of an on-demand check, one per
+// line. A check fails when it reports an error, like the results of scheduled
+// checks, or when no region was checked.
+func checkFailures(results []client.MonitorResult) []string {
+	if len(results) == 0 {
+		return []string{"no regions were checked"}
+	}
+	var failures []string
+	for _, result := range results {
+		if result.ErrorType != "" {
+			failures = append(failures, fmt.Sprintf("%s: %s %s", result.Region, result.ErrorType, result.Message))
+		}
+	}
+	return failures
+}
+
+// addCheckFailedError adds an error listing the failures of a check of
+// monitorID to diags.
+func addCheckFailedError(diags *diag.Diagnostics, monitorID string, failures []string) {
+	diags.AddError(
+		"Monitor Check Failed",
+		fmt.Sprintf("The check of monitor %s failed:\n\n%s", monitorID, strings.Join(failures, "\n")),
+	)
+}
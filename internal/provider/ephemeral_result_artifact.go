@@ -0,0 +1,134 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ackack-io/terraform-provider-ackack/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &ResultArtifactEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &ResultArtifactEphemeralResource{}
+
+func NewResultArtifactEphemeralResource() ephemeral.EphemeralResource {
+	return &ResultArtifactEphemeralResource{}
+}
+
+// ResultArtifactEphemeralResource defines the ephemeral resource implementation.
+type ResultArtifactEphemeralResource struct {
+	client *client.Client
+}
+
+// ResultArtifactEphemeralResourceModel describes the ephemeral resource data model.
+type ResultArtifactEphemeralResourceModel struct {
+	MonitorID  types.String `tfsdk:"monitor_id"`
+	ResultID   types.Int64  `tfsdk:"result_id"`
+	Kind       types.String `tfsdk:"kind"`
+	TTLSeconds types.Int64  `tfsdk:"ttl_seconds"`
+	URL        types.String `tfsdk:"url"`
+	ExpiresAt  types.String `tfsdk:"expires_at"`
+}
+
+func (e *ResultArtifactEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_result_artifact"
+}
+
+func (e *ResultArtifactEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this ephemeral resource to get a short-lived signed URL for a check result's failure artifact, " +
+			"such as a screenshot or HAR file. The URL is never stored in Terraform state or plan files. Requires Terraform 1.10 or later.",
+
+		Attributes: map[string]schema.Attribute{
+			"monitor_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the monitor.",
+				Required:            true,
+			},
+			"result_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the check result, e.g. from the `ackack_monitor_results` data source.",
+				Required:            true,
+			},
+			"kind": schema.StringAttribute{
+				MarkdownDescription: "The artifact to sign. Valid values: `screenshot`, `har`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("screenshot", "har"),
+				},
+			},
+			"ttl_seconds": schema.Int64Attribute{
+				MarkdownDescription: "How long the URL stays valid, in seconds. When omitted, the API default is used.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(60, 86400),
+				},
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The signed URL of the artifact.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the URL expires.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (e *ResultArtifactEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	requireFeature(c, "artifacts", "Failure artifacts", &resp.Diagnostics)
+
+	e.client = c
+}
+
+func (e *ResultArtifactEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
+	var data ResultArtifactEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	artifact, err := e.client.GetResultArtifactURL(ctx,
+		data.MonitorID.ValueString(),
+		int(data.ResultID.ValueInt64()),
+		data.Kind.ValueString(),
+		int(data.TTLSeconds.ValueInt64()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get result artifact URL, got error: %s", err))
+		return
+	}
+
+	data.URL = types.StringValue(artifact.URL)
+	if artifact.ExpiresAt != "" {
+		data.ExpiresAt = types.StringValue(artifact.ExpiresAt)
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
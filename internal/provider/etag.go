@@ -0,0 +1,70 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// etagKey is the private state key holding the ETag a resource was last read
+// with. Updates send it back as If-Match, so they fail instead of silently
+// overwriting changes made since the last refresh.
+const etagKey = "etag"
+
+// privateState is implemented by the private state of resource requests and
+// responses.
+type privateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+// privateStateSetter is implemented by the private state of resource
+// responses.
+type privateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// privateETag returns the stored ETag, or an empty string when there is none,
+// e.g. for state written before ETags were tracked.
+func privateETag(ctx context.Context, private privateState) (string, diag.Diagnostics) {
+	data, diags := private.GetKey(ctx, etagKey)
+	if diags.HasError() || len(data) == 0 {
+		return "", diags
+	}
+
+	var etag string
+	if err := json.Unmarshal(data, &etag); err != nil {
+		return "", diags
+	}
+	return etag, diags
+}
+
+// setPrivateETag stores the ETag of the latest API response. Servers that
+// don't send ETags clear it, so updates are sent without a precondition.
+func setPrivateETag(ctx context.Context, private privateStateSetter, etag string) diag.Diagnostics {
+	if etag == "" {
+		return private.SetKey(ctx, etagKey, nil)
+	}
+	data, err := json.Marshal(etag)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Unable to Store ETag", err.Error())
+		return diags
+	}
+	return private.SetKey(ctx, etagKey, data)
+}
+
+// addChangedOutsideTerraformError reports an update the API rejected because
+// the resource changed after it was last read.
+func addChangedOutsideTerraformError(diags *diag.Diagnostics, resourceName, id string) {
+	diags.AddError(
+		"Resource Changed Outside Terraform",
+		fmt.Sprintf("The %s %s was changed outside Terraform, e.g. in the dashboard or by a concurrent apply, after it was last read, "+
+			"so the update was not applied to avoid overwriting that change. Run terraform apply again to review the plan against the current %s.",
+			resourceName, id, resourceName),
+	)
+}
@@ -0,0 +1,294 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &MonitorManifestFunction{}
+
+func NewMonitorManifestFunction() function.Function {
+	return &MonitorManifestFunction{}
+}
+
+// MonitorManifestFunction renders the effective configuration of a monitor
+// as canonical JSON for policy-as-code tooling.
+type MonitorManifestFunction struct{}
+
+func (f *MonitorManifestFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "monitor_manifest"
+}
+
+func (f *MonitorManifestFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Render a monitor's effective configuration as canonical JSON",
+		MarkdownDescription: "Given an `ackack_monitor` resource (or an object with the same attributes), returns its effective configuration " +
+			"as JSON with object keys sorted. Provider defaults are filled in for unset attributes, and computed-only attributes such as " +
+			"`id`, `status` and timestamps are left out, so the document is stable across plans and suitable for OPA or Sentinel policies. " +
+			"Values that are not known until apply are omitted.",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name:                "monitor",
+				MarkdownDescription: "The `ackack_monitor` resource or an object with the same attributes.",
+				AllowUnknownValues:  true,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *MonitorManifestFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var monitor types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &monitor))
+	if resp.Error != nil {
+		return
+	}
+
+	if monitor.IsUnknown() || monitor.IsUnderlyingValueUnknown() {
+		resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, types.StringUnknown()))
+		return
+	}
+
+	object, ok := monitor.UnderlyingValue().(types.Object)
+	if !ok || object.IsNull() {
+		resp.Error = function.NewArgumentFuncError(0, "The monitor argument must be an ackack_monitor resource or an object with the same attributes.")
+		return
+	}
+
+	var schemaResp resource.SchemaResponse
+	(&MonitorResource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	manifest, err := manifestObject(ctx, path.Empty(), schemaResp.Schema.Attributes, object.Attributes())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	if err := manifestBlocks(ctx, manifest, schemaResp.Schema.Blocks, object.Attributes()); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	// encoding/json sorts map keys, which keeps the output canonical.
+	out, err := json.Marshal(manifest)
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("Unable to encode monitor manifest: %s", err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, types.StringValue(string(out))))
+}
+
+// manifestObject converts the configurable attributes of an object to plain
+// Go values, filling in schema defaults for null attributes.
+func manifestObject(ctx context.Context, p path.Path, attributes map[string]schema.Attribute, values map[string]attr.Value) (map[string]any, error) {
+	manifest := make(map[string]any)
+
+	for name, attribute := range attributes {
+		if attribute.IsComputed() && !attribute.IsOptional() && !attribute.IsRequired() {
+			continue
+		}
+
+		attrPath := p.AtName(name)
+		value, ok := values[name]
+		if !ok || value.IsNull() {
+			var err error
+			value, err = attributeDefault(ctx, attrPath, attribute)
+			if err != nil {
+				return nil, err
+			}
+			if value == nil {
+				continue
+			}
+		}
+		if value.IsUnknown() {
+			continue
+		}
+
+		if nested, ok := attribute.(schema.SingleNestedAttribute); ok {
+			object, ok := value.(types.Object)
+			if !ok {
+				return nil, fmt.Errorf("attribute %s must be an object", attrPath)
+			}
+			v, err := manifestObject(ctx, attrPath, nested.Attributes, object.Attributes())
+			if err != nil {
+				return nil, err
+			}
+			manifest[name] = v
+			continue
+		}
+
+		tfValue, err := value.ToTerraformValue(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert attribute %s: %w", attrPath, err)
+		}
+		v, err := manifestValue(tfValue)
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert attribute %s: %w", attrPath, err)
+		}
+		if v != nil {
+			manifest[name] = v
+		}
+	}
+
+	return manifest, nil
+}
+
+// manifestBlocks adds the list blocks of an object to the manifest. Each
+// block is converted like an object, so defaults are filled in per block.
+func manifestBlocks(ctx context.Context, manifest map[string]any, blocks map[string]schema.Block, values map[string]attr.Value) error {
+	for name, block := range blocks {
+		nested, ok := block.(schema.ListNestedBlock)
+		if !ok {
+			continue
+		}
+
+		value, ok := values[name]
+		if !ok || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		// Object literals pass blocks as tuples rather than lists.
+		var elements []attr.Value
+		switch v := value.(type) {
+		case types.List:
+			elements = v.Elements()
+		case types.Tuple:
+			elements = v.Elements()
+		default:
+			return fmt.Errorf("block %s must be a list", name)
+		}
+
+		items := make([]any, 0, len(elements))
+		for i, element := range elements {
+			object, ok := element.(types.Object)
+			if !ok {
+				return fmt.Errorf("block %s must be a list of objects", name)
+			}
+			if object.IsUnknown() {
+				continue
+			}
+			v, err := manifestObject(ctx, path.Root(name).AtListIndex(i), nested.NestedObject.Attributes, object.Attributes())
+			if err != nil {
+				return err
+			}
+			items = append(items, v)
+		}
+		if len(items) > 0 {
+			manifest[name] = items
+		}
+	}
+
+	return nil
+}
+
+// attributeDefault returns the schema default of an attribute, or nil when
+// it has none.
+func attributeDefault(ctx context.Context, p path.Path, attribute schema.Attribute) (attr.Value, error) {
+	switch a := attribute.(type) {
+	case schema.BoolAttribute:
+		if a.Default != nil {
+			var resp defaults.BoolResponse
+			a.Default.DefaultBool(ctx, defaults.BoolRequest{Path: p}, &resp)
+			return resp.PlanValue, nil
+		}
+	case schema.Int64Attribute:
+		if a.Default != nil {
+			var resp defaults.Int64Response
+			a.Default.DefaultInt64(ctx, defaults.Int64Request{Path: p}, &resp)
+			return resp.PlanValue, nil
+		}
+	case schema.Float64Attribute:
+		if a.Default != nil {
+			var resp defaults.Float64Response
+			a.Default.DefaultFloat64(ctx, defaults.Float64Request{Path: p}, &resp)
+			return resp.PlanValue, nil
+		}
+	case schema.StringAttribute:
+		if a.Default != nil {
+			var resp defaults.StringResponse
+			a.Default.DefaultString(ctx, defaults.StringRequest{Path: p}, &resp)
+			return resp.PlanValue, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// manifestValue converts a Terraform value to a value encoding/json can
+// marshal. Null and unknown values, including collection elements, are
+// returned as nil.
+func manifestValue(v tftypes.Value) (any, error) {
+	if v.IsNull() || !v.IsKnown() {
+		return nil, nil
+	}
+
+	typ := v.Type()
+	switch {
+	case typ.Is(tftypes.String):
+		var s string
+		err := v.As(&s)
+		return s, err
+	case typ.Is(tftypes.Bool):
+		var b bool
+		err := v.As(&b)
+		return b, err
+	case typ.Is(tftypes.Number):
+		var n big.Float
+		if err := v.As(&n); err != nil {
+			return nil, err
+		}
+		if n.IsInt() {
+			i, _ := n.Int64()
+			return i, nil
+		}
+		f, _ := n.Float64()
+		return f, nil
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		var elems []tftypes.Value
+		if err := v.As(&elems); err != nil {
+			return nil, err
+		}
+		out := make([]any, 0, len(elems))
+		for _, elem := range elems {
+			e, err := manifestValue(elem)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, e)
+		}
+		return out, nil
+	case typ.Is(tftypes.Map{}), typ.Is(tftypes.Object{}):
+		var elems map[string]tftypes.Value
+		if err := v.As(&elems); err != nil {
+			return nil, err
+		}
+		out := make(map[string]any, len(elems))
+		for k, elem := range elems {
+			e, err := manifestValue(elem)
+			if err != nil {
+				return nil, err
+			}
+			if e != nil {
+				out[k] = e
+			}
+		}
+		return out, nil
+	}
+
+	return nil, fmt.Errorf("unsupported value type %s", typ)
+}
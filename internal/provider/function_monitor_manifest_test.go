@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMonitorManifestFunction_Run(t *testing.T) {
+	ctx := context.Background()
+
+	monitor := types.ObjectValueMust(
+		map[string]attr.Type{
+			"id":                types.StringType,
+			"name":              types.StringType,
+			"type":              types.StringType,
+			"url":               types.StringType,
+			"frequency_seconds": types.Int64Type,
+			"retries":           types.Int64Type,
+		},
+		map[string]attr.Value{
+			"id":                types.StringUnknown(),
+			"name":              types.StringValue("Website"),
+			"type":              types.StringValue("http"),
+			"url":               types.StringValue("https://example.com"),
+			"frequency_seconds": types.Int64Value(30),
+			"retries":           types.Int64Unknown(),
+		},
+	)
+
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.DynamicValue(monitor)}),
+	}
+	resp := function.RunResponse{
+		Result: function.NewResultData(types.StringUnknown()),
+	}
+
+	(&MonitorManifestFunction{}).Run(ctx, req, &resp)
+
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+
+	expected := types.StringValue(`{"frequency_seconds":30,"is_enabled":true,"name":"Website","timeout_ms":10000,"type":"http","url":"https://example.com"}`)
+	if !resp.Result.Value().Equal(expected) {
+		t.Errorf("expected %s, got %s", expected, resp.Result.Value())
+	}
+}
+
+func TestMonitorManifestFunction_RunInvalidArgument(t *testing.T) {
+	ctx := context.Background()
+
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.DynamicValue(types.StringValue("monitor"))}),
+	}
+	resp := function.RunResponse{
+		Result: function.NewResultData(types.StringUnknown()),
+	}
+
+	(&MonitorManifestFunction{}).Run(ctx, req, &resp)
+
+	if resp.Error == nil {
+		t.Fatal("expected an error for a non-object argument")
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/net/http/httpguts"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &NormalizeHeadersFunction{}
+
+func NewNormalizeHeadersFunction() function.Function {
+	return &NormalizeHeadersFunction{}
+}
+
+// NormalizeHeadersFunction renders a map of HTTP headers as the canonical
+// JSON string monitors store.
+type NormalizeHeadersFunction struct{}
+
+func (f *NormalizeHeadersFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize_headers"
+}
+
+func (f *NormalizeHeadersFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Render HTTP headers as the canonical JSON string the API expects",
+		MarkdownDescription: "Returns a map of HTTP headers as the JSON string the `headers` attribute of `ackack_monitor` expects, in the canonical form the API stores. " +
+			"Header names are canonicalized (e.g. `content-type` becomes `Content-Type`) and sorted, and values are trimmed. " +
+			"Names that differ only in case are rejected, as are invalid names and values containing line breaks. " +
+			"Use it instead of `jsonencode` when composing headers from variables, so equivalent maps never show a diff.",
+		Parameters: []function.Parameter{
+			function.MapParameter{
+				Name:                "headers",
+				MarkdownDescription: "The headers to normalize, keyed by header name.",
+				ElementType:         types.StringType,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *NormalizeHeadersFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var headers map[string]string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &headers))
+	if resp.Error != nil {
+		return
+	}
+
+	normalized, err := normalizeHeaders(headers)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, normalized))
+}
+
+// normalizeHeaders returns headers as compact JSON with canonical, sorted
+// names and trimmed values.
+func normalizeHeaders(headers map[string]string) (string, error) {
+	canonical := make(map[string]string, len(headers))
+	original := make(map[string]string, len(headers))
+	for name, value := range headers {
+		trimmed := strings.TrimSpace(name)
+		if !httpguts.ValidHeaderFieldName(trimmed) {
+			return "", fmt.Errorf("%q is not a valid HTTP header name", name)
+		}
+		value = strings.TrimSpace(value)
+		if !httpguts.ValidHeaderFieldValue(value) {
+			return "", fmt.Errorf("the value of header %q contains invalid characters, such as line breaks", name)
+		}
+
+		key := http.CanonicalHeaderKey(trimmed)
+		if other, ok := original[key]; ok {
+			return "", fmt.Errorf("headers %q and %q are the same header; HTTP header names are case-insensitive", other, name)
+		}
+		original[key] = name
+		canonical[key] = value
+	}
+
+	// encoding/json sorts map keys; HTML escaping would only make values
+	// harder to read in plans.
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(canonical); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
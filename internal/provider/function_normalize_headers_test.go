@@ -0,0 +1,52 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+)
+
+func TestNormalizeHeaders(t *testing.T) {
+	tests := []struct {
+		name     string
+		headers  map[string]string
+		expected string
+	}{
+		{"empty", map[string]string{}, `{}`},
+		{"canonical names", map[string]string{"x-api-version": "2", "content-type": "application/json"}, `{"Content-Type":"application/json","X-Api-Version":"2"}`},
+		{"trimmed", map[string]string{" Accept ": " text/html "}, `{"Accept":"text/html"}`},
+		{"unescaped", map[string]string{"X-Query": "a=1&b=<2>"}, `{"X-Query":"a=1&b=<2>"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := normalizeHeaders(tt.headers)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestNormalizeHeaders_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		headers map[string]string
+	}{
+		{"duplicate", map[string]string{"accept": "a", "Accept": "b"}},
+		{"invalid name", map[string]string{"X Api": "1"}},
+		{"line break", map[string]string{"X-Api": "1\r\nHost: evil.example"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := normalizeHeaders(tt.headers); err == nil {
+				t.Errorf("expected an error for %v", tt.headers)
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/ackack-io/terraform-provider-ackack/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &NormalizeTargetFunction{}
+
+func NewNormalizeTargetFunction() function.Function {
+	return &NormalizeTargetFunction{}
+}
+
+// NormalizeTargetFunction canonicalizes alert targets the way the API does.
+type NormalizeTargetFunction struct{}
+
+func (f *NormalizeTargetFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize_target"
+}
+
+func (f *NormalizeTargetFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Canonicalize an alert target the way the API does",
+		MarkdownDescription: "Returns the canonical form of an `ackack_alert` target for the given alert type, as stored by the API. " +
+			"Email addresses are lowercased; webhook, Discord and Slack URLs get a lowercase scheme and host, punycode hostnames and " +
+			"no default port; phone numbers for `sms` and `voice` lose spaces, dashes, dots and parentheses. Other targets are trimmed. " +
+			"Use it for `for_each` keys and comparisons that must not change when a target is written differently.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "type",
+				MarkdownDescription: "The alert type, e.g. `email` or `webhook`.",
+			},
+			function.StringParameter{
+				Name:                "target",
+				MarkdownDescription: "The alert target to normalize.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *NormalizeTargetFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var alertType, target string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &alertType, &target))
+	if resp.Error != nil {
+		return
+	}
+
+	if !client.AlertType(alertType).Valid() {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Unsupported alert type %q, expected one of: %s.", alertType, strings.Join(client.Strings(client.AlertTypes), ", ")))
+		return
+	}
+
+	normalized, err := normalizeAlertTarget(alertType, target)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, normalized))
+}
+
+// normalizeAlertTarget returns the canonical form of target for alertType.
+func normalizeAlertTarget(alertType, target string) (string, error) {
+	target = strings.TrimSpace(target)
+
+	switch client.AlertType(alertType) {
+	case client.AlertTypeEmail:
+		return strings.ToLower(target), nil
+	case client.AlertTypeWebhook, client.AlertTypeDiscord, client.AlertTypeSlack:
+		return normalizeWebhookURL(target)
+	case client.AlertTypeSMS, client.AlertTypeVoice:
+		phone := strings.NewReplacer(" ", "", "-", "", ".", "", "(", "", ")", "").Replace(target)
+		if !e164Regexp.MatchString(phone) {
+			return "", fmt.Errorf("%q is not a phone number in E.164 format, e.g. +14155550123", target)
+		}
+		return phone, nil
+	default:
+		return target, nil
+	}
+}
+
+// normalizeWebhookURL lowercases the scheme and host of a URL, converts the
+// host to punycode and drops the port when it is the scheme's default.
+func normalizeWebhookURL(target string) (string, error) {
+	u, err := url.Parse(target)
+	if err != nil || u.Host == "" {
+		return "", fmt.Errorf("%q is not an absolute URL", target)
+	}
+
+	u.Scheme = strings.ToLower(u.Scheme)
+
+	host, err := toASCIIHostname(u.Hostname())
+	if err != nil {
+		return "", fmt.Errorf("%q has an invalid host: %s", target, err)
+	}
+	if strings.Contains(host, ":") {
+		host = "[" + host + "]"
+	}
+
+	port := u.Port()
+	if (u.Scheme == "https" && port == "443") || (u.Scheme == "http" && port == "80") {
+		port = ""
+	}
+	if port != "" {
+		host += ":" + port
+	}
+	u.Host = host
+
+	return u.String(), nil
+}
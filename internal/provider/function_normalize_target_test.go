@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+)
+
+func TestNormalizeAlertTarget(t *testing.T) {
+	tests := []struct {
+		alertType string
+		target    string
+		expected  string
+	}{
+		{"email", " Ops@Example.COM ", "ops@example.com"},
+		{"webhook", "HTTPS://Hooks.Example.com:443/alert?token=AbC", "https://hooks.example.com/alert?token=AbC"},
+		{"webhook", "http://example.com:8080/hook", "http://example.com:8080/hook"},
+		{"slack", "https://Bücher.example/hook", "https://xn--bcher-kva.example/hook"},
+		{"webhook", "https://[::1]:443/hook", "https://[::1]/hook"},
+		{"sms", "+1 (415) 555-0123", "+14155550123"},
+		{"voice", "+44.20.7946.0958", "+442079460958"},
+		{"pagerduty", " R0UT1NGKEY ", "R0UT1NGKEY"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.alertType+"/"+tt.target, func(t *testing.T) {
+			got, err := normalizeAlertTarget(tt.alertType, tt.target)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestNormalizeAlertTarget_Invalid(t *testing.T) {
+	tests := []struct {
+		alertType string
+		target    string
+	}{
+		{"webhook", "not a url"},
+		{"sms", "555-0123"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.alertType+"/"+tt.target, func(t *testing.T) {
+			if _, err := normalizeAlertTarget(tt.alertType, tt.target); err == nil {
+				t.Errorf("expected an error for %q", tt.target)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &SLAToDowntimeFunction{}
+
+func NewSLAToDowntimeFunction() function.Function {
+	return &SLAToDowntimeFunction{}
+}
+
+// SLAToDowntimeFunction converts an uptime target into the downtime it
+// allows over a window.
+type SLAToDowntimeFunction struct{}
+
+func (f *SLAToDowntimeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "sla_to_downtime"
+}
+
+func (f *SLAToDowntimeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Convert an uptime target into allowed downtime minutes",
+		MarkdownDescription: "Returns the downtime, in minutes, that an uptime target allows over a window of days, " +
+			"e.g. 21.6 minutes for `99.95` over 30 days. The arguments match `target_percentage` and `window_days` of `ackack_slo`. " +
+			"The target may be given as a string, such as `\"99.95\"`.",
+		Parameters: []function.Parameter{
+			function.Float64Parameter{
+				Name:                "target_percentage",
+				MarkdownDescription: "The uptime target as a percentage between 0 and 100, e.g. `99.9`.",
+			},
+			function.Int64Parameter{
+				Name:                "window_days",
+				MarkdownDescription: "The length of the window in days, e.g. `30`.",
+			},
+		},
+		Return: function.Float64Return{},
+	}
+}
+
+func (f *SLAToDowntimeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var target float64
+	var windowDays int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &target, &windowDays))
+	if resp.Error != nil {
+		return
+	}
+
+	if target < 0 || target > 100 {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("The target percentage must be between 0 and 100, got: %g.", target))
+		return
+	}
+	if windowDays < 1 {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("The window must be at least 1 day, got: %d.", windowDays))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, allowedDowntimeMinutes(target, windowDays)))
+}
+
+// allowedDowntimeMinutes returns the minutes of downtime target allows over
+// windowDays, rounded to hide floating point noise such as 21.599999999.
+func allowedDowntimeMinutes(target float64, windowDays int64) float64 {
+	minutes := (100 - target) / 100 * float64(windowDays) * 24 * 60
+	return math.Round(minutes*1e6) / 1e6
+}
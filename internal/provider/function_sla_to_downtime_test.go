@@ -0,0 +1,32 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestAllowedDowntimeMinutes(t *testing.T) {
+	tests := []struct {
+		target     float64
+		windowDays int64
+		expected   float64
+	}{
+		{99.9, 30, 43.2},
+		{99.95, 30, 21.6},
+		{99.99, 7, 1.008},
+		{99, 90, 1296},
+		{100, 30, 0},
+		{0, 1, 1440},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%g/%d", tt.target, tt.windowDays), func(t *testing.T) {
+			if got := allowedDowntimeMinutes(tt.target, tt.windowDays); got != tt.expected {
+				t.Errorf("expected %g, got %g", tt.expected, got)
+			}
+		})
+	}
+}
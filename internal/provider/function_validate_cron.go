@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &ValidateCronFunction{}
+
+func NewValidateCronFunction() function.Function {
+	return &ValidateCronFunction{}
+}
+
+// ValidateCronFunction checks a cron expression and returns it normalized.
+type ValidateCronFunction struct{}
+
+func (f *ValidateCronFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "validate_cron"
+}
+
+func (f *ValidateCronFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Validate a cron expression and return it normalized",
+		MarkdownDescription: "Returns a standard five-field cron expression (minute, hour, day of month, month, day of week) in canonical form, " +
+			"or fails with a message naming the invalid field. Shorthands such as `@daily` are expanded, month and weekday names " +
+			"are replaced by numbers, Sunday is written as `0`, steps of 1 are dropped and fields are separated by single spaces. " +
+			"Wrap it in `can()` to use it in a precondition or variable validation.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "expression",
+				MarkdownDescription: "The cron expression, e.g. `0 9 * * MON-FRI` or `@hourly`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ValidateCronFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var expression string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &expression))
+	if resp.Error != nil {
+		return
+	}
+
+	normalized, err := normalizeCron(expression)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "Invalid cron expression: "+err.Error()+".")
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, normalized))
+}
@@ -0,0 +1,194 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"golang.org/x/net/idna"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ basetypes.StringTypable                    = HostnameType{}
+	_ basetypes.StringValuableWithSemanticEquals = HostnameValue{}
+	_ xattr.ValidateableAttribute                = HostnameValue{}
+)
+
+// HostnameType is a string type for hostnames and domains. Internationalized
+// names are semantically equal to their punycode form, so configurations
+// written with Unicode domains do not diff against the ASCII form the API
+// returns.
+type HostnameType struct {
+	basetypes.StringType
+}
+
+func (t HostnameType) String() string {
+	return "HostnameType"
+}
+
+func (t HostnameType) ValueType(ctx context.Context) attr.Value {
+	return HostnameValue{}
+}
+
+func (t HostnameType) Equal(o attr.Type) bool {
+	other, ok := o.(HostnameType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t HostnameType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return HostnameValue{StringValue: in}, nil
+}
+
+func (t HostnameType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+// HostnameValue is a hostname or domain, possibly internationalized.
+type HostnameValue struct {
+	basetypes.StringValue
+}
+
+// NewHostnameValue creates a known hostname.
+func NewHostnameValue(value string) HostnameValue {
+	return HostnameValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+func (v HostnameValue) Type(ctx context.Context) attr.Type {
+	return HostnameType{}
+}
+
+func (v HostnameValue) Equal(o attr.Value) bool {
+	other, ok := o.(HostnameValue)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// ASCII returns the punycode form of the hostname, as sent to the API. Names
+// that cannot be converted are returned unchanged.
+func (v HostnameValue) ASCII() string {
+	ascii, err := toASCIIHostname(v.ValueString())
+	if err != nil {
+		return v.ValueString()
+	}
+
+	return ascii
+}
+
+func (v HostnameValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(HostnameValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	return v.ASCII() == newValue.ASCII(), diags
+}
+
+func (v HostnameValue) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if err := checkHostname(v.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Hostname",
+			fmt.Sprintf("A hostname or domain was expected, got %q: %s", v.ValueString(), err),
+		)
+	}
+}
+
+// hostnameProfile maps hostnames for lookup like idna.Lookup, but allows
+// underscores, which are common in internal host names.
+var hostnameProfile = idna.New(
+	idna.MapForLookup(),
+	idna.Transitional(false),
+	idna.StrictDomainName(false),
+)
+
+// toASCIIHostname converts an internationalized hostname to lowercase
+// punycode, e.g. "Bücher.example" to "xn--bcher-kva.example". IP addresses
+// are returned unchanged.
+func toASCIIHostname(hostname string) (string, error) {
+	if net.ParseIP(hostname) != nil {
+		return hostname, nil
+	}
+
+	ascii, err := hostnameProfile.ToASCII(strings.TrimSuffix(hostname, "."))
+	if err != nil {
+		return "", err
+	}
+
+	return strings.ToLower(ascii), nil
+}
+
+// hostnameLabelRegexp matches a label of a hostname in punycode form,
+// allowing underscores like hostnameProfile.
+var hostnameLabelRegexp = regexp.MustCompile(`^[a-z0-9_]([a-z0-9_-]{0,61}[a-z0-9_])?$`)
+
+// checkHostname returns an error unless hostname is an IP address or a
+// hostname, possibly internationalized.
+func checkHostname(hostname string) error {
+	if net.ParseIP(hostname) != nil {
+		return nil
+	}
+
+	ascii, err := toASCIIHostname(hostname)
+	if err != nil {
+		return err
+	}
+	if ascii == "" {
+		return fmt.Errorf("the hostname is empty")
+	}
+	if len(ascii) > 253 {
+		return fmt.Errorf("the hostname is longer than 253 characters")
+	}
+	for _, label := range strings.Split(ascii, ".") {
+		if !hostnameLabelRegexp.MatchString(label) {
+			return fmt.Errorf("invalid label %q", label)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+)
+
+func TestHostnameValue_StringSemanticEquals(t *testing.T) {
+	testCases := map[string]struct {
+		current  string
+		new      string
+		expected bool
+	}{
+		"identical": {
+			current:  "example.com",
+			new:      "example.com",
+			expected: true,
+		},
+		"unicode-and-punycode": {
+			current:  "bücher.example",
+			new:      "xn--bcher-kva.example",
+			expected: true,
+		},
+		"case-insensitive": {
+			current:  "Bücher.Example",
+			new:      "xn--bcher-kva.example",
+			expected: true,
+		},
+		"trailing-dot": {
+			current:  "example.com.",
+			new:      "example.com",
+			expected: true,
+		},
+		"ip-address": {
+			current:  "2001:db8::1",
+			new:      "2001:db8::1",
+			expected: true,
+		},
+		"different": {
+			current:  "bücher.example",
+			new:      "bucher.example",
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, diags := NewHostnameValue(testCase.current).StringSemanticEquals(context.Background(), NewHostnameValue(testCase.new))
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestHostnameValue_ASCII(t *testing.T) {
+	for hostname, expected := range map[string]string{
+		"bücher.example":   "xn--bcher-kva.example",
+		"EXAMPLE.com":      "example.com",
+		"my_host.internal": "my_host.internal",
+		"10.0.0.1":         "10.0.0.1",
+	} {
+		if got := NewHostnameValue(hostname).ASCII(); got != expected {
+			t.Errorf("%s: expected %s, got %s", hostname, expected, got)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// idIdentityModel describes the identity of a resource identified by its ID.
+//
+// Names and types can change in place, and Terraform rejects an identity that
+// changes over the life of a resource, so the ID is the only stable identity.
+type idIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+// idIdentitySchema returns the identity schema of a resource identified by
+// its ID, described by description.
+func idIdentitySchema(description string) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       description,
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+// setIDIdentity sets identity to id.
+func setIDIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, id types.String) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+	return identity.Set(ctx, idIdentityModel{ID: id})
+}
@@ -0,0 +1,259 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"math"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// jsonSchemaDrafts are the JSON Schema dialects the check runner supports,
+// keyed by $schema URI without a trailing "#".
+var jsonSchemaDrafts = []string{
+	"http://json-schema.org/draft-04/schema",
+	"http://json-schema.org/draft-06/schema",
+	"http://json-schema.org/draft-07/schema",
+	"https://json-schema.org/draft/2019-09/schema",
+	"https://json-schema.org/draft/2020-12/schema",
+}
+
+// jsonSchemaTypes are the accepted values of the "type" keyword.
+var jsonSchemaTypes = []string{"array", "boolean", "integer", "null", "number", "object", "string"}
+
+// Keywords whose values are a subschema, an object of subschemas, or an
+// array of subschemas.
+var (
+	jsonSchemaSubschemaKeywords = []string{
+		"additionalItems", "additionalProperties", "contains", "else", "if", "not",
+		"propertyNames", "then", "unevaluatedItems", "unevaluatedProperties",
+	}
+	jsonSchemaSubschemaMapKeywords   = []string{"$defs", "definitions", "dependentSchemas", "patternProperties", "properties"}
+	jsonSchemaSubschemaArrayKeywords = []string{"allOf", "anyOf", "oneOf", "prefixItems"}
+	jsonSchemaCountKeywords          = []string{
+		"maxContains", "maxItems", "maxLength", "maxProperties",
+		"minContains", "minItems", "minLength", "minProperties",
+	}
+)
+
+// validateJSONSchema checks that doc is a well-formed JSON Schema document.
+// It checks the structure of the standard keywords, not that the schema can
+// be satisfied, and ignores unknown keywords like the check runner does.
+func validateJSONSchema(doc string) error {
+	decoder := json.NewDecoder(strings.NewReader(doc))
+	decoder.UseNumber()
+
+	var schema any
+	if err := decoder.Decode(&schema); err != nil {
+		return fmt.Errorf("invalid JSON: %w", err)
+	}
+	if decoder.More() {
+		return fmt.Errorf("invalid JSON: unexpected data after the schema")
+	}
+
+	if object, ok := schema.(map[string]any); ok {
+		if dialect, ok := object["$schema"]; ok {
+			uri, ok := dialect.(string)
+			if !ok {
+				return fmt.Errorf("at /$schema: must be a string")
+			}
+			if !slices.Contains(jsonSchemaDrafts, strings.TrimSuffix(uri, "#")) {
+				return fmt.Errorf("at /$schema: unsupported dialect %q, must be draft-04, draft-06, draft-07, 2019-09 or 2020-12", uri)
+			}
+		}
+	}
+
+	return checkJSONSchema(schema, "")
+}
+
+// checkJSONSchema checks the subschema at the JSON pointer location.
+func checkJSONSchema(schema any, location string) error {
+	if _, ok := schema.(bool); ok {
+		return nil
+	}
+	object, ok := schema.(map[string]any)
+	if !ok {
+		return jsonSchemaError(location, "a schema must be an object or a boolean")
+	}
+
+	if t, ok := object["type"]; ok {
+		if err := checkJSONSchemaType(t, location+"/type"); err != nil {
+			return err
+		}
+	}
+
+	for _, keyword := range jsonSchemaSubschemaKeywords {
+		if sub, ok := object[keyword]; ok {
+			if err := checkJSONSchema(sub, location+"/"+keyword); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, keyword := range jsonSchemaSubschemaMapKeywords {
+		value, ok := object[keyword]
+		if !ok {
+			continue
+		}
+		subschemas, ok := value.(map[string]any)
+		if !ok {
+			return jsonSchemaError(location+"/"+keyword, "must be an object of schemas")
+		}
+		for _, name := range slices.Sorted(maps.Keys(subschemas)) {
+			if err := checkJSONSchema(subschemas[name], location+"/"+keyword+"/"+escapeJSONPointer(name)); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, keyword := range jsonSchemaSubschemaArrayKeywords {
+		value, ok := object[keyword]
+		if !ok {
+			continue
+		}
+		subschemas, ok := value.([]any)
+		if !ok || len(subschemas) == 0 {
+			return jsonSchemaError(location+"/"+keyword, "must be a non-empty array of schemas")
+		}
+		for i, sub := range subschemas {
+			if err := checkJSONSchema(sub, fmt.Sprintf("%s/%s/%d", location, keyword, i)); err != nil {
+				return err
+			}
+		}
+	}
+
+	// items is a schema, or an array of schemas before draft 2020-12.
+	if items, ok := object["items"]; ok {
+		if list, ok := items.([]any); ok {
+			for i, sub := range list {
+				if err := checkJSONSchema(sub, fmt.Sprintf("%s/items/%d", location, i)); err != nil {
+					return err
+				}
+			}
+		} else if err := checkJSONSchema(items, location+"/items"); err != nil {
+			return err
+		}
+	}
+
+	if required, ok := object["required"]; ok {
+		if err := checkJSONSchemaStrings(required, location+"/required"); err != nil {
+			return err
+		}
+	}
+
+	if enum, ok := object["enum"]; ok {
+		if _, ok := enum.([]any); !ok {
+			return jsonSchemaError(location+"/enum", "must be an array")
+		}
+	}
+
+	for _, keyword := range jsonSchemaCountKeywords {
+		value, ok := object[keyword]
+		if !ok {
+			continue
+		}
+		if n, ok := jsonSchemaNumber(value); !ok || n < 0 || n != math.Trunc(n) {
+			return jsonSchemaError(location+"/"+keyword, "must be a non-negative integer")
+		}
+	}
+
+	if value, ok := object["multipleOf"]; ok {
+		if n, ok := jsonSchemaNumber(value); !ok || n <= 0 {
+			return jsonSchemaError(location+"/multipleOf", "must be a number greater than 0")
+		}
+	}
+
+	for _, keyword := range []string{"maximum", "minimum"} {
+		if value, ok := object[keyword]; ok {
+			if _, ok := jsonSchemaNumber(value); !ok {
+				return jsonSchemaError(location+"/"+keyword, "must be a number")
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkJSONSchemaType checks a "type" keyword, which is a type name or a
+// non-empty array of unique type names.
+func checkJSONSchemaType(value any, location string) error {
+	names := []any{value}
+	if list, ok := value.([]any); ok {
+		if len(list) == 0 {
+			return jsonSchemaError(location, "must not be empty")
+		}
+		names = list
+	}
+	for _, name := range names {
+		s, ok := name.(string)
+		if !ok || !slices.Contains(jsonSchemaTypes, s) {
+			return jsonSchemaError(location, fmt.Sprintf("unknown type %v, must be one of: %s", name, strings.Join(jsonSchemaTypes, ", ")))
+		}
+	}
+	return checkJSONSchemaUnique(names, location)
+}
+
+// checkJSONSchemaStrings checks that value is an array of unique strings.
+func checkJSONSchemaStrings(value any, location string) error {
+	list, ok := value.([]any)
+	if !ok {
+		return jsonSchemaError(location, "must be an array of strings")
+	}
+	for _, element := range list {
+		if _, ok := element.(string); !ok {
+			return jsonSchemaError(location, "must be an array of strings")
+		}
+	}
+	return checkJSONSchemaUnique(list, location)
+}
+
+func checkJSONSchemaUnique(list []any, location string) error {
+	for i := range list {
+		for j := i + 1; j < len(list); j++ {
+			if reflect.DeepEqual(list[i], list[j]) {
+				return jsonSchemaError(location, fmt.Sprintf("duplicate value %v", list[i]))
+			}
+		}
+	}
+	return nil
+}
+
+func jsonSchemaNumber(value any) (float64, bool) {
+	number, ok := value.(json.Number)
+	if !ok {
+		return 0, false
+	}
+	n, err := number.Float64()
+	return n, err == nil
+}
+
+func jsonSchemaError(location, message string) error {
+	if location == "" {
+		location = "/"
+	}
+	return fmt.Errorf("at %s: %s", location, message)
+}
+
+// escapeJSONPointer escapes a property name for use in a JSON pointer.
+func escapeJSONPointer(name string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(name)
+}
+
+// jsonEqual reports whether two JSON documents have the same value, so
+// whitespace and key order differences in what the API returns do not show
+// as drift.
+func jsonEqual(a, b string) bool {
+	var x, y any
+	if err := json.Unmarshal([]byte(a), &x); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(b), &y); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(x, y)
+}
@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateJSONSchema(t *testing.T) {
+	testCases := map[string]struct {
+		schema string
+		err    string
+	}{
+		"object": {
+			schema: `{
+				"$schema": "https://json-schema.org/draft/2020-12/schema",
+				"type": "object",
+				"required": ["id", "status"],
+				"properties": {
+					"id": {"type": "integer", "minimum": 1},
+					"status": {"enum": ["ok", "degraded"]},
+					"tags": {"type": "array", "items": {"type": "string"}, "maxItems": 10}
+				},
+				"additionalProperties": false
+			}`,
+		},
+		"boolean":              {schema: `true`},
+		"draft 07 tuple items": {schema: `{"$schema": "http://json-schema.org/draft-07/schema#", "items": [{"type": "string"}, {"type": "number"}]}`},
+		"type list":            {schema: `{"type": ["string", "null"]}`},
+		"unknown keyword":      {schema: `{"x-owner": "payments"}`},
+		"invalid json":         {schema: `{"type": "object"`, err: "invalid JSON"},
+		"trailing data":        {schema: `{} {}`, err: "unexpected data"},
+		"not a schema":         {schema: `"object"`, err: "at /: a schema must be an object or a boolean"},
+		"unsupported dialect":  {schema: `{"$schema": "http://json-schema.org/draft-03/schema#"}`, err: "unsupported dialect"},
+		"unknown type":         {schema: `{"properties": {"id": {"type": "int"}}}`, err: "at /properties/id/type: unknown type int"},
+		"duplicate type":       {schema: `{"type": ["string", "string"]}`, err: "duplicate value string"},
+		"required not strings": {schema: `{"required": "id"}`, err: "at /required: must be an array of strings"},
+		"empty allOf":          {schema: `{"allOf": []}`, err: "at /allOf: must be a non-empty array of schemas"},
+		"negative minLength":   {schema: `{"minLength": -1}`, err: "at /minLength: must be a non-negative integer"},
+		"zero multipleOf":      {schema: `{"multipleOf": 0}`, err: "at /multipleOf: must be a number greater than 0"},
+		"nested item":          {schema: `{"items": {"properties": {"a/b": {"type": 1}}}}`, err: "at /items/properties/a~1b/type"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := validateJSONSchema(tc.schema)
+
+			if tc.err == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Fatalf("expected error containing %q, got %v", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestJSONEqual(t *testing.T) {
+	if !jsonEqual(`{"type":"object","required":["id"]}`, "{\n  \"required\": [\"id\"],\n  \"type\": \"object\"\n}") {
+		t.Error("expected reformatted documents to be equal")
+	}
+	if jsonEqual(`{"required":["id"]}`, `{"required":["id","status"]}`) {
+		t.Error("expected different documents not to be equal")
+	}
+	if jsonEqual(``, `{}`) {
+		t.Error("expected an empty value not to equal a document")
+	}
+}
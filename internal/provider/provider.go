@@ -5,19 +5,32 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"time"
 
 	"github.com/ackack-io/terraform-provider-ackack/internal/client"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure AckackProvider satisfies various provider interfaces.
 var _ provider.Provider = &AckackProvider{}
+var _ provider.ProviderWithFunctions = &AckackProvider{}
+var _ provider.ProviderWithEphemeralResources = &AckackProvider{}
+var _ provider.ProviderWithActions = &AckackProvider{}
 
 // AckackProvider defines the provider implementation.
 type AckackProvider struct {
@@ -29,8 +42,16 @@ type AckackProvider struct {
 
 // AckackProviderModel describes the provider data model.
 type AckackProviderModel struct {
-	APIKey   types.String `tfsdk:"api_key"`
-	Endpoint types.String `tfsdk:"endpoint"`
+	APIKey     types.String `tfsdk:"api_key"`
+	Endpoint   types.String `tfsdk:"endpoint"`
+	MaxDestroy types.Int64  `tfsdk:"max_destroy"`
+
+	BatchRequests           types.Bool  `tfsdk:"batch_requests"`
+	MaxRateLimitWaitSeconds types.Int64 `tfsdk:"max_rate_limit_wait_seconds"`
+
+	AllowedWebhookDomains types.List `tfsdk:"allowed_webhook_domains"`
+
+	AlertDefaults *AlertDefaultsModel `tfsdk:"alert_defaults"`
 }
 
 func (p *AckackProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -43,13 +64,77 @@ func (p *AckackProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 		MarkdownDescription: "The ackack provider allows you to manage uptime monitors, alerts, systems, and reports on ackack.io.",
 		Attributes: map[string]schema.Attribute{
 			"api_key": schema.StringAttribute{
-				MarkdownDescription: "The API key for authenticating with ackack.io. Can also be set via the `ACKACK_API_KEY` environment variable.",
-				Optional:            true,
-				Sensitive:           true,
+				MarkdownDescription: "The API key for authenticating with ackack.io. Can also be set via the `ACKACK_API_KEY` environment variable. " +
+					"The key may come from an ephemeral variable or resource: provider configuration is never written to state or plan files.",
+				Optional:  true,
+				Sensitive: true,
 			},
 			"endpoint": schema.StringAttribute{
-				MarkdownDescription: "The ackack.io API endpoint. Defaults to `https://api.ackack.io`. Can also be set via the `ACKACK_ENDPOINT` environment variable.",
-				Optional:            true,
+				MarkdownDescription: "The ackack.io API endpoint. Defaults to `https://api.ackack.io`. Can also be set via the `ACKACK_ENDPOINT` environment variable. " +
+					"For self-hosted endpoints the provider discovers the server's features and rejects resources it does not support.",
+				Optional: true,
+			},
+			"max_destroy": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of monitors a single plan may destroy. Plans that exceed it fail " +
+					"before anything is deleted, guarding against accidental mass deletion. Replacements, e.g. from `-replace` or " +
+					"`replace_triggered_by`, count too; Terraform only decides them after planning, so they fail when applied, " +
+					"before the monitor is deleted. When omitted, there is no limit.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"batch_requests": schema.BoolAttribute{
+				MarkdownDescription: "Whether monitors created, updated or destroyed at the same time are sent to the API in bulk requests " +
+					"of up to 100, instead of one request each. This speeds up applies of large fleets; raise Terraform's `-parallelism` " +
+					"to batch more monitors at once. Each monitor still succeeds or fails on its own. Default is false.",
+				Optional: true,
+			},
+			"max_rate_limit_wait_seconds": schema.Int64Attribute{
+				MarkdownDescription: "The longest the provider waits for the API's rate limit to reset before retrying a request. " +
+					"Requests that would need to wait longer, or past Terraform's own deadline, fail instead. " +
+					"Waits of more than a few seconds are reported as warnings. Default is 300.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"allowed_webhook_domains": schema.ListAttribute{
+				MarkdownDescription: "Domains that webhook, Slack and Discord alert targets may point to. A domain also allows its subdomains. " +
+					"Alerts with other targets fail at plan time, so alert data cannot be sent to unapproved endpoints. When omitted, every domain is allowed.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"alert_defaults": schema.SingleNestedAttribute{
+				MarkdownDescription: "Defaults for the settings of `ackack_alert` resources that do not set them, " +
+					"to apply an organization's alerting policy in one place. Changing a default updates every alert that relies on it.",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"trigger_threshold": schema.Int64Attribute{
+						MarkdownDescription: "The default number of consecutive failures before an alert triggers. Default is 1.",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.Between(1, 100),
+						},
+					},
+					"recovery_threshold": schema.Int64Attribute{
+						MarkdownDescription: "The default number of consecutive successes before a recovery notification is sent. Default is 1.",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.Between(1, 100),
+						},
+					},
+					"min_interval_minutes": schema.Int64Attribute{
+						MarkdownDescription: "The default minimum interval between alerts, in minutes. Default is 5.",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.Between(1, 1440),
+						},
+					},
+				},
 			},
 		},
 	}
@@ -64,6 +149,38 @@ func (p *AckackProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
+	// Values such as an API key created in the same configuration are unknown
+	// until apply. Where Terraform supports it, defer everything that needs
+	// the client instead of failing the plan.
+	if !req.Config.Raw.IsFullyKnown() && req.ClientCapabilities.DeferralAllowed {
+		resp.Deferred = &provider.Deferred{
+			Reason: provider.DeferredReasonProviderConfigUnknown,
+		}
+		return
+	}
+
+	if data.APIKey.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_key"),
+			"Unknown ackack API Key",
+			"The provider cannot create the ackack API client as there is an unknown configuration value for the ackack API key. "+
+				"Either apply the source of the value first with -target, set the value statically in the configuration, "+
+				"or use the ACKACK_API_KEY environment variable.",
+		)
+	}
+	if data.Endpoint.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("endpoint"),
+			"Unknown ackack Endpoint",
+			"The provider cannot create the ackack API client as there is an unknown configuration value for the ackack endpoint. "+
+				"Either apply the source of the value first with -target, set the value statically in the configuration, "+
+				"or use the ACKACK_ENDPOINT environment variable.",
+		)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Check environment variables first, then use config values
 	apiKey := os.Getenv("ACKACK_API_KEY")
 	if !data.APIKey.IsNull() {
@@ -99,8 +216,56 @@ func (p *AckackProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
+	if !data.MaxDestroy.IsNull() {
+		c.MaxDestroy = int(data.MaxDestroy.ValueInt64())
+	}
+	c.Observer = requestLogger{}
+	if !data.MaxRateLimitWaitSeconds.IsNull() {
+		c.MaxRetryWait = time.Duration(data.MaxRateLimitWaitSeconds.ValueInt64()) * time.Second
+	}
+	c.AlertDefaults = alertDefaultsFromModel(data.AlertDefaults)
+	if !data.AllowedWebhookDomains.IsNull() && !data.AllowedWebhookDomains.IsUnknown() {
+		resp.Diagnostics.Append(data.AllowedWebhookDomains.ElementsAs(ctx, &c.AllowedWebhookDomains, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Every server's version is checked. Self-hosted servers may also lag
+	// behind the hosted API, so discover which features they support before
+	// any resource touches them.
+	selfHosted := c.BaseURL != client.DefaultBaseURL
+	capabilities, err := c.GetCapabilities(ctx)
+	switch {
+	case err == nil:
+		checkAPIVersion(capabilities.Version, &resp.Diagnostics)
+		if selfHosted {
+			c.Capabilities = capabilities
+		}
+	case selfHosted:
+		resp.Diagnostics.AddWarning(
+			"Unable to Discover ackack Features",
+			fmt.Sprintf("The provider could not read the capabilities of the ackack server at %s, so all features are assumed to be available. "+
+				"Resources the server does not support will fail with API errors.\n\nError: %s", c.RedactedBaseURL(), err),
+		)
+	}
+
+	if data.BatchRequests.ValueBool() {
+		if c.SupportsFeature("bulk_monitors") {
+			c.EnableBatching()
+		} else {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("batch_requests"),
+				"Bulk Requests Not Supported",
+				fmt.Sprintf("The ackack server at %s does not support bulk monitor requests, so each monitor is sent on its own.", c.RedactedBaseURL()),
+			)
+		}
+	}
+
 	resp.DataSourceData = c
 	resp.ResourceData = c
+	resp.EphemeralResourceData = c
+	resp.ActionData = c
 }
 
 func (p *AckackProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -109,6 +274,16 @@ func (p *AckackProvider) Resources(ctx context.Context) []func() resource.Resour
 		NewAlertResource,
 		NewSystemResource,
 		NewReportResource,
+		NewAnnotationResource,
+		NewPagerDutyIntegrationResource,
+		NewSystemMonitorAttachmentResource,
+		NewMaintenanceWindowResource,
+		NewArtifactsBucketResource,
+		NewSLOResource,
+		NewLimitAlertResource,
+		NewBrowserMonitorResource,
+		NewMonitorSetResource,
+		NewMonitorActivationResource,
 	}
 }
 
@@ -125,6 +300,41 @@ func (p *AckackProvider) DataSources(ctx context.Context) []func() datasource.Da
 		NewMonitorIncidentsDataSource,
 		NewMonitorHealthDataSource,
 		NewNotificationsDataSource,
+		NewAccountDataSource,
+		NewAnnotationsDataSource,
+		NewFailingMonitorsDataSource,
+		NewCoverageDataSource,
+		NewMonitorBadgeDataSource,
+		NewExportManifestDataSource,
+		NewAccountHealthDataSource,
+		NewOrganizationAccountsDataSource,
+		NewMonitorIsUpDataSource,
+		NewChangeFeedDataSource,
+		NewLatencyHistogramDataSource,
+	}
+}
+
+func (p *AckackProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewResultArtifactEphemeralResource,
+		NewMonitorCheckEphemeralResource,
+	}
+}
+
+func (p *AckackProvider) Actions(ctx context.Context) []func() action.Action {
+	return []func() action.Action{
+		NewMonitorTriggerCheckAction,
+		NewMonitorResetIncidentsAction,
+	}
+}
+
+func (p *AckackProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewMonitorManifestFunction,
+		NewNormalizeTargetFunction,
+		NewNormalizeHeadersFunction,
+		NewValidateCronFunction,
+		NewSLAToDowntimeFunction,
 	}
 }
 
@@ -135,3 +345,45 @@ func New(version string) func() provider.Provider {
 		}
 	}
 }
+
+// requireFeature adds an error to diags when the ackack server does not
+// support feature, so unsupported resources fail with a clear message rather
+// than a 404. The description names the feature in the message, e.g.
+// "Reports".
+func requireFeature(c *client.Client, feature, description string, diags *diag.Diagnostics) {
+	if c.SupportsFeature(feature) {
+		return
+	}
+
+	diags.AddError(
+		"Unsupported ackack Feature",
+		fmt.Sprintf("%s are not supported by this ackack version (%s) at %s. "+
+			"Upgrade the server or remove these resources and data sources from the configuration.",
+			description, c.Capabilities.Version, c.RedactedBaseURL()),
+	)
+}
+
+// checkAPIVersion warns when the server version is outside the range the
+// provider supports. Resources may still work, so this is not an error.
+func checkAPIVersion(serverVersion string, diags *diag.Diagnostics) {
+	constraints := version.MustConstraints(version.NewConstraint(client.SupportedAPIVersions))
+
+	v, err := version.NewVersion(serverVersion)
+	if err != nil {
+		diags.AddWarning(
+			"Unknown ackack Server Version",
+			fmt.Sprintf("The ackack server reported version %q, which could not be parsed. "+
+				"This provider supports server versions %s.", serverVersion, client.SupportedAPIVersions),
+		)
+		return
+	}
+
+	if !constraints.Check(v) {
+		diags.AddWarning(
+			"Unsupported ackack Server Version",
+			fmt.Sprintf("The ackack server is version %s, but this provider supports server versions %s. "+
+				"Some resources may fail or behave unexpectedly; upgrade the server or use a provider release that matches it.",
+				v, client.SupportedAPIVersions),
+		)
+	}
+}
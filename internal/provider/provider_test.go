@@ -4,11 +4,25 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
 	"os"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 // testAccProtoV6ProviderFactories is used to instantiate a provider during acceptance testing.
@@ -23,3 +37,151 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatal("ACKACK_API_KEY must be set for acceptance tests")
 	}
 }
+
+// unknownAPIKeyConfig returns a provider configuration whose api_key is not
+// known until apply.
+func unknownAPIKeyConfig(t *testing.T) tfsdk.Config {
+	t.Helper()
+
+	ctx := context.Background()
+	var resp provider.SchemaResponse
+	(&AckackProvider{}).Schema(ctx, provider.SchemaRequest{}, &resp)
+
+	objectType, ok := resp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	if !ok {
+		t.Fatal("provider schema is not an object")
+	}
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	values["api_key"] = tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+
+	return tfsdk.Config{Schema: resp.Schema, Raw: tftypes.NewValue(objectType, values)}
+}
+
+func TestProviderConfigure_UnknownAPIKey(t *testing.T) {
+	t.Run("deferral allowed", func(t *testing.T) {
+		req := provider.ConfigureRequest{Config: unknownAPIKeyConfig(t)}
+		req.ClientCapabilities.DeferralAllowed = true
+		var resp provider.ConfigureResponse
+		(&AckackProvider{}).Configure(context.Background(), req, &resp)
+
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected error: %v", resp.Diagnostics)
+		}
+		if resp.Deferred == nil || resp.Deferred.Reason != provider.DeferredReasonProviderConfigUnknown {
+			t.Errorf("Deferred = %v, want provider config unknown", resp.Deferred)
+		}
+	})
+
+	t.Run("deferral not allowed", func(t *testing.T) {
+		req := provider.ConfigureRequest{Config: unknownAPIKeyConfig(t)}
+		var resp provider.ConfigureResponse
+		(&AckackProvider{}).Configure(context.Background(), req, &resp)
+
+		if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Unknown ackack API Key" {
+			t.Errorf("diagnostics = %v, want Unknown ackack API Key error", resp.Diagnostics)
+		}
+		if resp.Deferred != nil {
+			t.Errorf("Deferred = %v, want nil", resp.Deferred)
+		}
+	})
+}
+
+// secretAbsent fails a plan or state check when the JSON rendering of the
+// plan or state contains secret.
+type secretAbsent struct {
+	secret string
+}
+
+func (c secretAbsent) check(name string, v any) error {
+	rendered, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if strings.Contains(string(rendered), c.secret) {
+		return fmt.Errorf("the %s contains the API key", name)
+	}
+	return nil
+}
+
+func (c secretAbsent) CheckPlan(ctx context.Context, req plancheck.CheckPlanRequest, resp *plancheck.CheckPlanResponse) {
+	resp.Error = c.check("plan", req.Plan)
+}
+
+func (c secretAbsent) CheckState(ctx context.Context, req statecheck.CheckStateRequest, resp *statecheck.CheckStateResponse) {
+	resp.Error = c.check("state", req.State)
+}
+
+func TestAccProvider_EphemeralAPIKey(t *testing.T) {
+	apiKey := os.Getenv("ACKACK_API_KEY")
+	rName := acctest.RandomWithPrefix("tfacc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+variable "api_key" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+provider "ackack" {
+  api_key = var.api_key
+}
+
+resource "ackack_monitor" "test" {
+  name = %[1]q
+  type = "http"
+  url  = "https://example.com"
+}
+`, rName),
+				ConfigVariables: config.Variables{
+					"api_key": config.StringVariable(apiKey),
+				},
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{secretAbsent{secret: apiKey}},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{secretAbsent{secret: apiKey}},
+			},
+		},
+	})
+}
+
+func TestCheckAPIVersion(t *testing.T) {
+	testCases := map[string]struct {
+		version string
+		warning string
+	}{
+		"supported":   {version: "1.9.0"},
+		"oldest":      {version: "1.8.0"},
+		"too old":     {version: "1.7.3", warning: "Unsupported ackack Server Version"},
+		"too new":     {version: "2.0.0", warning: "Unsupported ackack Server Version"},
+		"unparseable": {version: "nightly", warning: "Unknown ackack Server Version"},
+		"empty":       {version: "", warning: "Unknown ackack Server Version"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			checkAPIVersion(tc.version, &diags)
+
+			if diags.HasError() {
+				t.Fatalf("expected only warnings, got %v", diags)
+			}
+			switch {
+			case tc.warning == "" && len(diags) != 0:
+				t.Errorf("expected no warnings, got %v", diags)
+			case tc.warning != "" && (len(diags) != 1 || diags[0].Summary() != tc.warning):
+				t.Errorf("expected warning %q, got %v", tc.warning, diags)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ackack-io/terraform-provider-ackack/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// trackRateLimits returns a context that records the client's waits for rate
+// limits to reset, and a function that adds a warning about them to diags,
+// so slow operations explain themselves.
+func trackRateLimits(ctx context.Context) (context.Context, func(*diag.Diagnostics)) {
+	ctx, waits := client.WithRateLimitWaits(ctx)
+	return ctx, func(diags *diag.Diagnostics) {
+		recorded := waits()
+		if len(recorded) == 0 {
+			return
+		}
+		var total time.Duration
+		for _, wait := range recorded {
+			total += wait.Duration
+		}
+		diags.AddWarning(
+			"API Rate Limited",
+			fmt.Sprintf("The ackack API rate limited this operation %d time(s), and it waited %s in total for the limit to reset. "+
+				"Large applies can send fewer requests with the provider's batch_requests option or a lower -parallelism.", len(recorded), total),
+		)
+	}
+}
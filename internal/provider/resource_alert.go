@@ -4,17 +4,27 @@
 package provider
 
 import (
+	"cmp"
 	"context"
 	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+	_ "time/tzdata" // Validate time zones without relying on the host's zoneinfo.
 
 	"github.com/ackack-io/terraform-provider-ackack/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -23,6 +33,11 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &AlertResource{}
 var _ resource.ResourceWithImportState = &AlertResource{}
+var _ resource.ResourceWithIdentity = &AlertResource{}
+var _ resource.ResourceWithUpgradeState = &AlertResource{}
+var _ resource.ResourceWithModifyPlan = &AlertResource{}
+var _ resource.ResourceWithConfigValidators = &AlertResource{}
+var _ resource.ResourceWithValidateConfig = &AlertResource{}
 
 func NewAlertResource() resource.Resource {
 	return &AlertResource{}
@@ -35,27 +50,81 @@ type AlertResource struct {
 
 // AlertResourceModel describes the resource data model.
 type AlertResourceModel struct {
-	ID                 types.String `tfsdk:"id"`
-	MonitorID          types.String `tfsdk:"monitor_id"`
-	Type               types.String `tfsdk:"type"`
-	Target             types.String `tfsdk:"target"`
-	IsEnabled          types.Bool   `tfsdk:"is_enabled"`
-	TriggerThreshold   types.Int64  `tfsdk:"trigger_threshold"`
-	RecoveryThreshold  types.Int64  `tfsdk:"recovery_threshold"`
-	MinIntervalMinutes types.Int64  `tfsdk:"min_interval_minutes"`
-	CustomMessage      types.String `tfsdk:"custom_message"`
-	IncludeDetails     types.Bool   `tfsdk:"include_details"`
-	LastTriggeredAt    types.String `tfsdk:"last_triggered_at"`
-	CreatedAt          types.String `tfsdk:"created_at"`
-	UpdatedAt          types.String `tfsdk:"updated_at"`
+	ID                        types.String      `tfsdk:"id"`
+	MonitorID                 types.String      `tfsdk:"monitor_id"`
+	Type                      types.String      `tfsdk:"type"`
+	Target                    types.String      `tfsdk:"target"`
+	TargetWO                  types.String      `tfsdk:"target_wo"`
+	TargetWOVersion           types.Int64       `tfsdk:"target_wo_version"`
+	IsEnabled                 types.Bool        `tfsdk:"is_enabled"`
+	TriggerThreshold          types.Int64       `tfsdk:"trigger_threshold"`
+	RecoveryThreshold         types.Int64       `tfsdk:"recovery_threshold"`
+	MinIntervalMinutes        types.Int64       `tfsdk:"min_interval_minutes"`
+	CustomMessage             types.String      `tfsdk:"custom_message"`
+	IncludeDetails            types.Bool        `tfsdk:"include_details"`
+	IntegrationID             types.String      `tfsdk:"integration_id"`
+	Opsgenie                  *OpsgenieModel    `tfsdk:"opsgenie"`
+	PhoneCountry              types.String      `tfsdk:"phone_country"`
+	SenderID                  types.String      `tfsdk:"sender_id"`
+	PayloadTemplate           types.String      `tfsdk:"payload_template"`
+	Headers                   types.Map         `tfsdk:"headers"`
+	Severity                  types.String      `tfsdk:"severity"`
+	ActiveHours               *ActiveHoursModel `tfsdk:"active_hours"`
+	SuppressDuringMaintenance types.Bool        `tfsdk:"suppress_during_maintenance"`
+	MaintenanceWindowIDs      types.Set         `tfsdk:"maintenance_window_ids"`
+	SendTestNotification      types.Bool        `tfsdk:"send_test_notification"`
+	LastTriggeredAt           types.String      `tfsdk:"last_triggered_at"`
+	CreatedAt                 types.String      `tfsdk:"created_at"`
+	UpdatedAt                 types.String      `tfsdk:"updated_at"`
 }
 
+// OpsgenieModel describes the opsgenie routing settings of an alert.
+type OpsgenieModel struct {
+	APIKey   types.String `tfsdk:"api_key"`
+	Team     types.String `tfsdk:"team"`
+	Region   types.String `tfsdk:"region"`
+	Priority types.String `tfsdk:"priority"`
+}
+
+// ActiveHoursModel describes when an alert sends notifications, or when a
+// monitor runs checks.
+type ActiveHoursModel struct {
+	Days      types.Set    `tfsdk:"days"`
+	StartTime types.String `tfsdk:"start_time"`
+	EndTime   types.String `tfsdk:"end_time"`
+	Timezone  types.String `tfsdk:"timezone"`
+}
+
+// weekdays are the accepted values of active_hours.days.
+var weekdays = []string{"mon", "tue", "wed", "thu", "fri", "sat", "sun"}
+
+// clockTimeRegexp matches a 24-hour HH:MM time of day.
+var clockTimeRegexp = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`)
+
+// alertManagedBy marks alerts created or updated by this provider, so that
+// monitors can tell them apart from alerts added in the dashboard.
+const alertManagedBy = "terraform"
+
+// webhookTemplateVariables lists the variables available in webhook payload
+// templates.
+var webhookTemplateVariables = []string{
+	"monitor_id", "monitor_name", "monitor_url", "status", "incident_id", "incident_url", "error_message", "timestamp",
+}
+
+// templateVariableRegexp matches a {{variable}} reference in a template.
+var templateVariableRegexp = regexp.MustCompile(`{{\s*([A-Za-z0-9_]+)\s*}}`)
+
+// e164Regexp matches phone numbers in E.164 format, e.g. +14155550123.
+var e164Regexp = regexp.MustCompile(`^\+[1-9][0-9]{1,14}$`)
+
 func (r *AlertResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_alert"
 }
 
 func (r *AlertResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 0,
+
 		MarkdownDescription: "Manages an alert configuration for a monitor on ackack.io.",
 
 		Attributes: map[string]schema.Attribute{
@@ -74,15 +143,154 @@ func (r *AlertResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 			"type": schema.StringAttribute{
-				MarkdownDescription: "The type of alert. Must be one of: `email`, `webhook`, `discord`, `slack`, `pagerduty`.",
+				MarkdownDescription: "The type of alert. Must be one of: `email`, `webhook`, `discord`, `slack`, `pagerduty`, `opsgenie`, `sms`, `voice`.",
 				Required:            true,
 				Validators: []validator.String{
-					stringvalidator.OneOf("email", "webhook", "discord", "slack", "pagerduty"),
+					stringvalidator.OneOf(client.Strings(client.AlertTypes)...),
 				},
 			},
 			"target": schema.StringAttribute{
-				MarkdownDescription: "The target for the alert (email address, webhook URL, etc.).",
-				Required:            true,
+				MarkdownDescription: "The target for the alert (email address, webhook URL, etc.). For `sms` and `voice` alerts this must be a phone number in E.164 format, e.g. `+14155550123`. Exactly one of `target`, `target_wo`, `integration_id` or `opsgenie` must be set.",
+				Optional:            true,
+			},
+			"target_wo": schema.StringAttribute{
+				MarkdownDescription: "A write-only variant of `target` for targets that embed a secret, such as webhook URLs with tokens. " +
+					"This value is never stored in Terraform state and is only sent to the API on create, or on update when " +
+					"`target_wo_version` changes. Requires Terraform 1.11 or later.",
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("target_wo_version")),
+				},
+			},
+			"target_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Change this value to send an updated `target_wo` to the API.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("target_wo")),
+				},
+			},
+			"integration_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of an `ackack_pagerduty_integration` to deliver `pagerduty` alerts through, instead of a `target`.",
+				Optional:            true,
+			},
+			"opsgenie": schema.SingleNestedAttribute{
+				MarkdownDescription: "Opsgenie routing settings for `opsgenie` alerts, used instead of a `target`.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"api_key": schema.StringAttribute{
+						MarkdownDescription: "The Opsgenie API integration key. The API never returns this value.",
+						Required:            true,
+						Sensitive:           true,
+					},
+					"team": schema.StringAttribute{
+						MarkdownDescription: "The Opsgenie team to route alerts to.",
+						Optional:            true,
+					},
+					"region": schema.StringAttribute{
+						MarkdownDescription: "The Opsgenie region of the account. Must be one of: `us`, `eu`. Defaults to `us`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString("us"),
+						Validators: []validator.String{
+							stringvalidator.OneOf("us", "eu"),
+						},
+					},
+					"priority": schema.StringAttribute{
+						MarkdownDescription: "The Opsgenie priority to create alerts with. Must be one of: `P1`, `P2`, `P3`, `P4`, `P5`.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("P1", "P2", "P3", "P4", "P5"),
+						},
+					},
+				},
+			},
+			"phone_country": schema.StringAttribute{
+				MarkdownDescription: "The ISO 3166-1 alpha-2 country code used to route `sms` and `voice` alerts, e.g. `US`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[A-Z]{2}$`), "must be a two-letter uppercase ISO 3166-1 country code"),
+				},
+			},
+			"sender_id": schema.StringAttribute{
+				MarkdownDescription: "The sender ID or caller number shown to recipients of `sms` and `voice` alerts, where supported by the destination country.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 16),
+				},
+			},
+			"payload_template": schema.StringAttribute{
+				MarkdownDescription: "The request body to send for `webhook` alerts, instead of the default JSON payload. " +
+					"Supports the variables `{{monitor_id}}`, `{{monitor_name}}`, `{{monitor_url}}`, `{{status}}`, `{{incident_id}}`, " +
+					"`{{incident_url}}`, `{{error_message}}` and `{{timestamp}}`.",
+				Optional: true,
+			},
+			"headers": schema.MapAttribute{
+				MarkdownDescription: "Additional HTTP headers to send with `webhook` alerts, such as an `Authorization` header.",
+				Optional:            true,
+				Sensitive:           true,
+				ElementType:         types.StringType,
+			},
+			"severity": schema.StringAttribute{
+				MarkdownDescription: "The minimum incident severity that triggers this alert. Must be one of: `info`, `warning`, `critical`. " +
+					"Use it to send every incident to chat while only paging for critical ones. When omitted, the alert fires for all incidents. " +
+					"Incident severity is set on the monitor with `severity_mapping`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.Strings(client.Severities)...),
+				},
+			},
+			"active_hours": schema.SingleNestedAttribute{
+				MarkdownDescription: "Restricts notifications to the given days and time window, e.g. business hours for non-production monitors. " +
+					"Incidents outside the window are still recorded. When omitted, the alert is always active.",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"days": schema.SetAttribute{
+						MarkdownDescription: "Days of the week the alert is active. Each must be one of: `mon`, `tue`, `wed`, `thu`, `fri`, `sat`, `sun`.",
+						Required:            true,
+						ElementType:         types.StringType,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ValueStringsAre(stringvalidator.OneOf(weekdays...)),
+						},
+					},
+					"start_time": schema.StringAttribute{
+						MarkdownDescription: "Start of the active window in 24-hour `HH:MM` format.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(clockTimeRegexp, "must be a 24-hour time in HH:MM format"),
+						},
+					},
+					"end_time": schema.StringAttribute{
+						MarkdownDescription: "End of the active window in 24-hour `HH:MM` format. A window that ends before it starts spans midnight.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(clockTimeRegexp, "must be a 24-hour time in HH:MM format"),
+						},
+					},
+					"timezone": schema.StringAttribute{
+						MarkdownDescription: "The IANA time zone the window is evaluated in, e.g. `Europe/Berlin`. Defaults to `UTC`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString("UTC"),
+					},
+				},
+			},
+			"suppress_during_maintenance": schema.BoolAttribute{
+				MarkdownDescription: "Whether notifications are suppressed while the monitor is in a maintenance window. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"maintenance_window_ids": schema.SetAttribute{
+				MarkdownDescription: "Only suppress notifications during these maintenance windows. When omitted, any maintenance window " +
+					"covering the monitor suppresses notifications. Requires `suppress_during_maintenance` to be `true`.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
 			},
 			"is_enabled": schema.BoolAttribute{
 				MarkdownDescription: "Whether the alert is enabled. Defaults to `true`.",
@@ -91,22 +299,28 @@ func (r *AlertResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Default:             booldefault.StaticBool(true),
 			},
 			"trigger_threshold": schema.Int64Attribute{
-				MarkdownDescription: "Number of consecutive failures before triggering the alert. Defaults to `1`.",
+				MarkdownDescription: "Number of consecutive failures before triggering the alert, between `1` and `100`. Defaults to the provider's `alert_defaults.trigger_threshold`, or `1` when that is not set.",
 				Optional:            true,
 				Computed:            true,
-				Default:             int64default.StaticInt64(1),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
 			},
 			"recovery_threshold": schema.Int64Attribute{
-				MarkdownDescription: "Number of consecutive successes before sending recovery notification. Defaults to `1`.",
+				MarkdownDescription: "Number of consecutive successes before sending recovery notification, between `1` and `100`. Defaults to the provider's `alert_defaults.recovery_threshold`, or `1` when that is not set.",
 				Optional:            true,
 				Computed:            true,
-				Default:             int64default.StaticInt64(1),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
 			},
 			"min_interval_minutes": schema.Int64Attribute{
-				MarkdownDescription: "Minimum interval between alerts, in minutes. Defaults to `5`.",
+				MarkdownDescription: "Minimum interval between alerts, in minutes, between `1` and `1440`. Defaults to the provider's `alert_defaults.min_interval_minutes`, or `5` when that is not set.",
 				Optional:            true,
 				Computed:            true,
-				Default:             int64default.StaticInt64(5),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 1440),
+				},
 			},
 			"custom_message": schema.StringAttribute{
 				MarkdownDescription: "Custom message to include in alerts.",
@@ -118,6 +332,12 @@ func (r *AlertResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Computed:            true,
 				Default:             booldefault.StaticBool(true),
 			},
+			"send_test_notification": schema.BoolAttribute{
+				MarkdownDescription: "Whether to send a test notification through the alert each time it is created or updated, " +
+					"and fail the apply when it cannot be delivered, so a wrong target is caught before the next outage. " +
+					"An alert whose test notification fails on create is still created and is marked as tainted.",
+				Optional: true,
+			},
 			"last_triggered_at": schema.StringAttribute{
 				MarkdownDescription: "The timestamp when the alert was last triggered.",
 				Computed:            true,
@@ -134,6 +354,132 @@ func (r *AlertResource) Schema(ctx context.Context, req resource.SchemaRequest,
 	}
 }
 
+func (r *AlertResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("target"),
+			path.MatchRoot("target_wo"),
+			path.MatchRoot("integration_id"),
+			path.MatchRoot("opsgenie"),
+		),
+	}
+}
+
+func (r *AlertResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AlertResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ActiveHours != nil {
+		if tz := data.ActiveHours.Timezone; !tz.IsNull() && !tz.IsUnknown() {
+			if _, err := time.LoadLocation(tz.ValueString()); err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("active_hours").AtName("timezone"),
+					"Invalid Time Zone",
+					fmt.Sprintf("Unknown IANA time zone %q: %s.", tz.ValueString(), err),
+				)
+			}
+		}
+		if start, end := data.ActiveHours.StartTime, data.ActiveHours.EndTime; !start.IsUnknown() && !end.IsUnknown() && start.Equal(end) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("active_hours").AtName("end_time"),
+				"Invalid Active Hours",
+				"The end_time must differ from start_time. Omit active_hours to keep the alert active all day.",
+			)
+		}
+	}
+
+	if !data.MaintenanceWindowIDs.IsNull() && !data.SuppressDuringMaintenance.IsUnknown() && !data.SuppressDuringMaintenance.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("maintenance_window_ids"),
+			"Invalid Attribute Combination",
+			"The maintenance_window_ids attribute has no effect unless suppress_during_maintenance is true.",
+		)
+	}
+
+	if data.Type.IsUnknown() || data.Type.IsNull() {
+		return
+	}
+
+	alertType := client.AlertType(data.Type.ValueString())
+
+	if alertType == client.AlertTypeSMS || alertType == client.AlertTypeVoice {
+		if !data.Target.IsNull() && !data.Target.IsUnknown() && !e164Regexp.MatchString(data.Target.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("target"),
+				"Invalid Phone Number",
+				fmt.Sprintf("The target of %s alerts must be a phone number in E.164 format (e.g. +14155550123), got: %q.", alertType, data.Target.ValueString()),
+			)
+		}
+		if !data.TargetWO.IsNull() && !data.TargetWO.IsUnknown() && !e164Regexp.MatchString(data.TargetWO.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("target_wo"),
+				"Invalid Phone Number",
+				fmt.Sprintf("The target of %s alerts must be a phone number in E.164 format (e.g. +14155550123).", alertType),
+			)
+		}
+	} else {
+		if !data.PhoneCountry.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("phone_country"),
+				"Invalid Attribute Combination",
+				fmt.Sprintf("The phone_country attribute can only be used with alerts of type \"sms\" or \"voice\", got: %q.", alertType),
+			)
+		}
+		if !data.SenderID.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("sender_id"),
+				"Invalid Attribute Combination",
+				fmt.Sprintf("The sender_id attribute can only be used with alerts of type \"sms\" or \"voice\", got: %q.", alertType),
+			)
+		}
+	}
+
+	if alertType == client.AlertTypeWebhook {
+		if !data.PayloadTemplate.IsNull() && !data.PayloadTemplate.IsUnknown() {
+			for _, match := range templateVariableRegexp.FindAllStringSubmatch(data.PayloadTemplate.ValueString(), -1) {
+				if !slices.Contains(webhookTemplateVariables, match[1]) {
+					resp.Diagnostics.AddAttributeError(
+						path.Root("payload_template"),
+						"Invalid Template Variable",
+						fmt.Sprintf("Unknown template variable %q. Supported variables are: %s.", match[1], strings.Join(webhookTemplateVariables, ", ")),
+					)
+				}
+			}
+		}
+	} else {
+		if !data.PayloadTemplate.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("payload_template"),
+				"Invalid Attribute Combination",
+				fmt.Sprintf("The payload_template attribute can only be used with alerts of type \"webhook\", got: %q.", alertType),
+			)
+		}
+		if !data.Headers.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("headers"),
+				"Invalid Attribute Combination",
+				fmt.Sprintf("The headers attribute can only be used with alerts of type \"webhook\", got: %q.", alertType),
+			)
+		}
+	}
+
+	if data.Opsgenie != nil && alertType != client.AlertTypeOpsgenie {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("opsgenie"),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("The opsgenie block can only be used with alerts of type \"opsgenie\", got: %q.", alertType),
+		)
+	}
+}
+
+func (r *AlertResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("The unique identifier of the alert.")
+}
+
 func (r *AlertResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -152,17 +498,60 @@ func (r *AlertResource) Configure(ctx context.Context, req resource.ConfigureReq
 }
 
 func (r *AlertResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
 	var data AlertResourceModel
+	var targetWO types.String
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only values are only available in the configuration.
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("target_wo"), &targetWO)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	createReq := client.CreateAlertRequest{
 		MonitorID: data.MonitorID.ValueString(),
-		Type:      data.Type.ValueString(),
-		Target:    data.Target.ValueString(),
+		Type:      client.AlertType(data.Type.ValueString()),
+		ManagedBy: alertManagedBy,
+	}
+
+	if !data.Target.IsNull() {
+		createReq.Target = data.Target.ValueString()
+	}
+	if !targetWO.IsNull() {
+		createReq.Target = targetWO.ValueString()
+	}
+	if !data.IntegrationID.IsNull() {
+		createReq.IntegrationID = data.IntegrationID.ValueString()
+	}
+	createReq.Opsgenie = opsgenieToClient(data.Opsgenie)
+	if !data.PhoneCountry.IsNull() {
+		createReq.PhoneCountry = data.PhoneCountry.ValueString()
+	}
+	if !data.SenderID.IsNull() {
+		createReq.SenderID = data.SenderID.ValueString()
+	}
+	if !data.PayloadTemplate.IsNull() {
+		createReq.PayloadTemplate = data.PayloadTemplate.ValueString()
+	}
+	createReq.Headers = stringMapFromValue(data.Headers)
+	if !data.Severity.IsNull() {
+		createReq.Severity = client.Severity(data.Severity.ValueString())
+	}
+	if data.ActiveHours != nil {
+		createReq.ActiveHours = activeHoursToClient(ctx, data.ActiveHours, &resp.Diagnostics)
+	}
+	if !data.SuppressDuringMaintenance.IsNull() {
+		suppress := data.SuppressDuringMaintenance.ValueBool()
+		createReq.SuppressDuringMaintenance = &suppress
+	}
+	if !data.MaintenanceWindowIDs.IsNull() {
+		resp.Diagnostics.Append(data.MaintenanceWindowIDs.ElementsAs(ctx, &createReq.MaintenanceWindowIDs, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	if !data.IsEnabled.IsNull() {
@@ -193,11 +582,23 @@ func (r *AlertResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	r.updateModelFromResponse(&data, alert)
+	resp.Diagnostics.Append(setPrivateETag(ctx, resp.Private, alert.ETag)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.ID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.SendTestNotification.ValueBool() {
+		r.sendTestNotification(ctx, data.ID.ValueString(), &resp.Diagnostics)
+	}
 }
 
 func (r *AlertResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
 	var data AlertResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -216,20 +617,77 @@ func (r *AlertResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	r.updateModelFromResponse(&data, alert)
+	resp.Diagnostics.Append(setPrivateETag(ctx, resp.Private, alert.ETag)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.ID)...)
 }
 
 func (r *AlertResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
 	var data AlertResourceModel
+	var state AlertResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	etag, diags := privateETag(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	updateReq := client.UpdateAlertRequest{
-		Target: data.Target.ValueString(),
+		IfMatch:   etag,
+		ManagedBy: alertManagedBy,
+	}
+
+	if !data.Target.IsNull() {
+		updateReq.Target = data.Target.ValueString()
+	}
+	// Only resend a write-only target when the practitioner bumps the version.
+	if !data.TargetWOVersion.IsNull() && !data.TargetWOVersion.Equal(state.TargetWOVersion) {
+		var targetWO types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("target_wo"), &targetWO)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		updateReq.Target = targetWO.ValueString()
+	}
+	if !data.IntegrationID.IsNull() {
+		updateReq.IntegrationID = data.IntegrationID.ValueString()
+	}
+	updateReq.Opsgenie = opsgenieToClient(data.Opsgenie)
+	if !data.PhoneCountry.IsNull() {
+		updateReq.PhoneCountry = data.PhoneCountry.ValueString()
+	}
+	if !data.SenderID.IsNull() {
+		updateReq.SenderID = data.SenderID.ValueString()
+	}
+	if !data.PayloadTemplate.IsNull() {
+		updateReq.PayloadTemplate = data.PayloadTemplate.ValueString()
+	}
+	updateReq.Headers = stringMapFromValue(data.Headers)
+	if !data.Severity.IsNull() {
+		updateReq.Severity = client.Severity(data.Severity.ValueString())
+	}
+	if data.ActiveHours != nil {
+		updateReq.ActiveHours = activeHoursToClient(ctx, data.ActiveHours, &resp.Diagnostics)
+	}
+	if !data.SuppressDuringMaintenance.IsNull() {
+		suppress := data.SuppressDuringMaintenance.ValueBool()
+		updateReq.SuppressDuringMaintenance = &suppress
+	}
+	if !data.MaintenanceWindowIDs.IsNull() {
+		resp.Diagnostics.Append(data.MaintenanceWindowIDs.ElementsAs(ctx, &updateReq.MaintenanceWindowIDs, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	if !data.IsEnabled.IsNull() {
@@ -255,16 +713,72 @@ func (r *AlertResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	alert, err := r.client.UpdateAlert(ctx, data.ID.ValueString(), updateReq)
 	if err != nil {
+		if client.IsPreconditionFailedError(err) {
+			addChangedOutsideTerraformError(&resp.Diagnostics, "alert", data.ID.ValueString())
+			return
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update alert, got error: %s", err))
 		return
 	}
 
 	r.updateModelFromResponse(&data, alert)
+	resp.Diagnostics.Append(setPrivateETag(ctx, resp.Private, alert.ETag)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.ID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.SendTestNotification.ValueBool() {
+		r.sendTestNotification(ctx, data.ID.ValueString(), &resp.Diagnostics)
+	}
+}
+
+// markManaged sets the managed_by marker of an imported alert. It warns
+// when the update fails, since the marker only affects drift reporting.
+func (r *AlertResource) markManaged(ctx context.Context, alert *client.Alert, diags *diag.Diagnostics) {
+	_, err := r.client.UpdateAlert(ctx, alert.ID, client.UpdateAlertRequest{
+		IfMatch:   alert.ETag,
+		ManagedBy: alertManagedBy,
+	})
+	if err != nil {
+		diags.AddWarning(
+			"Unable to Mark Alert as Managed",
+			fmt.Sprintf("Alert %s may be reported as unmanaged by its monitor until its next update, got error: %s", alert.ID, err),
+		)
+	}
+}
+
+// sendTestNotification sends a test notification through the alert and adds
+// an error when it is not delivered. The alert has already been saved to
+// state by then.
+func (r *AlertResource) sendTestNotification(ctx context.Context, id string, diags *diag.Diagnostics) {
+	result, err := r.client.SendTestNotification(ctx, id)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to send test notification, got error: %s", err))
+		return
+	}
+	if result.Delivered {
+		return
+	}
+
+	detail := result.ErrorMessage
+	if result.ResponseCode != 0 {
+		detail = fmt.Sprintf("%s (HTTP %d)", detail, result.ResponseCode)
+	}
+	diags.AddAttributeError(
+		path.Root("send_test_notification"),
+		"Test Notification Failed",
+		fmt.Sprintf("The test notification of alert %s could not be delivered: %s. "+
+			"Check the alert's target, or set send_test_notification to false to skip the test.", id, detail),
+	)
 }
 
 func (r *AlertResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
 	var data AlertResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -282,15 +796,62 @@ func (r *AlertResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 }
 
+func (r *AlertResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	defaults := builtinAlertDefaults
+	if r.client != nil {
+		defaults = r.client.AlertDefaults
+	}
+	resp.Diagnostics.Append(planAlertDefaults(ctx, defaults, req.Config, &resp.Plan)...)
+	if r.client == nil || resp.Diagnostics.HasError() {
+		return
+	}
+
+	var alertType, target, targetWO types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("type"), &alertType)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("target"), &target)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("target_wo"), &targetWO)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checkWebhookAllowlist(r.client.AllowedWebhookDomains, alertType, target, path.Root("target"), &resp.Diagnostics)
+	checkWebhookAllowlist(r.client.AllowedWebhookDomains, alertType, targetWO, path.Root("target_wo"), &resp.Diagnostics)
+}
+
+// UpgradeState returns the state upgraders from each previous schema version.
+func (r *AlertResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
 func (r *AlertResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+
+	var id string
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Mark the alert as managed, so that its monitor does not report it as
+	// unmanaged. Read leaves the marker alone, so refreshes don't write.
+	alert, err := r.client.GetAlert(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read alert, got error: %s", err))
+		return
+	}
+	if alert.ManagedBy != alertManagedBy {
+		r.markManaged(ctx, alert, &resp.Diagnostics)
+	}
 }
 
 func (r *AlertResource) updateModelFromResponse(data *AlertResourceModel, alert *client.Alert) {
 	data.ID = types.StringValue(alert.ID)
 	data.MonitorID = types.StringValue(alert.MonitorID)
-	data.Type = types.StringValue(alert.Type)
-	data.Target = types.StringValue(alert.Target)
+	data.Type = types.StringValue(string(alert.Type))
 	data.IsEnabled = types.BoolValue(alert.IsEnabled)
 	data.TriggerThreshold = types.Int64Value(int64(alert.TriggerThreshold))
 	data.RecoveryThreshold = types.Int64Value(int64(alert.RecoveryThreshold))
@@ -299,6 +860,37 @@ func (r *AlertResource) updateModelFromResponse(data *AlertResourceModel, alert
 	data.CreatedAt = types.StringValue(alert.CreatedAt)
 	data.UpdatedAt = types.StringValue(alert.UpdatedAt)
 
+	data.TargetWO = types.StringNull()
+	// Keep a write-only target out of state.
+	if alert.Target != "" && data.TargetWOVersion.IsNull() {
+		data.Target = types.StringValue(alert.Target)
+	}
+	if alert.IntegrationID != "" {
+		data.IntegrationID = types.StringValue(alert.IntegrationID)
+	}
+	data.Opsgenie = opsgenieFromClient(alert.Opsgenie, data.Opsgenie)
+	if alert.PhoneCountry != "" {
+		data.PhoneCountry = types.StringValue(alert.PhoneCountry)
+	}
+	if alert.SenderID != "" {
+		data.SenderID = types.StringValue(alert.SenderID)
+	}
+	if alert.PayloadTemplate != "" {
+		data.PayloadTemplate = types.StringValue(alert.PayloadTemplate)
+	}
+	if len(alert.Headers) > 0 {
+		data.Headers = stringMapValue(alert.Headers)
+	}
+	if alert.Severity != "" {
+		data.Severity = types.StringValue(string(alert.Severity))
+	}
+	if alert.ActiveHours != nil {
+		data.ActiveHours = activeHoursFromClient(alert.ActiveHours)
+	}
+	data.SuppressDuringMaintenance = types.BoolValue(alert.SuppressDuringMaintenance)
+	if len(alert.MaintenanceWindowIDs) > 0 {
+		data.MaintenanceWindowIDs = stringSetValue(alert.MaintenanceWindowIDs)
+	}
 	if alert.CustomMessage != "" {
 		data.CustomMessage = types.StringValue(alert.CustomMessage)
 	}
@@ -306,3 +898,65 @@ func (r *AlertResource) updateModelFromResponse(data *AlertResourceModel, alert
 		data.LastTriggeredAt = types.StringValue(alert.LastTriggeredAt)
 	}
 }
+
+func opsgenieToClient(m *OpsgenieModel) *client.OpsgenieConfig {
+	if m == nil {
+		return nil
+	}
+
+	return &client.OpsgenieConfig{
+		APIKey:   m.APIKey.ValueString(),
+		Team:     m.Team.ValueString(),
+		Region:   m.Region.ValueString(),
+		Priority: m.Priority.ValueString(),
+	}
+}
+
+// opsgenieFromClient converts the API settings to the model. The API key is
+// not returned by the API, so it is carried over from the prior model.
+func opsgenieFromClient(c *client.OpsgenieConfig, prior *OpsgenieModel) *OpsgenieModel {
+	if c == nil {
+		return nil
+	}
+
+	m := &OpsgenieModel{
+		APIKey:   types.StringNull(),
+		Team:     types.StringNull(),
+		Region:   types.StringValue("us"),
+		Priority: types.StringNull(),
+	}
+	if prior != nil {
+		m.APIKey = prior.APIKey
+	}
+	if c.Team != "" {
+		m.Team = types.StringValue(c.Team)
+	}
+	if c.Region != "" {
+		m.Region = types.StringValue(c.Region)
+	}
+	if c.Priority != "" {
+		m.Priority = types.StringValue(c.Priority)
+	}
+
+	return m
+}
+
+func activeHoursToClient(ctx context.Context, m *ActiveHoursModel, diags *diag.Diagnostics) *client.ActiveHours {
+	activeHours := &client.ActiveHours{
+		StartTime: m.StartTime.ValueString(),
+		EndTime:   m.EndTime.ValueString(),
+		Timezone:  m.Timezone.ValueString(),
+	}
+	diags.Append(m.Days.ElementsAs(ctx, &activeHours.Days, false)...)
+
+	return activeHours
+}
+
+func activeHoursFromClient(c *client.ActiveHours) *ActiveHoursModel {
+	return &ActiveHoursModel{
+		Days:      stringSetValue(c.Days),
+		StartTime: types.StringValue(c.StartTime),
+		EndTime:   types.StringValue(c.EndTime),
+		Timezone:  types.StringValue(cmp.Or(c.Timezone, "UTC")),
+	}
+}
@@ -0,0 +1,247 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ackack-io/terraform-provider-ackack/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AnnotationResource{}
+var _ resource.ResourceWithImportState = &AnnotationResource{}
+
+func NewAnnotationResource() resource.Resource {
+	return &AnnotationResource{}
+}
+
+// AnnotationResource defines the resource implementation.
+type AnnotationResource struct {
+	client *client.Client
+}
+
+// AnnotationResourceModel describes the resource data model.
+type AnnotationResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	MonitorID types.String `tfsdk:"monitor_id"`
+	Timestamp types.String `tfsdk:"timestamp"`
+	Text      types.String `tfsdk:"text"`
+	Link      types.String `tfsdk:"link"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+func (r *AnnotationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_annotation"
+}
+
+func (r *AnnotationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an annotation on a monitor's timeline on ackack.io, such as a deploy marker.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the annotation.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"monitor_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the monitor this annotation is attached to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"timestamp": schema.StringAttribute{
+				MarkdownDescription: "The time of the event in RFC 3339 format. Defaults to the time the annotation is created.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"text": schema.StringAttribute{
+				MarkdownDescription: "The text displayed on the timeline, e.g. the deployed version.",
+				Required:            true,
+			},
+			"link": schema.StringAttribute{
+				MarkdownDescription: "An optional URL with more details, e.g. a release or pipeline page.",
+				Optional:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the annotation was created.",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the annotation was last updated.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *AnnotationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	requireFeature(c, "annotations", "Annotations", &resp.Diagnostics)
+
+	r.client = c
+}
+
+func (r *AnnotationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
+	var data AnnotationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createReq := client.CreateAnnotationRequest{
+		MonitorID: data.MonitorID.ValueString(),
+		Text:      data.Text.ValueString(),
+	}
+
+	if !data.Timestamp.IsNull() && !data.Timestamp.IsUnknown() {
+		createReq.Timestamp = data.Timestamp.ValueString()
+	}
+	if !data.Link.IsNull() {
+		createReq.Link = data.Link.ValueString()
+	}
+
+	annotation, err := r.client.CreateAnnotation(ctx, createReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create annotation, got error: %s", err))
+		return
+	}
+
+	r.updateModelFromResponse(&data, annotation)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AnnotationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
+	var data AnnotationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	annotation, err := r.client.GetAnnotation(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read annotation, got error: %s", err))
+		return
+	}
+
+	r.updateModelFromResponse(&data, annotation)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AnnotationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
+	var data AnnotationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A null link is sent as empty, which removes it.
+	updateReq := client.UpdateAnnotationRequest{
+		Text: data.Text.ValueString(),
+		Link: data.Link.ValueString(),
+	}
+
+	if !data.Timestamp.IsNull() && !data.Timestamp.IsUnknown() {
+		updateReq.Timestamp = data.Timestamp.ValueString()
+	}
+
+	annotation, err := r.client.UpdateAnnotation(ctx, data.ID.ValueString(), updateReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update annotation, got error: %s", err))
+		return
+	}
+
+	r.updateModelFromResponse(&data, annotation)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AnnotationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
+	var data AnnotationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteAnnotation(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFoundError(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete annotation, got error: %s", err))
+		return
+	}
+}
+
+func (r *AnnotationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *AnnotationResource) updateModelFromResponse(data *AnnotationResourceModel, annotation *client.Annotation) {
+	data.ID = types.StringValue(annotation.ID)
+	data.MonitorID = types.StringValue(annotation.MonitorID)
+	data.Text = types.StringValue(annotation.Text)
+	data.CreatedAt = types.StringValue(annotation.CreatedAt)
+	data.UpdatedAt = types.StringValue(annotation.UpdatedAt)
+
+	// Keep the configured timestamp as written; the API may return it with
+	// a different precision.
+	if data.Timestamp.IsNull() || data.Timestamp.IsUnknown() {
+		data.Timestamp = types.StringValue(normalizeTimestamp(annotation.Timestamp))
+	}
+	if annotation.Link != "" {
+		data.Link = types.StringValue(annotation.Link)
+	} else {
+		data.Link = types.StringNull()
+	}
+}
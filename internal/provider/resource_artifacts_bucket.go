@@ -0,0 +1,305 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ackack-io/terraform-provider-ackack/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ArtifactsBucketResource{}
+var _ resource.ResourceWithImportState = &ArtifactsBucketResource{}
+
+func NewArtifactsBucketResource() resource.Resource {
+	return &ArtifactsBucketResource{}
+}
+
+// ArtifactsBucketResource defines the resource implementation.
+type ArtifactsBucketResource struct {
+	client *client.Client
+}
+
+// ArtifactsBucketResourceModel describes the resource data model.
+type ArtifactsBucketResourceModel struct {
+	ID                    types.String `tfsdk:"id"`
+	Bucket                types.String `tfsdk:"bucket"`
+	Endpoint              types.String `tfsdk:"endpoint"`
+	Region                types.String `tfsdk:"region"`
+	PathPrefix            types.String `tfsdk:"path_prefix"`
+	ArtifactRetentionDays types.Int64  `tfsdk:"artifact_retention_days"`
+	AccessKeyIDWO         types.String `tfsdk:"access_key_id_wo"`
+	SecretAccessKeyWO     types.String `tfsdk:"secret_access_key_wo"`
+	CredentialsWOVersion  types.Int64  `tfsdk:"credentials_wo_version"`
+	AccessKeyIDHint       types.String `tfsdk:"access_key_id_hint"`
+	CreatedAt             types.String `tfsdk:"created_at"`
+	UpdatedAt             types.String `tfsdk:"updated_at"`
+}
+
+func (r *ArtifactsBucketResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_artifacts_bucket"
+}
+
+func (r *ArtifactsBucketResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an S3-compatible bucket on ackack.io where failure artifacts, such as screenshots and HAR files, are stored. " +
+			"The credentials are write-only and are never stored in Terraform state. Requires Terraform 1.11 or later.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the bucket configuration.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"bucket": schema.StringAttribute{
+				MarkdownDescription: "The name of the bucket.",
+				Required:            true,
+			},
+			"endpoint": schema.StringAttribute{
+				MarkdownDescription: "The endpoint URL of the S3-compatible service, e.g. `https://storage.googleapis.com`. Omit for AWS S3.",
+				Optional:            true,
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "The region of the bucket.",
+				Optional:            true,
+			},
+			"path_prefix": schema.StringAttribute{
+				MarkdownDescription: "A key prefix under which artifacts are written, e.g. `ackack/`.",
+				Optional:            true,
+			},
+			"artifact_retention_days": schema.Int64Attribute{
+				MarkdownDescription: "How many days artifacts are kept before they are deleted from the bucket. When omitted, artifacts are kept indefinitely.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"access_key_id_wo": schema.StringAttribute{
+				MarkdownDescription: "The access key ID used to write to the bucket. This value is write-only and is only sent " +
+					"to the API on create, or on update when `credentials_wo_version` changes.",
+				Required:  true,
+				Sensitive: true,
+				WriteOnly: true,
+			},
+			"secret_access_key_wo": schema.StringAttribute{
+				MarkdownDescription: "The secret access key used to write to the bucket. This value is write-only and is only sent " +
+					"to the API on create, or on update when `credentials_wo_version` changes.",
+				Required:  true,
+				Sensitive: true,
+				WriteOnly: true,
+			},
+			"credentials_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Change this value to send updated `access_key_id_wo` and `secret_access_key_wo` to the API.",
+				Optional:            true,
+			},
+			"access_key_id_hint": schema.StringAttribute{
+				MarkdownDescription: "The last characters of the stored access key ID, for identification.",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the bucket was configured.",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the bucket configuration was last updated.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *ArtifactsBucketResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	requireFeature(c, "artifacts", "Failure artifacts", &resp.Diagnostics)
+
+	r.client = c
+}
+
+func (r *ArtifactsBucketResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
+	var data ArtifactsBucketResourceModel
+	var accessKeyID, secretAccessKey types.String
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only values are only available in the configuration.
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("access_key_id_wo"), &accessKeyID)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("secret_access_key_wo"), &secretAccessKey)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createReq := client.CreateArtifactsBucketRequest{
+		Bucket:          data.Bucket.ValueString(),
+		Endpoint:        data.Endpoint.ValueString(),
+		Region:          data.Region.ValueString(),
+		PathPrefix:      data.PathPrefix.ValueString(),
+		AccessKeyID:     accessKeyID.ValueString(),
+		SecretAccessKey: secretAccessKey.ValueString(),
+	}
+
+	if !data.ArtifactRetentionDays.IsNull() {
+		createReq.ArtifactRetentionDays = int(data.ArtifactRetentionDays.ValueInt64())
+	}
+
+	bucket, err := r.client.CreateArtifactsBucket(ctx, createReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create artifacts bucket, got error: %s", err))
+		return
+	}
+
+	r.updateModelFromResponse(&data, bucket)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ArtifactsBucketResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
+	var data ArtifactsBucketResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucket, err := r.client.GetArtifactsBucket(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read artifacts bucket, got error: %s", err))
+		return
+	}
+
+	r.updateModelFromResponse(&data, bucket)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ArtifactsBucketResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
+	var data ArtifactsBucketResourceModel
+	var state ArtifactsBucketResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateReq := client.UpdateArtifactsBucketRequest{
+		Bucket:     data.Bucket.ValueString(),
+		Endpoint:   data.Endpoint.ValueString(),
+		Region:     data.Region.ValueString(),
+		PathPrefix: data.PathPrefix.ValueString(),
+	}
+
+	if !data.ArtifactRetentionDays.IsNull() {
+		updateReq.ArtifactRetentionDays = int(data.ArtifactRetentionDays.ValueInt64())
+	}
+
+	// Only resend the credentials when the practitioner bumps the version.
+	if !data.CredentialsWOVersion.Equal(state.CredentialsWOVersion) {
+		var accessKeyID, secretAccessKey types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("access_key_id_wo"), &accessKeyID)...)
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("secret_access_key_wo"), &secretAccessKey)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		updateReq.AccessKeyID = accessKeyID.ValueString()
+		updateReq.SecretAccessKey = secretAccessKey.ValueString()
+	}
+
+	bucket, err := r.client.UpdateArtifactsBucket(ctx, data.ID.ValueString(), updateReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update artifacts bucket, got error: %s", err))
+		return
+	}
+
+	r.updateModelFromResponse(&data, bucket)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ArtifactsBucketResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
+	var data ArtifactsBucketResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteArtifactsBucket(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFoundError(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete artifacts bucket, got error: %s", err))
+		return
+	}
+}
+
+func (r *ArtifactsBucketResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *ArtifactsBucketResource) updateModelFromResponse(data *ArtifactsBucketResourceModel, bucket *client.ArtifactsBucket) {
+	data.ID = types.StringValue(bucket.ID)
+	data.Bucket = types.StringValue(bucket.Bucket)
+	data.AccessKeyIDWO = types.StringNull()
+	data.SecretAccessKeyWO = types.StringNull()
+	data.CreatedAt = types.StringValue(bucket.CreatedAt)
+	data.UpdatedAt = types.StringValue(bucket.UpdatedAt)
+
+	if bucket.Endpoint != "" {
+		data.Endpoint = types.StringValue(bucket.Endpoint)
+	}
+	if bucket.Region != "" {
+		data.Region = types.StringValue(bucket.Region)
+	}
+	if bucket.PathPrefix != "" {
+		data.PathPrefix = types.StringValue(bucket.PathPrefix)
+	}
+	if bucket.ArtifactRetentionDays != 0 {
+		data.ArtifactRetentionDays = types.Int64Value(int64(bucket.ArtifactRetentionDays))
+	}
+	if bucket.AccessKeyIDHint != "" {
+		data.AccessKeyIDHint = types.StringValue(bucket.AccessKeyIDHint)
+	} else {
+		data.AccessKeyIDHint = types.StringNull()
+	}
+}
@@ -0,0 +1,514 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/ackack-io/terraform-provider-ackack/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &BrowserMonitorResource{}
+var _ resource.ResourceWithImportState = &BrowserMonitorResource{}
+var _ resource.ResourceWithConfigValidators = &BrowserMonitorResource{}
+var _ resource.ResourceWithValidateConfig = &BrowserMonitorResource{}
+
+// browserStepActions lists the supported browser scenario actions.
+var browserStepActions = []string{"navigate", "click", "fill", "press", "wait_for", "assert_text", "assert_visible"}
+
+// browserStepActionsWithSelector lists the actions that act on an element.
+var browserStepActionsWithSelector = []string{"click", "fill", "wait_for", "assert_text", "assert_visible"}
+
+// browserStepActionsWithValue lists the actions that take a value.
+var browserStepActionsWithValue = []string{"navigate", "fill", "press", "assert_text"}
+
+func NewBrowserMonitorResource() resource.Resource {
+	return &BrowserMonitorResource{}
+}
+
+// BrowserMonitorResource defines the resource implementation.
+type BrowserMonitorResource struct {
+	client *client.Client
+}
+
+// BrowserMonitorResourceModel describes the resource data model.
+type BrowserMonitorResourceModel struct {
+	ID                    types.String          `tfsdk:"id"`
+	Name                  types.String          `tfsdk:"name"`
+	IsEnabled             types.Bool            `tfsdk:"is_enabled"`
+	FrequencySeconds      types.Int64           `tfsdk:"frequency_seconds"`
+	TimeoutMs             types.Int64           `tfsdk:"timeout_ms"`
+	GeneralRegion         types.String          `tfsdk:"general_region"`
+	StartURL              types.String          `tfsdk:"start_url"`
+	Steps                 []BrowserStepModel    `tfsdk:"steps"`
+	RecordingJSON         types.String          `tfsdk:"recording_json"`
+	Viewport              *BrowserViewportModel `tfsdk:"viewport"`
+	Device                types.String          `tfsdk:"device"`
+	ScreenshotOnFailure   types.Bool            `tfsdk:"screenshot_on_failure"`
+	CaptureHAR            types.Bool            `tfsdk:"capture_har"`
+	ArtifactRetentionDays types.Int64           `tfsdk:"artifact_retention_days"`
+	Status                types.String          `tfsdk:"status"`
+	LastChecked           types.String          `tfsdk:"last_checked"`
+	CreatedAt             types.String          `tfsdk:"created_at"`
+	UpdatedAt             types.String          `tfsdk:"updated_at"`
+}
+
+// BrowserStepModel describes a single scenario step.
+type BrowserStepModel struct {
+	Action    types.String `tfsdk:"action"`
+	Selector  types.String `tfsdk:"selector"`
+	Value     types.String `tfsdk:"value"`
+	TimeoutMs types.Int64  `tfsdk:"timeout_ms"`
+}
+
+// BrowserViewportModel describes the browser window size.
+type BrowserViewportModel struct {
+	Width  types.Int64 `tfsdk:"width"`
+	Height types.Int64 `tfsdk:"height"`
+}
+
+func (r *BrowserMonitorResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_browser_monitor"
+}
+
+func (r *BrowserMonitorResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a browser synthetic monitor on ackack.io, which runs a scripted scenario in a real browser. " +
+			"The scenario is either a list of `steps` or a recording exported from the ackack recorder.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the browser monitor.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the browser monitor.",
+				Required:            true,
+			},
+			"is_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the browser monitor is enabled. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"frequency_seconds": schema.Int64Attribute{
+				MarkdownDescription: "How often to run the scenario, in seconds. Defaults to `300`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(300),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
+			},
+			"timeout_ms": schema.Int64Attribute{
+				MarkdownDescription: "Timeout for the whole scenario, in milliseconds. Defaults to `60000`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(60000),
+			},
+			"general_region": schema.StringAttribute{
+				MarkdownDescription: "The general region for monitoring (e.g., `us`, `eu`, `asia`).",
+				Optional:            true,
+				Computed:            true,
+			},
+			"start_url": schema.StringAttribute{
+				MarkdownDescription: "The URL the browser opens before the scenario starts.",
+				Required:            true,
+				Validators: []validator.String{
+					httpURL(),
+				},
+			},
+			"steps": schema.ListNestedAttribute{
+				MarkdownDescription: "The scenario as Playwright-style steps, run in order. Exactly one of `steps` or `recording_json` must be set.",
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"action": schema.StringAttribute{
+							MarkdownDescription: "The action to perform. Valid values: `navigate`, `click`, `fill`, `press`, `wait_for`, `assert_text`, `assert_visible`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(browserStepActions...),
+							},
+						},
+						"selector": schema.StringAttribute{
+							MarkdownDescription: "The CSS or text selector of the element to act on. Required for `click`, `fill`, `wait_for`, `assert_text` and `assert_visible`.",
+							Optional:            true,
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "The URL for `navigate`, the text for `fill` and `assert_text`, or the key for `press`.",
+							Optional:            true,
+						},
+						"timeout_ms": schema.Int64Attribute{
+							MarkdownDescription: "How long the step may take, in milliseconds. When omitted, the API default is used.",
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"recording_json": schema.StringAttribute{
+				MarkdownDescription: "The scenario as a recording in JSON, e.g. `file(\"checkout.recording.json\")`. Exactly one of `steps` or `recording_json` must be set.",
+				Optional:            true,
+			},
+			"viewport": schema.SingleNestedAttribute{
+				MarkdownDescription: "The browser window size. Conflicts with `device`.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"width": schema.Int64Attribute{
+						MarkdownDescription: "The width in pixels.",
+						Required:            true,
+						Validators: []validator.Int64{
+							int64validator.Between(320, 3840),
+						},
+					},
+					"height": schema.Int64Attribute{
+						MarkdownDescription: "The height in pixels.",
+						Required:            true,
+						Validators: []validator.Int64{
+							int64validator.Between(320, 2160),
+						},
+					},
+				},
+			},
+			"device": schema.StringAttribute{
+				MarkdownDescription: "A device to emulate, e.g. `iPhone 15` or `Pixel 7`, which sets the viewport and user agent. Conflicts with `viewport`.",
+				Optional:            true,
+			},
+			"screenshot_on_failure": schema.BoolAttribute{
+				MarkdownDescription: "Whether to capture a screenshot when the scenario fails. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"capture_har": schema.BoolAttribute{
+				MarkdownDescription: "Whether to capture a HAR file of the network traffic when the scenario fails. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"artifact_retention_days": schema.Int64Attribute{
+				MarkdownDescription: "How many days failure artifacts of this monitor are kept. When omitted, the retention of the `ackack_artifacts_bucket` or the API default applies.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The current status of the browser monitor.",
+				Computed:            true,
+			},
+			"last_checked": schema.StringAttribute{
+				MarkdownDescription: "The timestamp of the last run.",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the browser monitor was created.",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the browser monitor was last updated.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *BrowserMonitorResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("steps"),
+			path.MatchRoot("recording_json"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("viewport"),
+			path.MatchRoot("device"),
+		),
+	}
+}
+
+func (r *BrowserMonitorResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var recording types.String
+	var steps types.List
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("recording_json"), &recording)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("steps"), &steps)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !recording.IsNull() && !recording.IsUnknown() && !json.Valid([]byte(recording.ValueString())) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("recording_json"),
+			"Invalid Recording",
+			"The recording_json value must be valid JSON.",
+		)
+	}
+
+	if steps.IsNull() || steps.IsUnknown() {
+		return
+	}
+
+	var stepModels []BrowserStepModel
+	resp.Diagnostics.Append(steps.ElementsAs(ctx, &stepModels, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, step := range stepModels {
+		if step.Action.IsUnknown() {
+			continue
+		}
+		action := step.Action.ValueString()
+		if slices.Contains(browserStepActionsWithSelector, action) && step.Selector.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("steps").AtListIndex(i).AtName("selector"),
+				"Missing Step Selector",
+				fmt.Sprintf("The %s action requires a selector.", action),
+			)
+		}
+		if slices.Contains(browserStepActionsWithValue, action) && step.Value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("steps").AtListIndex(i).AtName("value"),
+				"Missing Step Value",
+				fmt.Sprintf("The %s action requires a value.", action),
+			)
+		}
+	}
+}
+
+func (r *BrowserMonitorResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	requireFeature(c, "browser_monitors", "Browser monitors", &resp.Diagnostics)
+
+	r.client = c
+}
+
+func (r *BrowserMonitorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
+	var data BrowserMonitorResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	monitor, err := r.client.CreateBrowserMonitor(ctx, r.buildRequest(&data))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create browser monitor, got error: %s", err))
+		return
+	}
+
+	r.updateModelFromResponse(&data, monitor)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BrowserMonitorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
+	var data BrowserMonitorResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	monitor, err := r.client.GetBrowserMonitor(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read browser monitor, got error: %s", err))
+		return
+	}
+
+	r.updateModelFromResponse(&data, monitor)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BrowserMonitorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
+	var data BrowserMonitorResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	monitor, err := r.client.UpdateBrowserMonitor(ctx, data.ID.ValueString(), r.buildRequest(&data))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update browser monitor, got error: %s", err))
+		return
+	}
+
+	r.updateModelFromResponse(&data, monitor)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BrowserMonitorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
+	var data BrowserMonitorResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteBrowserMonitor(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFoundError(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete browser monitor, got error: %s", err))
+		return
+	}
+}
+
+func (r *BrowserMonitorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *BrowserMonitorResource) buildRequest(data *BrowserMonitorResourceModel) client.BrowserMonitorRequest {
+	isEnabled := data.IsEnabled.ValueBool()
+	screenshotOnFailure := data.ScreenshotOnFailure.ValueBool()
+	captureHAR := data.CaptureHAR.ValueBool()
+
+	req := client.BrowserMonitorRequest{
+		Name:                data.Name.ValueString(),
+		IsEnabled:           &isEnabled,
+		FrequencySeconds:    int(data.FrequencySeconds.ValueInt64()),
+		TimeoutMs:           int(data.TimeoutMs.ValueInt64()),
+		StartURL:            data.StartURL.ValueString(),
+		ScreenshotOnFailure: &screenshotOnFailure,
+		CaptureHAR:          &captureHAR,
+	}
+
+	if !data.GeneralRegion.IsNull() && !data.GeneralRegion.IsUnknown() {
+		req.GeneralRegion = data.GeneralRegion.ValueString()
+	}
+	for _, step := range data.Steps {
+		req.Steps = append(req.Steps, client.BrowserStep{
+			Action:    step.Action.ValueString(),
+			Selector:  step.Selector.ValueString(),
+			Value:     step.Value.ValueString(),
+			TimeoutMs: int(step.TimeoutMs.ValueInt64()),
+		})
+	}
+	if !data.RecordingJSON.IsNull() {
+		req.Recording = data.RecordingJSON.ValueString()
+	}
+	if data.Viewport != nil {
+		req.Viewport = &client.BrowserViewport{
+			Width:  int(data.Viewport.Width.ValueInt64()),
+			Height: int(data.Viewport.Height.ValueInt64()),
+		}
+	}
+	if !data.Device.IsNull() {
+		req.Device = data.Device.ValueString()
+	}
+	if !data.ArtifactRetentionDays.IsNull() {
+		req.ArtifactRetentionDays = int(data.ArtifactRetentionDays.ValueInt64())
+	}
+
+	return req
+}
+
+func (r *BrowserMonitorResource) updateModelFromResponse(data *BrowserMonitorResourceModel, monitor *client.BrowserMonitor) {
+	data.ID = types.StringValue(monitor.ID)
+	data.Name = types.StringValue(monitor.Name)
+	data.IsEnabled = types.BoolValue(monitor.IsEnabled)
+	data.FrequencySeconds = types.Int64Value(int64(monitor.FrequencySeconds))
+	data.TimeoutMs = types.Int64Value(int64(monitor.TimeoutMs))
+	data.GeneralRegion = types.StringValue(monitor.GeneralRegion)
+	data.StartURL = types.StringValue(monitor.StartURL)
+	data.ScreenshotOnFailure = types.BoolValue(monitor.ScreenshotOnFailure)
+	data.CaptureHAR = types.BoolValue(monitor.CaptureHAR)
+	data.Status = types.StringValue(monitor.Status)
+	data.CreatedAt = types.StringValue(monitor.CreatedAt)
+	data.UpdatedAt = types.StringValue(monitor.UpdatedAt)
+
+	// Keep the recording as configured; the API may reformat the JSON.
+	if data.RecordingJSON.IsNull() && monitor.Recording != "" {
+		data.RecordingJSON = types.StringValue(monitor.Recording)
+	}
+	if len(monitor.Steps) > 0 {
+		data.Steps = make([]BrowserStepModel, 0, len(monitor.Steps))
+		for _, step := range monitor.Steps {
+			item := BrowserStepModel{
+				Action: types.StringValue(step.Action),
+			}
+			if step.Selector != "" {
+				item.Selector = types.StringValue(step.Selector)
+			}
+			if step.Value != "" {
+				item.Value = types.StringValue(step.Value)
+			}
+			if step.TimeoutMs != 0 {
+				item.TimeoutMs = types.Int64Value(int64(step.TimeoutMs))
+			}
+			data.Steps = append(data.Steps, item)
+		}
+	}
+	if monitor.Viewport != nil && data.Device.IsNull() {
+		data.Viewport = &BrowserViewportModel{
+			Width:  types.Int64Value(int64(monitor.Viewport.Width)),
+			Height: types.Int64Value(int64(monitor.Viewport.Height)),
+		}
+	}
+	if monitor.Device != "" {
+		data.Device = types.StringValue(monitor.Device)
+	}
+	if monitor.ArtifactRetentionDays != 0 {
+		data.ArtifactRetentionDays = types.Int64Value(int64(monitor.ArtifactRetentionDays))
+	}
+	if monitor.LastChecked != "" {
+		data.LastChecked = types.StringValue(monitor.LastChecked)
+	} else {
+		data.LastChecked = types.StringNull()
+	}
+}
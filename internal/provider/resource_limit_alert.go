@@ -0,0 +1,302 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ackack-io/terraform-provider-ackack/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &LimitAlertResource{}
+var _ resource.ResourceWithImportState = &LimitAlertResource{}
+var _ resource.ResourceWithModifyPlan = &LimitAlertResource{}
+
+// limitAlertTypes lists the alert types that can notify on account limits.
+var limitAlertTypes = []client.AlertType{client.AlertTypeEmail, client.AlertTypeWebhook, client.AlertTypeDiscord, client.AlertTypeSlack}
+
+// limitAlertEvents lists the account limit events a limit alert can notify on.
+var limitAlertEvents = []string{"in_flight_limit", "monitor_quota", "alert_quota"}
+
+func NewLimitAlertResource() resource.Resource {
+	return &LimitAlertResource{}
+}
+
+// LimitAlertResource defines the resource implementation.
+type LimitAlertResource struct {
+	client *client.Client
+}
+
+// LimitAlertResourceModel describes the resource data model.
+type LimitAlertResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Type               types.String `tfsdk:"type"`
+	Target             types.String `tfsdk:"target"`
+	Events             types.Set    `tfsdk:"events"`
+	IsEnabled          types.Bool   `tfsdk:"is_enabled"`
+	MinIntervalMinutes types.Int64  `tfsdk:"min_interval_minutes"`
+	LastTriggeredAt    types.String `tfsdk:"last_triggered_at"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+	UpdatedAt          types.String `tfsdk:"updated_at"`
+}
+
+func (r *LimitAlertResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_limit_alert"
+}
+
+func (r *LimitAlertResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an account-level alert on ackack.io that notifies a channel when the account reaches a plan limit, " +
+			"such as the in-flight check limit or the monitor quota, so capacity problems don't go unnoticed.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the limit alert.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of channel. Valid values: `email`, `webhook`, `discord`, `slack`. Changing this forces a new limit alert.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.Strings(limitAlertTypes)...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target": schema.StringAttribute{
+				MarkdownDescription: "The email address or webhook URL to notify.",
+				Required:            true,
+			},
+			"events": schema.SetAttribute{
+				MarkdownDescription: "The limit events to notify on. Valid values: `in_flight_limit` (checks are delayed because too many are " +
+					"in progress), `monitor_quota` and `alert_quota` (the plan's monitor or alert count is reached).",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(limitAlertEvents...)),
+				},
+			},
+			"is_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the limit alert is enabled. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"min_interval_minutes": schema.Int64Attribute{
+				MarkdownDescription: "Minimum minutes between notifications for the same event. When omitted, the API default is used.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"last_triggered_at": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the limit alert last sent a notification.",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the limit alert was created.",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the limit alert was last updated.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *LimitAlertResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *LimitAlertResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
+	var data LimitAlertResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	isEnabled := data.IsEnabled.ValueBool()
+	createReq := client.CreateLimitAlertRequest{
+		Type:      data.Type.ValueString(),
+		Target:    data.Target.ValueString(),
+		IsEnabled: &isEnabled,
+	}
+
+	resp.Diagnostics.Append(data.Events.ElementsAs(ctx, &createReq.Events, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !data.MinIntervalMinutes.IsNull() {
+		createReq.MinIntervalMinutes = int(data.MinIntervalMinutes.ValueInt64())
+	}
+
+	alert, err := r.client.CreateLimitAlert(ctx, createReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create limit alert, got error: %s", err))
+		return
+	}
+
+	r.updateModelFromResponse(&data, alert)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *LimitAlertResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
+	var data LimitAlertResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alert, err := r.client.GetLimitAlert(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read limit alert, got error: %s", err))
+		return
+	}
+
+	r.updateModelFromResponse(&data, alert)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *LimitAlertResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
+	var data LimitAlertResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	isEnabled := data.IsEnabled.ValueBool()
+	updateReq := client.UpdateLimitAlertRequest{
+		Target:    data.Target.ValueString(),
+		IsEnabled: &isEnabled,
+	}
+
+	resp.Diagnostics.Append(data.Events.ElementsAs(ctx, &updateReq.Events, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !data.MinIntervalMinutes.IsNull() {
+		updateReq.MinIntervalMinutes = int(data.MinIntervalMinutes.ValueInt64())
+	}
+
+	alert, err := r.client.UpdateLimitAlert(ctx, data.ID.ValueString(), updateReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update limit alert, got error: %s", err))
+		return
+	}
+
+	r.updateModelFromResponse(&data, alert)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *LimitAlertResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
+	var data LimitAlertResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteLimitAlert(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFoundError(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete limit alert, got error: %s", err))
+		return
+	}
+}
+
+func (r *LimitAlertResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var alertType, target types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("type"), &alertType)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("target"), &target)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checkWebhookAllowlist(r.client.AllowedWebhookDomains, alertType, target, path.Root("target"), &resp.Diagnostics)
+}
+
+func (r *LimitAlertResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *LimitAlertResource) updateModelFromResponse(data *LimitAlertResourceModel, alert *client.LimitAlert) {
+	data.ID = types.StringValue(alert.ID)
+	data.Type = types.StringValue(alert.Type)
+	data.Target = types.StringValue(alert.Target)
+	data.IsEnabled = types.BoolValue(alert.IsEnabled)
+	data.CreatedAt = types.StringValue(alert.CreatedAt)
+	data.UpdatedAt = types.StringValue(alert.UpdatedAt)
+
+	if len(alert.Events) > 0 {
+		data.Events = stringSetValue(alert.Events)
+	}
+	if alert.MinIntervalMinutes != 0 {
+		data.MinIntervalMinutes = types.Int64Value(int64(alert.MinIntervalMinutes))
+	}
+	if alert.LastTriggeredAt != "" {
+		data.LastTriggeredAt = types.StringValue(alert.LastTriggeredAt)
+	} else {
+		data.LastTriggeredAt = types.StringNull()
+	}
+}
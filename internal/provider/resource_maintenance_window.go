@@ -0,0 +1,359 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/ackack-io/terraform-provider-ackack/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &MaintenanceWindowResource{}
+var _ resource.ResourceWithImportState = &MaintenanceWindowResource{}
+var _ resource.ResourceWithConfigValidators = &MaintenanceWindowResource{}
+var _ resource.ResourceWithValidateConfig = &MaintenanceWindowResource{}
+
+func NewMaintenanceWindowResource() resource.Resource {
+	return &MaintenanceWindowResource{}
+}
+
+// MaintenanceWindowResource defines the resource implementation.
+type MaintenanceWindowResource struct {
+	client *client.Client
+}
+
+// MaintenanceWindowResourceModel describes the resource data model.
+type MaintenanceWindowResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Description         types.String `tfsdk:"description"`
+	StartTime           types.String `tfsdk:"start_time"`
+	EndTime             types.String `tfsdk:"end_time"`
+	MonitorIDs          types.Set    `tfsdk:"monitor_ids"`
+	SystemIDs           types.Set    `tfsdk:"system_ids"`
+	EffectiveMonitorIDs types.Set    `tfsdk:"effective_monitor_ids"`
+	CreatedAt           types.String `tfsdk:"created_at"`
+	UpdatedAt           types.String `tfsdk:"updated_at"`
+}
+
+func (r *MaintenanceWindowResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_maintenance_window"
+}
+
+func (r *MaintenanceWindowResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a scheduled maintenance window on ackack.io. A window can target monitors directly and whole systems, " +
+			"which cover every monitor that is a member of the system.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the maintenance window.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the maintenance window.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "A description of the planned work.",
+				Optional:            true,
+			},
+			"start_time": schema.StringAttribute{
+				MarkdownDescription: "When the window starts, in RFC 3339 format.",
+				Required:            true,
+			},
+			"end_time": schema.StringAttribute{
+				MarkdownDescription: "When the window ends, in RFC 3339 format. Must be after `start_time`.",
+				Required:            true,
+			},
+			"monitor_ids": schema.SetAttribute{
+				MarkdownDescription: "The IDs of monitors covered by the window.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"system_ids": schema.SetAttribute{
+				MarkdownDescription: "The IDs of systems covered by the window. Every monitor in these systems is covered.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"effective_monitor_ids": schema.SetAttribute{
+				MarkdownDescription: "Every monitor covered by the window: `monitor_ids` plus the current members of `system_ids`. " +
+					"Refreshed on every read, so it reflects system membership changes made after the window was created.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the maintenance window was created.",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the maintenance window was last updated.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *MaintenanceWindowResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("monitor_ids"),
+			path.MatchRoot("system_ids"),
+		),
+	}
+}
+
+func (r *MaintenanceWindowResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data MaintenanceWindowResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	start := parseTimeAttribute(path.Root("start_time"), data.StartTime, &resp.Diagnostics)
+	end := parseTimeAttribute(path.Root("end_time"), data.EndTime, &resp.Diagnostics)
+	if start.IsZero() || end.IsZero() {
+		return
+	}
+
+	if !end.After(start) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("end_time"),
+			"Invalid Maintenance Window",
+			fmt.Sprintf("The end_time (%s) must be after start_time (%s).", data.EndTime.ValueString(), data.StartTime.ValueString()),
+		)
+	}
+}
+
+func (r *MaintenanceWindowResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	requireFeature(c, "maintenance_windows", "Maintenance windows", &resp.Diagnostics)
+
+	r.client = c
+}
+
+func (r *MaintenanceWindowResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
+	var data MaintenanceWindowResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createReq := client.CreateMaintenanceWindowRequest{
+		Name:       data.Name.ValueString(),
+		StartTime:  data.StartTime.ValueString(),
+		EndTime:    data.EndTime.ValueString(),
+		MonitorIDs: []string{},
+		SystemIDs:  []string{},
+	}
+
+	if !data.Description.IsNull() {
+		createReq.Description = data.Description.ValueString()
+	}
+	if !data.MonitorIDs.IsNull() {
+		resp.Diagnostics.Append(data.MonitorIDs.ElementsAs(ctx, &createReq.MonitorIDs, false)...)
+	}
+	if !data.SystemIDs.IsNull() {
+		resp.Diagnostics.Append(data.SystemIDs.ElementsAs(ctx, &createReq.SystemIDs, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	window, err := r.client.CreateMaintenanceWindow(ctx, createReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create maintenance window, got error: %s", err))
+		return
+	}
+
+	r.updateModelFromResponse(ctx, &data, window, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MaintenanceWindowResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
+	var data MaintenanceWindowResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	window, err := r.client.GetMaintenanceWindow(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read maintenance window, got error: %s", err))
+		return
+	}
+
+	r.updateModelFromResponse(ctx, &data, window, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MaintenanceWindowResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
+	var data MaintenanceWindowResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateReq := client.UpdateMaintenanceWindowRequest{
+		Name:       data.Name.ValueString(),
+		StartTime:  data.StartTime.ValueString(),
+		EndTime:    data.EndTime.ValueString(),
+		MonitorIDs: []string{},
+		SystemIDs:  []string{},
+	}
+
+	if !data.Description.IsNull() {
+		updateReq.Description = data.Description.ValueString()
+	}
+	if !data.MonitorIDs.IsNull() {
+		resp.Diagnostics.Append(data.MonitorIDs.ElementsAs(ctx, &updateReq.MonitorIDs, false)...)
+	}
+	if !data.SystemIDs.IsNull() {
+		resp.Diagnostics.Append(data.SystemIDs.ElementsAs(ctx, &updateReq.SystemIDs, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	window, err := r.client.UpdateMaintenanceWindow(ctx, data.ID.ValueString(), updateReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update maintenance window, got error: %s", err))
+		return
+	}
+
+	r.updateModelFromResponse(ctx, &data, window, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MaintenanceWindowResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
+	var data MaintenanceWindowResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteMaintenanceWindow(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFoundError(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete maintenance window, got error: %s", err))
+		return
+	}
+}
+
+func (r *MaintenanceWindowResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *MaintenanceWindowResource) updateModelFromResponse(ctx context.Context, data *MaintenanceWindowResourceModel, window *client.MaintenanceWindow, diags *diag.Diagnostics) {
+	data.ID = types.StringValue(window.ID)
+	data.Name = types.StringValue(window.Name)
+	data.CreatedAt = types.StringValue(window.CreatedAt)
+	data.UpdatedAt = types.StringValue(window.UpdatedAt)
+
+	// Keep the configured times as written; the API may return them with
+	// a different precision or offset.
+	if data.StartTime.IsNull() || data.StartTime.IsUnknown() {
+		data.StartTime = types.StringValue(normalizeTimestamp(window.StartTime))
+	}
+	if data.EndTime.IsNull() || data.EndTime.IsUnknown() {
+		data.EndTime = types.StringValue(normalizeTimestamp(window.EndTime))
+	}
+	if window.Description != "" {
+		data.Description = types.StringValue(window.Description)
+	}
+	if len(window.MonitorIDs) > 0 {
+		data.MonitorIDs = stringSetValue(window.MonitorIDs)
+	}
+	if len(window.SystemIDs) > 0 {
+		data.SystemIDs = stringSetValue(window.SystemIDs)
+	}
+
+	// Expand systems to their current members so the blast radius of the
+	// window can be reviewed before it starts.
+	effective := slices.Clone(window.MonitorIDs)
+	for _, systemID := range window.SystemIDs {
+		monitorIDs, err := r.client.ListSystemMonitorIDs(ctx, systemID)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to read monitors of system %s, got error: %s", systemID, err))
+			return
+		}
+		effective = append(effective, monitorIDs...)
+	}
+	slices.Sort(effective)
+	data.EffectiveMonitorIDs = stringSetValue(slices.Compact(effective))
+}
+
+// parseTimeAttribute parses a known RFC 3339 attribute value, adding an
+// attribute error when it is malformed. It returns the zero time when the
+// value is null, unknown or invalid.
+func parseTimeAttribute(p path.Path, value types.String, diags *diag.Diagnostics) time.Time {
+	if value.IsNull() || value.IsUnknown() {
+		return time.Time{}
+	}
+
+	t, err := time.Parse(time.RFC3339, value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			p,
+			"Invalid Timestamp",
+			fmt.Sprintf("Expected a timestamp in RFC 3339 format, e.g. 2026-01-15T09:00:00Z, got %q.", value.ValueString()),
+		)
+		return time.Time{}
+	}
+
+	return t
+}
@@ -5,25 +5,85 @@ package provider
 
 import (
 	"context"
+	"crypto/tls"
+	"encoding/json"
 	"fmt"
+	"maps"
+	"net"
+	"net/netip"
+	"net/url"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/ackack-io/terraform-provider-ackack/internal/client"
+	"github.com/ackack-io/terraform-provider-ackack/internal/deprecation"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// failureConditions are the check failure conditions that can be mapped to
+// an incident severity.
+var failureConditions = []string{
+	"timeout",
+	"connection_error",
+	"status_mismatch",
+	"body_mismatch",
+	"dns_mismatch",
+	"dnssec_invalid",
+	"ssl_expiring",
+	"ssl_invalid",
+	"phase_threshold",
+	"schema_mismatch",
+}
+
+// fingerprintSHA256Regexp matches a SHA-256 fingerprint as 64 hex characters
+// or as 32 colon-separated hex pairs.
+var fingerprintSHA256Regexp = regexp.MustCompile(`^([0-9A-Fa-f]{64}|[0-9A-Fa-f]{2}(:[0-9A-Fa-f]{2}){31})$`)
+
+// defaultWaitForFirstCheckSeconds is how long Create waits for the first
+// check of a monitor when wait_for_first_check sets no timeout.
+const defaultWaitForFirstCheckSeconds = 300
+
+// allowDestroyWithOpenIncidentsEnv overrides prevent_destroy_with_open_incidents
+// when set to "true".
+const allowDestroyWithOpenIncidentsEnv = "ACKACK_ALLOW_DESTROY_WITH_OPEN_INCIDENTS"
+
+// Defaults of the check timing attributes.
+const (
+	defaultFrequencySeconds = 60
+	defaultTimeoutMs        = 10000
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &MonitorResource{}
 var _ resource.ResourceWithImportState = &MonitorResource{}
+var _ resource.ResourceWithIdentity = &MonitorResource{}
+var _ resource.ResourceWithUpgradeState = &MonitorResource{}
+var _ resource.ResourceWithValidateConfig = &MonitorResource{}
+var _ resource.ResourceWithConfigValidators = &MonitorResource{}
+var _ resource.ResourceWithModifyPlan = &MonitorResource{}
 
 func NewMonitorResource() resource.Resource {
 	return &MonitorResource{}
@@ -36,44 +96,150 @@ type MonitorResource struct {
 
 // MonitorResourceModel describes the resource data model.
 type MonitorResourceModel struct {
-	ID               types.String  `tfsdk:"id"`
-	Name             types.String  `tfsdk:"name"`
-	Type             types.String  `tfsdk:"type"`
-	IsEnabled        types.Bool    `tfsdk:"is_enabled"`
-	FrequencySeconds types.Int64   `tfsdk:"frequency_seconds"`
-	TimeoutMs        types.Int64   `tfsdk:"timeout_ms"`
-	Retries          types.Int64   `tfsdk:"retries"`
-	GeneralRegion    types.String  `tfsdk:"general_region"`
-	SpecificRegion   types.String  `tfsdk:"specific_region"`
-	Status           types.String  `tfsdk:"status"`
-	UptimePercentage types.Float64 `tfsdk:"uptime_percentage"`
-	LastChecked      types.String  `tfsdk:"last_checked"`
-	CreatedAt        types.String  `tfsdk:"created_at"`
-	UpdatedAt        types.String  `tfsdk:"updated_at"`
+	ID                types.String  `tfsdk:"id"`
+	Name              types.String  `tfsdk:"name"`
+	Type              types.String  `tfsdk:"type"`
+	IsEnabled         types.Bool    `tfsdk:"is_enabled"`
+	FrequencySeconds  types.Int64   `tfsdk:"frequency_seconds"`
+	TimeoutMs         types.Int64   `tfsdk:"timeout_ms"`
+	Retries           types.Int64   `tfsdk:"retries"`
+	GeneralRegion     types.String  `tfsdk:"general_region"`
+	SpecificRegion    types.String  `tfsdk:"specific_region"`
+	Regions           types.Set     `tfsdk:"regions"`
+	MinRegionsFailing types.Int64   `tfsdk:"min_regions_failing"`
+	IPVersion         types.String  `tfsdk:"ip_version"`
+	Status            types.String  `tfsdk:"status"`
+	UptimePercentage  types.Float64 `tfsdk:"uptime_percentage"`
+	LastChecked       types.String  `tfsdk:"last_checked"`
+	CreatedAt         types.String  `tfsdk:"created_at"`
+	UpdatedAt         types.String  `tfsdk:"updated_at"`
+
+	EffectiveSchedule types.Object `tfsdk:"effective_schedule"`
 
 	// HTTP specific
-	URL                types.String `tfsdk:"url"`
-	ExpectedStatusCode types.Int64  `tfsdk:"expected_status_code"`
-	ValidateStatus     types.Bool   `tfsdk:"validate_status"`
-	ValidateBody       types.Bool   `tfsdk:"validate_body"`
-	BodyPattern        types.String `tfsdk:"body_pattern"`
-	Headers            types.String `tfsdk:"headers"`
+	URL                types.String  `tfsdk:"url"`
+	ExpectedStatusCode types.Int64   `tfsdk:"expected_status_code"`
+	ValidateStatus     types.Bool    `tfsdk:"validate_status"`
+	ValidateBody       types.Bool    `tfsdk:"validate_body"`
+	BodyPattern        types.String  `tfsdk:"body_pattern"`
+	BodyPatternMode    types.String  `tfsdk:"body_pattern_mode"`
+	BodyPatternIsRegex types.Bool    `tfsdk:"body_pattern_is_regex"`
+	ResponseSchema     types.String  `tfsdk:"response_schema"`
+	FollowRedirects    types.Bool    `tfsdk:"follow_redirects"`
+	MaxRedirects       types.Int64   `tfsdk:"max_redirects"`
+	Headers            types.String  `tfsdk:"headers"`
+	ClientCertPEM      types.String  `tfsdk:"client_cert_pem"`
+	ClientKeyPEM       types.String  `tfsdk:"client_key_pem"`
+	ResolveToIP        types.String  `tfsdk:"resolve_to_ip"`
+	HostHeader         HostnameValue `tfsdk:"host_header"`
+	Path               types.String  `tfsdk:"path"`
+	Scheme             types.String  `tfsdk:"scheme"`
 
 	// DNS specific
-	DNSRecordType types.String `tfsdk:"dns_record_type"`
-	ExpectedValue types.String `tfsdk:"expected_value"`
-	Nameserver    types.String `tfsdk:"nameserver"`
+	DNSRecordType  types.String `tfsdk:"dns_record_type"`
+	ExpectedValue  types.String `tfsdk:"expected_value"`
+	Nameserver     types.String `tfsdk:"nameserver"`
+	ValidateDNSSEC types.Bool   `tfsdk:"validate_dnssec"`
+
+	ExpectedRecords []ExpectedRecordModel `tfsdk:"expected_record"`
 
 	// TCP specific
-	Host types.String `tfsdk:"host"`
-	Port types.Int64  `tfsdk:"port"`
+	Host   HostnameValue `tfsdk:"host"`
+	Port   types.Int64   `tfsdk:"port"`
+	UseTLS types.Bool    `tfsdk:"use_tls"`
 
 	// SSL specific
-	Domain                   types.String `tfsdk:"domain"`
-	CheckExpirationThreshold types.Bool   `tfsdk:"check_expiration_threshold"`
-	ExpirationThreshold      types.Int64  `tfsdk:"expiration_threshold"`
-	CheckProtocolVersion     types.Bool   `tfsdk:"check_protocol_version"`
-	MinimumProtocol          types.String `tfsdk:"minimum_protocol"`
+	Domain                    HostnameValue `tfsdk:"domain"`
+	ServerName                HostnameValue `tfsdk:"server_name"`
+	CheckExpirationThreshold  types.Bool    `tfsdk:"check_expiration_threshold"`
+	ExpirationThreshold       types.Int64   `tfsdk:"expiration_threshold"`
+	CheckProtocolVersion      types.Bool    `tfsdk:"check_protocol_version"`
+	MinimumProtocol           types.String  `tfsdk:"minimum_protocol"`
+	CheckChain                types.Bool    `tfsdk:"check_chain"`
+	ExpectedIssuer            types.String  `tfsdk:"expected_issuer"`
+	CheckRevocation           types.Bool    `tfsdk:"check_revocation"`
+	ExpectedFingerprintSHA256 types.String  `tfsdk:"expected_fingerprint_sha256"`
+
+	// Result storage
+	ResultSampling *ResultSamplingModel `tfsdk:"result_sampling"`
+
+	// Per-phase latency thresholds
+	PhaseThresholds *PhaseThresholdsModel `tfsdk:"phase_thresholds"`
+
+	// Time-windowed threshold overrides
+	ThresholdWindows []ThresholdWindowModel `tfsdk:"threshold_window"`
+
+	// Active hours
+	Schedule []ActiveHoursModel `tfsdk:"schedule"`
+
+	// Incident handling
+	SeverityMapping         types.Map   `tfsdk:"severity_mapping"`
+	AutoResolveAfterMinutes types.Int64 `tfsdk:"auto_resolve_after_minutes"`
+	ReopenWindowMinutes     types.Int64 `tfsdk:"reopen_window_minutes"`
+
+	// Dependencies
+	DependsOnMonitorIDs types.Set  `tfsdk:"depends_on_monitor_ids"`
+	InheritMaintenance  types.Bool `tfsdk:"inherit_maintenance"`
+
+	// Maintenance
+	PauseDuringMaintenance types.Bool `tfsdk:"pause_during_maintenance"`
+
+	// Existing monitors
+	AdoptExisting types.Bool `tfsdk:"adopt_existing"`
+
+	// Initial apply
+	StartPaused types.Bool `tfsdk:"start_paused"`
+
+	// Destroy protection
+	PreventDestroyWithOpenIncidents types.Bool `tfsdk:"prevent_destroy_with_open_incidents"`
+
+	// First check
+	WaitForFirstCheck *WaitForFirstCheckModel `tfsdk:"wait_for_first_check"`
+
+	// Out-of-band alerts
+	AdoptUnmanagedAlerts types.Bool `tfsdk:"adopt_unmanaged_alerts"`
+	UnmanagedAlerts      types.List `tfsdk:"unmanaged_alerts"`
+}
+
+// unmanagedAlertAttrTypes are the attribute types of an unmanaged_alerts
+// element.
+var unmanagedAlertAttrTypes = map[string]attr.Type{
+	"id":         types.StringType,
+	"type":       types.StringType,
+	"target":     types.StringType,
+	"is_enabled": types.BoolType,
+}
+
+// effectiveScheduleAttrTypes are the attribute types of effective_schedule.
+var effectiveScheduleAttrTypes = map[string]attr.Type{
+	"frequency_seconds": types.Int64Type,
+	"throttled":         types.BoolType,
+	"throttle_reason":   types.StringType,
+	"dampening_level":   types.Int64Type,
+	"next_check_at":     types.StringType,
+}
+
+// PhaseThresholdsModel describes the per-phase latency thresholds of an HTTP
+// monitor.
+type PhaseThresholdsModel struct {
+	DNSMs      types.Int64 `tfsdk:"dns_ms"`
+	ConnectMs  types.Int64 `tfsdk:"connect_ms"`
+	TLSMs      types.Int64 `tfsdk:"tls_ms"`
+	TTFBMs     types.Int64 `tfsdk:"ttfb_ms"`
+	TransferMs types.Int64 `tfsdk:"transfer_ms"`
+}
+
+// WaitForFirstCheckModel describes how Create waits for the first check of a
+// new monitor.
+type WaitForFirstCheckModel struct {
+	TimeoutSeconds   types.Int64 `tfsdk:"timeout_seconds"`
+	MaxFailedRegions types.Int64 `tfsdk:"max_failed_regions"`
+}
+
+// ResultSamplingModel describes which check results are retained.
+type ResultSamplingModel struct {
+	SuccessSampleRate types.Int64 `tfsdk:"success_sample_rate"`
+	StoreAllFailures  types.Bool  `tfsdk:"store_all_failures"`
 }
 
 func (r *MonitorResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -82,6 +248,8 @@ func (r *MonitorResource) Metadata(ctx context.Context, req resource.MetadataReq
 
 func (r *MonitorResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 0,
+
 		MarkdownDescription: "Manages an uptime monitor on ackack.io.",
 
 		Attributes: map[string]schema.Attribute{
@@ -100,7 +268,7 @@ func (r *MonitorResource) Schema(ctx context.Context, req resource.SchemaRequest
 				MarkdownDescription: "The type of monitor. Must be one of: `http`, `dns`, `ssl`, `tcp`.",
 				Required:            true,
 				Validators: []validator.String{
-					stringvalidator.OneOf("http", "dns", "ssl", "tcp"),
+					stringvalidator.OneOf(client.Strings(client.MonitorTypes)...),
 				},
 			},
 			"is_enabled": schema.BoolAttribute{
@@ -113,28 +281,62 @@ func (r *MonitorResource) Schema(ctx context.Context, req resource.SchemaRequest
 				MarkdownDescription: "How often to check the monitor, in seconds. Defaults to `60`.",
 				Optional:            true,
 				Computed:            true,
-				Default:             int64default.StaticInt64(60),
+				Default:             int64default.StaticInt64(defaultFrequencySeconds),
 			},
 			"timeout_ms": schema.Int64Attribute{
-				MarkdownDescription: "Timeout for each check, in milliseconds. Defaults to `10000`.",
-				Optional:            true,
-				Computed:            true,
-				Default:             int64default.StaticInt64(10000),
+				MarkdownDescription: "Timeout for each check, in milliseconds. Must be at least `100`, and the check with all its retries " +
+					"must fit within `frequency_seconds`. Defaults to `10000`.",
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(defaultTimeoutMs),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(100),
+				},
 			},
 			"retries": schema.Int64Attribute{
-				MarkdownDescription: "Number of retries before marking as failed.",
+				MarkdownDescription: "Number of retries before marking as failed. Must be between `0` and `10`.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 10),
+				},
 			},
 			"general_region": schema.StringAttribute{
-				MarkdownDescription: "The general region for monitoring (e.g., `us`, `eu`, `asia`).",
+				MarkdownDescription: "The general region for monitoring (e.g., `us`, `eu`, `asia`). Deprecated: use `regions`.",
 				Optional:            true,
 				Computed:            true,
+				DeprecationMessage:  deprecation.Message("general_region", "regions"),
 			},
 			"specific_region": schema.StringAttribute{
-				MarkdownDescription: "The specific region for monitoring.",
+				MarkdownDescription: "The specific region for monitoring. Deprecated: use `regions`.",
 				Optional:            true,
 				Computed:            true,
+				DeprecationMessage:  deprecation.Message("specific_region", "regions"),
+			},
+			"regions": schema.SetAttribute{
+				MarkdownDescription: "The regions to run checks from (e.g., `us-east`, `eu-west`, `asia-southeast`). " +
+					"Each check runs from every region. Conflicts with `general_region` and `specific_region`.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"min_regions_failing": schema.Int64Attribute{
+				MarkdownDescription: "How many regions must fail the same check before the monitor is marked down and alerts fire, " +
+					"so a single region's network problem does not page anyone. Requires `regions`. When omitted, the API default of `1` is used.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"ip_version": schema.StringAttribute{
+				MarkdownDescription: "The IP version to connect over. Valid values: `ipv4`, `ipv6`, `any`. " +
+					"Use `ipv6` for a dedicated IPv6 check of a dual-stack service. Only valid for HTTP and TCP monitors. When omitted, the API default is used.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("ipv4", "ipv6", "any"),
+				},
 			},
 			"status": schema.StringAttribute{
 				MarkdownDescription: "The current status of the monitor.",
@@ -148,6 +350,111 @@ func (r *MonitorResource) Schema(ctx context.Context, req resource.SchemaRequest
 				MarkdownDescription: "The timestamp of the last check.",
 				Computed:            true,
 			},
+			"effective_schedule": schema.SingleNestedAttribute{
+				MarkdownDescription: "The schedule the monitor actually runs on, which can differ from `frequency_seconds` " +
+					"when the account is throttled for its plan or the monitor is dampened after repeated failures. " +
+					"Refreshed on every read; null when the server does not report it.",
+				Computed: true,
+				Attributes: map[string]schema.Attribute{
+					"frequency_seconds": schema.Int64Attribute{
+						MarkdownDescription: "The resolved check frequency, in seconds.",
+						Computed:            true,
+					},
+					"throttled": schema.BoolAttribute{
+						MarkdownDescription: "Whether checks are currently throttled.",
+						Computed:            true,
+					},
+					"throttle_reason": schema.StringAttribute{
+						MarkdownDescription: "Why checks are throttled, when they are.",
+						Computed:            true,
+					},
+					"dampening_level": schema.Int64Attribute{
+						MarkdownDescription: "The dampening level applied after repeated failures. `0` means no dampening.",
+						Computed:            true,
+					},
+					"next_check_at": schema.StringAttribute{
+						MarkdownDescription: "The timestamp of the next scheduled check.",
+						Computed:            true,
+					},
+				},
+			},
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Whether to take over an existing monitor with the same name when creating this one would conflict with it. " +
+					"The existing monitor is updated to match this configuration instead of a new one being created. " +
+					"When false or unset, the create fails with the existing monitor's ID so it can be imported. " +
+					"Useful when bringing an account that was set up by hand under Terraform.",
+				Optional: true,
+			},
+			"start_paused": schema.BoolAttribute{
+				MarkdownDescription: "Whether the monitor is created disabled, so it does not alert before its alerts and maintenance windows exist. " +
+					"Enable it in the same apply with an `ackack_monitor_activation` that depends on them. " +
+					"Only applies when the monitor is created; conflicts with `is_enabled`.",
+				Optional: true,
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("is_enabled")),
+				},
+			},
+			"prevent_destroy_with_open_incidents": schema.BoolAttribute{
+				MarkdownDescription: "Whether destroying the monitor fails while it has unresolved incidents, so an outage's evidence " +
+					"is not deleted by accident. Resolve the incidents first, for example with the `ackack_monitor_reset_incidents` action, " +
+					"or set the `" + allowDestroyWithOpenIncidentsEnv + "` environment variable to `true` to destroy the monitor anyway. Default is false.",
+				Optional: true,
+			},
+			"wait_for_first_check": schema.SingleNestedAttribute{
+				MarkdownDescription: "Makes creating the monitor wait until its first check has completed in every region, and fail when more regions " +
+					"failed the check than `max_failed_regions` allows, so resources that depend on the monitor can rely on it being verified. " +
+					"A monitor whose first check fails is still created and is marked as tainted, so the next apply replaces it. " +
+					"Has no effect on updates or imports.",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"timeout_seconds": schema.Int64Attribute{
+						MarkdownDescription: fmt.Sprintf("How long to wait for the first check, in seconds. Default is %d.", defaultWaitForFirstCheckSeconds),
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"max_failed_regions": schema.Int64Attribute{
+						MarkdownDescription: "How many regions may fail the first check before creating the monitor fails. Default is 0.",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+				},
+			},
+			"adopt_unmanaged_alerts": schema.BoolAttribute{
+				MarkdownDescription: "How to surface alerts attached to this monitor outside Terraform, for example in the dashboard. " +
+					"When true, they are listed in `unmanaged_alerts`. When false, they are also listed and each refresh reports them " +
+					"as a drift warning. When unset, they are not looked up. Alerts created by `ackack_alert` are recognised as managed; " +
+					"imported alerts are marked as managed when imported, and alerts created by older provider versions when they are next updated.",
+				Optional: true,
+			},
+			"unmanaged_alerts": schema.ListNestedAttribute{
+				MarkdownDescription: "The alerts attached to this monitor that are not managed by Terraform. " +
+					"Refreshed on every read; null unless `adopt_unmanaged_alerts` is set.",
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the alert.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The delivery type of the alert.",
+							Computed:            true,
+						},
+						"target": schema.StringAttribute{
+							MarkdownDescription: "The target of the alert, when it has one.",
+							Computed:            true,
+						},
+						"is_enabled": schema.BoolAttribute{
+							MarkdownDescription: "Whether the alert is enabled.",
+							Computed:            true,
+						},
+					},
+				},
+			},
 			"created_at": schema.StringAttribute{
 				MarkdownDescription: "The timestamp when the monitor was created.",
 				Computed:            true,
@@ -159,8 +466,23 @@ func (r *MonitorResource) Schema(ctx context.Context, req resource.SchemaRequest
 
 			// HTTP specific
 			"url": schema.StringAttribute{
-				MarkdownDescription: "The URL to monitor. Required for HTTP monitors.",
+				MarkdownDescription: "The URL to monitor. HTTP monitors require either `url` or `host`, which is combined with `scheme`, `port` and `path` into the URL. " +
+					"For DNS monitors, the domain to query.",
+				Optional: true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "The path of the URL to monitor, e.g. `/healthz`. Only valid for HTTP monitors configured with `host`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^/`), "must start with a slash"),
+				},
+			},
+			"scheme": schema.StringAttribute{
+				MarkdownDescription: "The scheme of the URL to monitor. Must be one of: `http`, `https`. Only valid for HTTP monitors configured with `host`. Defaults to `https`.",
 				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("http", "https"),
+				},
 			},
 			"expected_status_code": schema.Int64Attribute{
 				MarkdownDescription: "The expected HTTP status code. Defaults to `200`.",
@@ -180,39 +502,128 @@ func (r *MonitorResource) Schema(ctx context.Context, req resource.SchemaRequest
 				MarkdownDescription: "The pattern to match in the response body.",
 				Optional:            true,
 			},
+			"body_pattern_mode": schema.StringAttribute{
+				MarkdownDescription: "How `body_pattern` is matched. `contains` fails the check when the pattern is missing from the body; " +
+					"`not_contains` fails it when the pattern appears, e.g. an error string such as `stack trace`. When omitted, the API default `contains` is used.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("contains", "not_contains"),
+				},
+			},
+			"body_pattern_is_regex": schema.BoolAttribute{
+				MarkdownDescription: "Whether `body_pattern` is a regular expression (RE2 syntax) rather than a plain substring. " +
+					"The expression is compiled at plan time, so invalid patterns fail the plan. Default is false.",
+				Optional: true,
+			},
+			"response_schema": schema.StringAttribute{
+				MarkdownDescription: "A JSON Schema document the response body must validate against, so structural API contract " +
+					"violations fail the check. Drafts 04, 06, 07, 2019-09 and 2020-12 are supported; the schema is checked at plan time. " +
+					"Failures use the `schema_mismatch` condition in `severity_mapping`. Only valid for HTTP monitors. " +
+					"Use `jsonencode` or `file` to supply the document.",
+				Optional: true,
+			},
+			"follow_redirects": schema.BoolAttribute{
+				MarkdownDescription: "Whether to follow redirects to the final page. Set to `false` to validate the redirect response itself, " +
+					"e.g. with `expected_status_code = 301`. When omitted, the API default is used.",
+				Optional: true,
+			},
+			"max_redirects": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of redirects to follow before the check fails. Cannot be set when `follow_redirects` is `false`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 20),
+				},
+			},
 			"headers": schema.StringAttribute{
 				MarkdownDescription: "HTTP headers as a JSON string.",
 				Optional:            true,
 			},
+			"client_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "The PEM-encoded client certificate the probe presents to endpoints that require mutual TLS. " +
+					"Intermediate certificates can follow the leaf certificate. Requires `client_key_pem`. Only valid for HTTP monitors.",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"client_key_pem": schema.StringAttribute{
+				MarkdownDescription: "The PEM-encoded private key of `client_cert_pem`. The API never returns this value. " +
+					"Only valid for HTTP monitors.",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"resolve_to_ip": schema.StringAttribute{
+				MarkdownDescription: "An IPv4 or IPv6 address to connect to instead of resolving the URL's host, to probe an origin " +
+					"server directly and bypass the CDN or DNS. The URL's host is still used for the Host header and TLS SNI " +
+					"unless `host_header` is set. Only valid for HTTP monitors.",
+				Optional: true,
+			},
+			"host_header": schema.StringAttribute{
+				MarkdownDescription: "The Host header to send instead of the URL's host, e.g. the production name when probing a " +
+					"blue/green deployment by its own address. Also used for TLS SNI. Conflicts with a `Host` entry in `headers`. " +
+					"Internationalized names are sent to the API in punycode form. Only valid for HTTP monitors.",
+				Optional:   true,
+				CustomType: HostnameType{},
+			},
 
 			// DNS specific
 			"dns_record_type": schema.StringAttribute{
-				MarkdownDescription: "The DNS record type to query (e.g., `A`, `AAAA`, `CNAME`). Required for DNS monitors.",
+				MarkdownDescription: "The DNS record type to query (e.g., `A`, `AAAA`, `CNAME`, `CAA`, `MX`, `NS`, `SRV`, `TXT`). Required for DNS monitors.",
 				Optional:            true,
 			},
 			"expected_value": schema.StringAttribute{
-				MarkdownDescription: "The expected DNS record value.",
+				MarkdownDescription: "The expected DNS record value. Conflicts with `expected_record`.",
 				Optional:            true,
 			},
 			"nameserver": schema.StringAttribute{
-				MarkdownDescription: "The nameserver to query.",
+				MarkdownDescription: "The nameserver to query, as a hostname or IP address with an optional port, e.g. `1.1.1.1:53`.",
 				Optional:            true,
+				Validators: []validator.String{
+					nameserver(),
+				},
+			},
+			"validate_dnssec": schema.BoolAttribute{
+				MarkdownDescription: "Whether to validate the DNSSEC chain of trust of the answer, so a broken signature or missing DS record " +
+					"fails the check even when the record still resolves. Failures use the `dnssec_invalid` condition in `severity_mapping`. " +
+					"Only valid for DNS monitors. Default is false.",
+				Optional: true,
 			},
 
 			// TCP specific
 			"host": schema.StringAttribute{
-				MarkdownDescription: "The host to connect to. Required for TCP monitors.",
-				Optional:            true,
+				MarkdownDescription: "The host to connect to. Required for TCP monitors; for HTTP monitors, an alternative to `url`. " +
+					"Internationalized names are sent to the API in punycode form.",
+				Optional:   true,
+				CustomType: HostnameType{},
 			},
 			"port": schema.Int64Attribute{
-				MarkdownDescription: "The port to connect to. Required for TCP monitors.",
-				Optional:            true,
+				MarkdownDescription: "The port to connect to. Required for TCP monitors; optional for HTTP monitors configured with `host` " +
+					"and for SSL monitors serving the certificate on a port other than 443. Must be between 1 and 65535.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"use_tls": schema.BoolAttribute{
+				MarkdownDescription: "Whether to complete a TLS handshake after connecting, for TLS-wrapped services on arbitrary ports " +
+					"such as syslog over TLS on 6514. A failed handshake fails the check. Only valid for TCP monitors. Default is false.",
+				Optional: true,
 			},
 
 			// SSL specific
 			"domain": schema.StringAttribute{
-				MarkdownDescription: "The domain to check SSL certificate for. Required for SSL monitors.",
-				Optional:            true,
+				MarkdownDescription: "The domain to check SSL certificate for. Required for SSL monitors. " +
+					"Internationalized names are sent to the API in punycode form.",
+				Optional:   true,
+				CustomType: HostnameType{},
+			},
+			"server_name": schema.StringAttribute{
+				MarkdownDescription: "The server name sent in the TLS SNI extension, for backends that route on SNI. " +
+					"Only valid for SSL monitors and for TCP monitors with `use_tls`. When omitted, `domain` or `host` is used.",
+				Optional:   true,
+				CustomType: HostnameType{},
 			},
 			"check_expiration_threshold": schema.BoolAttribute{
 				MarkdownDescription: "Whether to check if the certificate is expiring soon.",
@@ -220,8 +631,11 @@ func (r *MonitorResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Computed:            true,
 			},
 			"expiration_threshold": schema.Int64Attribute{
-				MarkdownDescription: "Days before expiration to alert.",
+				MarkdownDescription: "Days before expiration to alert. Must be between `1` and `365`.",
 				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 365),
+				},
 			},
 			"check_protocol_version": schema.BoolAttribute{
 				MarkdownDescription: "Whether to check the TLS protocol version.",
@@ -232,10 +646,568 @@ func (r *MonitorResource) Schema(ctx context.Context, req resource.SchemaRequest
 				MarkdownDescription: "The minimum TLS protocol version (e.g., `TLS1.2`, `TLS1.3`).",
 				Optional:            true,
 			},
+			"check_chain": schema.BoolAttribute{
+				MarkdownDescription: "Whether to verify that the server sends a complete certificate chain up to a trusted root, " +
+					"catching missing or mis-served intermediates. Only valid for SSL monitors.",
+				Optional: true,
+				Computed: true,
+			},
+			"expected_issuer": schema.StringAttribute{
+				MarkdownDescription: "The expected issuer of the certificate, matched against the issuer's common name or organization " +
+					"(e.g., `Let's Encrypt`, `DigiCert Inc`). The check fails when the certificate is issued by anyone else. Only valid for SSL monitors.",
+				Optional: true,
+			},
+			"check_revocation": schema.BoolAttribute{
+				MarkdownDescription: "Whether to check the certificate's revocation status through OCSP. Only valid for SSL monitors.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"expected_fingerprint_sha256": schema.StringAttribute{
+				MarkdownDescription: "Pins the SHA-256 fingerprint of the leaf certificate, so a certificate rotated outside your PKI pipeline fails the check. " +
+					"Accepts 64 hex characters, optionally as colon-separated pairs (e.g., the output of `openssl x509 -noout -fingerprint -sha256`). " +
+					"Only valid for SSL monitors.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(fingerprintSHA256Regexp, "must be a SHA-256 fingerprint of 64 hex characters, optionally colon-separated"),
+				},
+			},
+
+			// Result storage
+			"result_sampling": schema.SingleNestedAttribute{
+				MarkdownDescription: "Controls which check results are stored, so high-frequency monitors stay within the retention quota. When omitted, every result is stored.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"success_sample_rate": schema.Int64Attribute{
+						MarkdownDescription: "Store one in every N successful results. `1` stores every successful result.",
+						Required:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"store_all_failures": schema.BoolAttribute{
+						MarkdownDescription: "Whether every failed result is stored regardless of `success_sample_rate`. Defaults to `true`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(true),
+					},
+				},
+			},
+
+			// Per-phase latency thresholds
+			"phase_thresholds": schema.SingleNestedAttribute{
+				MarkdownDescription: "Fails the check when a phase of the request takes longer than its threshold, in milliseconds, " +
+					"so a slow TLS handshake is caught even when the total response time is acceptable. Only valid for HTTP monitors. " +
+					"Failures use the `phase_threshold` condition in `severity_mapping`.",
+				Optional:   true,
+				Attributes: phaseThresholdAttributes(),
+			},
+
+			// Incident handling
+			"severity_mapping": schema.MapAttribute{
+				MarkdownDescription: "Maps failure conditions to the severity of the incident they open. " +
+					"Keys must be one of: `timeout`, `connection_error`, `status_mismatch`, `body_mismatch`, `dns_mismatch`, `dnssec_invalid`, `ssl_expiring`, `ssl_invalid`, `phase_threshold`, `schema_mismatch`. " +
+					"Values must be one of: `info`, `warning`, `critical`. Conditions that are not mapped use the API default.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.OneOf(failureConditions...)),
+					mapvalidator.ValueStringsAre(stringvalidator.OneOf(client.Strings(client.Severities)...)),
+				},
+			},
+			"auto_resolve_after_minutes": schema.Int64Attribute{
+				MarkdownDescription: "How long, in minutes, the monitor must stay healthy before an open incident is resolved. " +
+					"When omitted, incidents resolve on the first successful check after `recovery_threshold` is met.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"reopen_window_minutes": schema.Int64Attribute{
+				MarkdownDescription: "If the monitor fails again within this many minutes of an incident resolving, " +
+					"the previous incident is reopened instead of a new one being created.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"depends_on_monitor_ids": schema.SetAttribute{
+				MarkdownDescription: "The IDs of monitors this monitor depends on, such as the monitor of a shared database or upstream API.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"inherit_maintenance": schema.BoolAttribute{
+				MarkdownDescription: "Whether checks and alerts of this monitor are suppressed while any monitor in `depends_on_monitor_ids` " +
+					"is inside a maintenance window. Requires `depends_on_monitor_ids`. Default is false.",
+				Optional: true,
+			},
+			"pause_during_maintenance": schema.BoolAttribute{
+				MarkdownDescription: "Whether checks are skipped while an `ackack_maintenance_window` covering this monitor, directly or " +
+					"through one of its systems, is active. No results are recorded and no incidents are opened until the window ends. " +
+					"When false, checks keep running and only alerts with `suppress_during_maintenance` are held back. Default is false.",
+				Optional: true,
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"expected_record": schema.ListNestedBlock{
+				MarkdownDescription: "A record the DNS answer must contain, with its fields checked individually instead of as a single " +
+					"`expected_value` string. The check fails when any expected record is missing from the answer. " +
+					"`MX` records require `priority`, `SRV` records require `priority`, `weight` and `port`, and `CAA` records require " +
+					"`flags` and `tag`; other record types only take `value`. Only valid for DNS monitors.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"value": schema.StringAttribute{
+							MarkdownDescription: "The record data: the address for `A` and `AAAA`, the host name for `CNAME`, `MX`, `NS` and `SRV`, " +
+								"the text for `TXT`, and the value for `CAA`, e.g. `letsencrypt.org`.",
+							Required: true,
+						},
+						"priority": schema.Int64Attribute{
+							MarkdownDescription: "The priority of an `MX` or `SRV` record.",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 65535),
+							},
+						},
+						"weight": schema.Int64Attribute{
+							MarkdownDescription: "The weight of an `SRV` record.",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 65535),
+							},
+						},
+						"port": schema.Int64Attribute{
+							MarkdownDescription: "The port of an `SRV` record.",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 65535),
+							},
+						},
+						"flags": schema.Int64Attribute{
+							MarkdownDescription: "The flags of a `CAA` record, e.g. `0`, or `128` for critical.",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 255),
+							},
+						},
+						"tag": schema.StringAttribute{
+							MarkdownDescription: "The tag of a `CAA` record. Must be one of: `issue`, `issuewild`, `iodef`.",
+							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(caaTags...),
+							},
+						},
+					},
+				},
+			},
+			"threshold_window": schema.ListNestedBlock{
+				MarkdownDescription: "Overrides the monitor's thresholds during a recurring time window, e.g. stricter latency thresholds " +
+					"during business hours. Outside every window the monitor-level thresholds apply. Windows in the same time zone must not overlap.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"days": schema.SetAttribute{
+							MarkdownDescription: "Days of the week the window starts on. Each must be one of: `mon`, `tue`, `wed`, `thu`, `fri`, `sat`, `sun`.",
+							Required:            true,
+							ElementType:         types.StringType,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+								setvalidator.ValueStringsAre(stringvalidator.OneOf(weekdays...)),
+							},
+						},
+						"start_time": schema.StringAttribute{
+							MarkdownDescription: "Start of the window in 24-hour `HH:MM` format.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(clockTimeRegexp, "must be a 24-hour time in HH:MM format"),
+							},
+						},
+						"end_time": schema.StringAttribute{
+							MarkdownDescription: "End of the window in 24-hour `HH:MM` format. A window that ends before it starts spans midnight.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(clockTimeRegexp, "must be a 24-hour time in HH:MM format"),
+							},
+						},
+						"timezone": schema.StringAttribute{
+							MarkdownDescription: "The IANA time zone the window is evaluated in, e.g. `Europe/Berlin`. Defaults to `UTC`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString("UTC"),
+						},
+						"timeout_ms": schema.Int64Attribute{
+							MarkdownDescription: "Timeout for each check during the window, in milliseconds.",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"phase_thresholds": schema.SingleNestedAttribute{
+							MarkdownDescription: "Per-phase latency thresholds during the window, in milliseconds. Replaces the monitor-level " +
+								"`phase_thresholds` while the window is active. Only valid for HTTP monitors.",
+							Optional:   true,
+							Attributes: phaseThresholdAttributes(),
+						},
+					},
+				},
+			},
+			"schedule": schema.ListNestedBlock{
+				MarkdownDescription: "Restricts checks to the given days and time windows, e.g. business hours for services that are " +
+					"expected to be down overnight. Outside every window the monitor does not run checks, so it cannot open incidents. " +
+					"Repeat the block to add windows; windows may overlap. When omitted, the monitor runs at all times.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"days": schema.SetAttribute{
+							MarkdownDescription: "Days of the week the window starts on. Each must be one of: `mon`, `tue`, `wed`, `thu`, `fri`, `sat`, `sun`.",
+							Required:            true,
+							ElementType:         types.StringType,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+								setvalidator.ValueStringsAre(stringvalidator.OneOf(weekdays...)),
+							},
+						},
+						"start_time": schema.StringAttribute{
+							MarkdownDescription: "Start of the window in 24-hour `HH:MM` format.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(clockTimeRegexp, "must be a 24-hour time in HH:MM format"),
+							},
+						},
+						"end_time": schema.StringAttribute{
+							MarkdownDescription: "End of the window in 24-hour `HH:MM` format. A window that ends before it starts spans midnight.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(clockTimeRegexp, "must be a 24-hour time in HH:MM format"),
+							},
+						},
+						"timezone": schema.StringAttribute{
+							MarkdownDescription: "The IANA time zone the window is evaluated in, e.g. `Europe/Berlin`. Defaults to `UTC`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString("UTC"),
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+func (r *MonitorResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		deprecation.Conflicting("general_region", "regions"),
+		deprecation.Conflicting("specific_region", "regions"),
+		resourcevalidator.RequiredTogether(
+			path.MatchRoot("client_cert_pem"),
+			path.MatchRoot("client_key_pem"),
+		),
+	}
+}
+
+func (r *MonitorResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data MonitorResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.BodyPatternMode.IsNull() && data.BodyPattern.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("body_pattern_mode"),
+			"Missing Body Pattern",
+			"The body_pattern_mode attribute requires body_pattern to be set.",
+		)
+	}
+	if !data.MinRegionsFailing.IsNull() && !data.MinRegionsFailing.IsUnknown() {
+		if data.Regions.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("min_regions_failing"),
+				"Missing Regions",
+				"The min_regions_failing attribute requires regions to be set.",
+			)
+		} else if !data.Regions.IsUnknown() && data.MinRegionsFailing.ValueInt64() > int64(len(data.Regions.Elements())) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("min_regions_failing"),
+				"Invalid Region Quorum",
+				fmt.Sprintf("The min_regions_failing value %d exceeds the %d configured regions, so the monitor could never fail.",
+					data.MinRegionsFailing.ValueInt64(), len(data.Regions.Elements())),
+			)
+		}
+	}
+	validateCheckTiming(path.Root("timeout_ms"), data.FrequencySeconds, data.TimeoutMs, data.Retries, &resp.Diagnostics)
+	for i, window := range data.ThresholdWindows {
+		if !window.TimeoutMs.IsNull() {
+			validateCheckTiming(path.Root("threshold_window").AtListIndex(i).AtName("timeout_ms"), data.FrequencySeconds, window.TimeoutMs, data.Retries, &resp.Diagnostics)
+		}
+	}
+	if data.WaitForFirstCheck != nil && ((!data.IsEnabled.IsNull() && !data.IsEnabled.ValueBool()) || data.StartPaused.ValueBool()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("wait_for_first_check"),
+			"Invalid Wait For First Check",
+			"A disabled monitor is never checked, so wait_for_first_check requires is_enabled to be true and start_paused to be unset.",
+		)
+	}
+	if data.InheritMaintenance.ValueBool() && data.DependsOnMonitorIDs.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("inherit_maintenance"),
+			"Missing Dependencies",
+			"The inherit_maintenance attribute requires depends_on_monitor_ids to be set.",
+		)
+	}
+	if data.BodyPatternIsRegex.ValueBool() && !data.BodyPattern.IsNull() && !data.BodyPattern.IsUnknown() {
+		if _, err := regexp.Compile(data.BodyPattern.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("body_pattern"),
+				"Invalid Body Pattern",
+				fmt.Sprintf("The body_pattern is not a valid regular expression: %s.", err),
+			)
+		}
+	}
+
+	if !data.ResponseSchema.IsNull() && !data.ResponseSchema.IsUnknown() {
+		if err := validateJSONSchema(data.ResponseSchema.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("response_schema"),
+				"Invalid Response Schema",
+				fmt.Sprintf("The response_schema is not a valid JSON Schema document, %s.", err),
+			)
+		}
+	}
+
+	if !data.ClientCertPEM.IsNull() && !data.ClientCertPEM.IsUnknown() && !data.ClientKeyPEM.IsNull() && !data.ClientKeyPEM.IsUnknown() {
+		if _, err := tls.X509KeyPair([]byte(data.ClientCertPEM.ValueString()), []byte(data.ClientKeyPEM.ValueString())); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("client_cert_pem"),
+				"Invalid Client Certificate",
+				fmt.Sprintf("The client_cert_pem and client_key_pem values are not a valid certificate and matching private key: %s.", err),
+			)
+		}
+	}
+
+	if !data.ResolveToIP.IsNull() && !data.ResolveToIP.IsUnknown() {
+		ip, err := netip.ParseAddr(data.ResolveToIP.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("resolve_to_ip"),
+				"Invalid IP Address",
+				fmt.Sprintf("The resolve_to_ip value %q is not an IPv4 or IPv6 address.", data.ResolveToIP.ValueString()),
+			)
+		} else if version := data.IPVersion.ValueString(); (version == "ipv4" && !ip.Unmap().Is4()) || (version == "ipv6" && ip.Unmap().Is4()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("resolve_to_ip"),
+				"Invalid Attribute Combination",
+				fmt.Sprintf("The resolve_to_ip address %s does not match ip_version %q.", ip, version),
+			)
+		}
+	}
+	if !data.HostHeader.IsNull() && !data.Headers.IsNull() && !data.Headers.IsUnknown() {
+		var headers map[string]any
+		if json.Unmarshal([]byte(data.Headers.ValueString()), &headers) == nil {
+			for name := range headers {
+				if strings.EqualFold(name, "host") {
+					resp.Diagnostics.AddAttributeError(
+						path.Root("host_header"),
+						"Invalid Attribute Combination",
+						"The host_header attribute cannot be used together with a Host entry in headers.",
+					)
+					break
+				}
+			}
+		}
+	}
+
+	validateThresholdWindows(data.ThresholdWindows, &resp.Diagnostics)
+	for i, window := range data.ThresholdWindows {
+		if tz := window.Timezone; !tz.IsNull() && !tz.IsUnknown() {
+			if _, err := time.LoadLocation(tz.ValueString()); err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("threshold_window").AtListIndex(i).AtName("timezone"),
+					"Invalid Time Zone",
+					fmt.Sprintf("Unknown IANA time zone %q: %s.", tz.ValueString(), err),
+				)
+			}
+		}
+	}
+
+	validateSchedule(data.Schedule, &resp.Diagnostics)
+
+	if data.Type.IsUnknown() || data.Type.IsNull() {
+		return
+	}
+	monitorType := client.MonitorType(data.Type.ValueString())
+
+	if !data.MaxRedirects.IsNull() && !data.FollowRedirects.IsNull() && !data.FollowRedirects.IsUnknown() && !data.FollowRedirects.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_redirects"),
+			"Invalid Attribute Combination",
+			"The max_redirects attribute cannot be set when follow_redirects is false.",
+		)
+	}
+
+	if monitorType == client.MonitorTypeTCP {
+		if !data.ServerName.IsNull() && !data.UseTLS.IsUnknown() && !data.UseTLS.ValueBool() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("server_name"),
+				"Invalid Attribute Combination",
+				"The server_name attribute requires use_tls to be true on TCP monitors.",
+			)
+		}
+	} else if !data.UseTLS.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("use_tls"),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("The use_tls attribute can only be used with TCP monitors, got type %q.", data.Type.ValueString()),
+		)
+	}
+
+	if monitorType != client.MonitorTypeSSL {
+		sslOnly := map[string]attr.Value{
+			"check_chain":                 data.CheckChain,
+			"expected_issuer":             data.ExpectedIssuer,
+			"check_revocation":            data.CheckRevocation,
+			"expected_fingerprint_sha256": data.ExpectedFingerprintSHA256,
+		}
+		if monitorType != client.MonitorTypeTCP {
+			sslOnly["server_name"] = data.ServerName
+		}
+		for _, name := range slices.Sorted(maps.Keys(sslOnly)) {
+			if !sslOnly[name].IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(name),
+					"Invalid Attribute Combination",
+					fmt.Sprintf("The %s attribute can only be used with SSL monitors, got type %q.", name, data.Type.ValueString()),
+				)
+			}
+		}
+	}
+
+	if !data.ValidateDNSSEC.IsNull() && monitorType != client.MonitorTypeDNS {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("validate_dnssec"),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("The validate_dnssec attribute can only be used with DNS monitors, got type %q.", data.Type.ValueString()),
+		)
+	}
+
+	if monitorType == client.MonitorTypeDNS {
+		if u := data.URL; !u.IsNull() && !u.IsUnknown() {
+			if err := checkHostname(u.ValueString()); err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("url"),
+					"Invalid Hostname",
+					fmt.Sprintf("DNS monitors query the domain in url, which must be a hostname, got %q: %s", u.ValueString(), err),
+				)
+			}
+		}
+		if len(data.ExpectedRecords) > 0 && !data.ExpectedValue.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("expected_value"),
+				"Invalid Attribute Combination",
+				"DNS monitors accept either expected_value or expected_record blocks, not both.",
+			)
+		}
+		validateExpectedRecords(data.DNSRecordType, data.ExpectedRecords, &resp.Diagnostics)
+	} else if len(data.ExpectedRecords) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("expected_record"),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("The expected_record block can only be used with DNS monitors, got type %q.", data.Type.ValueString()),
+		)
+	}
+
+	if !data.IPVersion.IsNull() && monitorType != client.MonitorTypeHTTP && monitorType != client.MonitorTypeTCP {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ip_version"),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("The ip_version attribute can only be used with HTTP and TCP monitors, got type %q.", data.Type.ValueString()),
+		)
+	}
+
+	if monitorType != client.MonitorTypeHTTP {
+		httpOnly := map[string]attr.Value{
+			"client_cert_pem": data.ClientCertPEM,
+			"resolve_to_ip":   data.ResolveToIP,
+			"host_header":     data.HostHeader,
+		}
+		for _, name := range slices.Sorted(maps.Keys(httpOnly)) {
+			if !httpOnly[name].IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(name),
+					"Invalid Attribute Combination",
+					fmt.Sprintf("The %s attribute can only be used with HTTP monitors, got type %q.", name, data.Type.ValueString()),
+				)
+			}
+		}
+	}
+
+	if !data.ResponseSchema.IsNull() && monitorType != client.MonitorTypeHTTP {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("response_schema"),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("The response_schema attribute can only be used with HTTP monitors, got type %q.", data.Type.ValueString()),
+		)
+	}
+
+	if data.PhaseThresholds != nil && monitorType != client.MonitorTypeHTTP {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("phase_thresholds"),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("The phase_thresholds attribute can only be used with HTTP monitors, got type %q.", data.Type.ValueString()),
+		)
+	}
+
+	for i, window := range data.ThresholdWindows {
+		if window.PhaseThresholds != nil && monitorType != client.MonitorTypeHTTP {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("threshold_window").AtListIndex(i).AtName("phase_thresholds"),
+				"Invalid Attribute Combination",
+				fmt.Sprintf("The phase_thresholds attribute can only be used with HTTP monitors, got type %q.", data.Type.ValueString()),
+			)
+		}
+	}
+
+	if monitorType == client.MonitorTypeHTTP {
+		if u := data.URL; !u.IsNull() && !u.IsUnknown() {
+			if err := checkHTTPURL(u.ValueString()); err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("url"),
+					"Invalid URL",
+					fmt.Sprintf("An absolute http or https URL was expected, got %q: %s", u.ValueString(), err),
+				)
+			}
+		}
+		if !data.URL.IsNull() && !data.Host.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("host"),
+				"Invalid Attribute Combination",
+				"HTTP monitors accept either url or host, port and path, not both.",
+			)
+		}
+		if data.Host.IsNull() && (!data.Path.IsNull() || !data.Scheme.IsNull()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("path"),
+				"Invalid Attribute Combination",
+				"The path and scheme attributes can only be used with HTTP monitors configured with host.",
+			)
+		}
+		return
+	}
+
+	if !data.Path.IsNull() || !data.Scheme.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("path"),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("The path and scheme attributes can only be used with HTTP monitors, got type %q.", data.Type.ValueString()),
+		)
+	}
+}
+
+func (r *MonitorResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("The unique identifier of the monitor.")
+}
+
 func (r *MonitorResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -254,6 +1226,9 @@ func (r *MonitorResource) Configure(ctx context.Context, req resource.ConfigureR
 }
 
 func (r *MonitorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
 	var data MonitorResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -264,17 +1239,104 @@ func (r *MonitorResource) Create(ctx context.Context, req resource.CreateRequest
 	createReq := r.buildCreateRequest(&data)
 
 	monitor, err := r.client.CreateMonitor(ctx, createReq)
-	if err != nil {
+	if client.IsConflictError(err) {
+		monitor = r.resolveCreateConflict(ctx, &data, err, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create monitor, got error: %s", err))
 		return
 	}
 
 	r.updateModelFromResponse(&data, monitor)
+	resp.Diagnostics.Append(setPrivateETag(ctx, resp.Private, monitor.ETag)...)
+	r.readEffectiveSchedule(ctx, &data, &resp.Diagnostics)
+	r.readUnmanagedAlerts(ctx, &data, &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.ID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.WaitForFirstCheck != nil {
+		r.waitForFirstCheck(ctx, &data, &resp.Diagnostics)
+	}
+}
+
+// waitForFirstCheck waits for the first check of a new monitor and adds an
+// error when more regions failed it than wait_for_first_check allows. The
+// monitor has already been saved to state, so the error taints it.
+func (r *MonitorResource) waitForFirstCheck(ctx context.Context, data *MonitorResourceModel, diags *diag.Diagnostics) {
+	timeout := time.Duration(defaultWaitForFirstCheckSeconds) * time.Second
+	if !data.WaitForFirstCheck.TimeoutSeconds.IsNull() {
+		timeout = time.Duration(data.WaitForFirstCheck.TimeoutSeconds.ValueInt64()) * time.Second
+	}
+
+	results, err := r.client.WaitForFirstResults(ctx, data.ID.ValueString(), stringsFromSetValue(data.Regions), timeout)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to wait for the first check of monitor, got error: %s", err))
+		return
+	}
+
+	failures := checkFailures(results)
+	if int64(len(failures)) > data.WaitForFirstCheck.MaxFailedRegions.ValueInt64() {
+		addCheckFailedError(diags, data.ID.ValueString(), failures)
+	}
+}
+
+// resolveCreateConflict handles a create that failed because a monitor with
+// the same name already exists. With adopt_existing, it updates that monitor
+// to match the plan and returns it; otherwise it reports the monitor's ID so
+// it can be imported.
+func (r *MonitorResource) resolveCreateConflict(ctx context.Context, data *MonitorResourceModel, createErr error, diags *diag.Diagnostics) *client.Monitor {
+	monitors, err := r.client.ListMonitors(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to create monitor, got error: %s", createErr))
+		return nil
+	}
+
+	name := data.Name.ValueString()
+	var existing []client.Monitor
+	for _, monitor := range monitors {
+		if monitor.Name == name {
+			existing = append(existing, monitor)
+		}
+	}
+	if len(existing) != 1 {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to create monitor, got error: %s", createErr))
+		return nil
+	}
+	id := existing[0].ID
+
+	if !data.AdoptExisting.ValueBool() {
+		diags.AddAttributeError(
+			path.Root("name"),
+			"Monitor Already Exists",
+			fmt.Sprintf("A monitor named %q already exists with ID %s. To manage it with Terraform, import it with an import block:\n\n"+
+				"import {\n  to = ackack_monitor.<name>\n  id = %q\n}\n\n"+
+				"or set adopt_existing to true to take it over when this resource is created.", name, id, id),
+		)
+		return nil
+	}
+
+	monitor, err := r.client.UpdateMonitor(ctx, id, r.buildUpdateRequest(data))
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to adopt existing monitor %s, got error: %s", id, err))
+		return nil
+	}
+	diags.AddWarning(
+		"Adopted Existing Monitor",
+		fmt.Sprintf("A monitor named %q already existed, so monitor %s was updated to match this configuration instead of a new one being created.", name, id),
+	)
+	return monitor
 }
 
 func (r *MonitorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
 	var data MonitorResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -293,11 +1355,18 @@ func (r *MonitorResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	r.updateModelFromResponse(&data, monitor)
+	resp.Diagnostics.Append(setPrivateETag(ctx, resp.Private, monitor.ETag)...)
+	r.readEffectiveSchedule(ctx, &data, &resp.Diagnostics)
+	r.readUnmanagedAlerts(ctx, &data, &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.ID)...)
 }
 
 func (r *MonitorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
 	var data MonitorResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -305,20 +1374,81 @@ func (r *MonitorResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	updateReq := r.buildUpdateRequest(&data)
+	etag, diags := privateETag(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	monitor, err := r.client.UpdateMonitor(ctx, data.ID.ValueString(), updateReq)
+	var monitor *client.Monitor
+	var err error
+	if r.client.SupportsFeature("partial_updates") {
+		// Send only what the plan changes, so fields set to zero values are
+		// transmitted and untouched fields are left alone.
+		var prior, planned MonitorResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+		raw, diffErr := planWithPriorUnknowns(req.Plan.Raw, req.State.Raw)
+		if diffErr != nil {
+			resp.Diagnostics.AddError("Unable to Compute Changes", fmt.Sprintf("Unable to compare plan to state, got error: %s", diffErr))
+			return
+		}
+		resp.Diagnostics.Append(tfsdk.Plan{Schema: req.Plan.Schema, Raw: raw}.Get(ctx, &planned)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		updateReq := r.buildUpdateRequest(&planned)
+		updateReq.IfMatch = etag
+		if fields := client.ChangedMonitorFields(r.buildUpdateRequest(&prior), updateReq); len(fields) > 0 {
+			monitor, err = r.client.PatchMonitor(ctx, data.ID.ValueString(), updateReq, fields)
+		} else {
+			monitor, err = r.client.GetMonitor(ctx, data.ID.ValueString())
+		}
+	} else {
+		updateReq := r.buildUpdateRequest(&data)
+		updateReq.IfMatch = etag
+		monitor, err = r.client.UpdateMonitor(ctx, data.ID.ValueString(), updateReq)
+	}
 	if err != nil {
+		if client.IsPreconditionFailedError(err) {
+			addChangedOutsideTerraformError(&resp.Diagnostics, "monitor", data.ID.ValueString())
+			return
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update monitor, got error: %s", err))
 		return
 	}
 
 	r.updateModelFromResponse(&data, monitor)
+	resp.Diagnostics.Append(setPrivateETag(ctx, resp.Private, monitor.ETag)...)
+	r.readEffectiveSchedule(ctx, &data, &resp.Diagnostics)
+	r.readUnmanagedAlerts(ctx, &data, &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.ID)...)
+}
+
+// planWithPriorUnknowns returns the plan with its unknown values, which the
+// API computes, replaced by their values in the prior state.
+func planWithPriorUnknowns(plan, state tftypes.Value) (tftypes.Value, error) {
+	return tftypes.Transform(plan, func(p *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		if v.IsKnown() {
+			return v, nil
+		}
+		prior, _, err := tftypes.WalkAttributePath(state, p)
+		if err != nil {
+			return v, nil
+		}
+		if priorValue, ok := prior.(tftypes.Value); ok {
+			return priorValue, nil
+		}
+		return v, nil
+	})
 }
 
 func (r *MonitorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
 	var data MonitorResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -326,6 +1456,39 @@ func (r *MonitorResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	// Plain destroys were counted when planned; replacements are only known
+	// here.
+	if err := r.client.ReserveDestroy(data.ID.ValueString()); err != nil {
+		addDestroyLimitError(&resp.Diagnostics, err)
+		return
+	}
+
+	if data.PreventDestroyWithOpenIncidents.ValueBool() && os.Getenv(allowDestroyWithOpenIncidentsEnv) != "true" {
+		incidents, err := r.client.GetMonitorIncidents(ctx, data.ID.ValueString(), 0)
+		if err != nil && !client.IsNotFoundError(err) {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read monitor incidents, got error: %s", err))
+			return
+		}
+
+		var open []string
+		for _, incident := range incidents {
+			if incident.ResolvedAt == "" {
+				open = append(open, incident.ID)
+			}
+		}
+		if len(open) > 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("prevent_destroy_with_open_incidents"),
+				"Monitor Has Open Incidents",
+				fmt.Sprintf("Monitor %s was not destroyed because it has %d unresolved incidents (%s) and prevent_destroy_with_open_incidents is true. "+
+					"Resolve the incidents first, for example with the ackack_monitor_reset_incidents action, set "+
+					"prevent_destroy_with_open_incidents to false and apply, or set %s=true to destroy it anyway.",
+					data.ID.ValueString(), len(open), strings.Join(open, ", "), allowDestroyWithOpenIncidentsEnv),
+			)
+			return
+		}
+	}
+
 	err := r.client.DeleteMonitor(ctx, data.ID.ValueString())
 	if err != nil {
 		if client.IsNotFoundError(err) {
@@ -336,14 +1499,59 @@ func (r *MonitorResource) Delete(ctx context.Context, req resource.DeleteRequest
 	}
 }
 
+func (r *MonitorResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// A monitor created with start_paused stays disabled until an
+	// ackack_monitor_activation enables it.
+	if req.State.Raw.IsNull() && !req.Plan.Raw.IsNull() {
+		var startPaused types.Bool
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("start_paused"), &startPaused)...)
+		if startPaused.ValueBool() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("is_enabled"), false)...)
+		}
+		return
+	}
+
+	// Only destroys are counted against max_destroy. Replacements are
+	// decided by Terraform after this plan, so Delete counts them.
+	if r.client == nil || req.State.Raw.IsNull() || !req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var id types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.client.ReserveDestroy(id.ValueString()); err != nil {
+		addDestroyLimitError(&resp.Diagnostics, err)
+	}
+}
+
+// addDestroyLimitError reports a run stopped by max_destroy.
+func addDestroyLimitError(diags *diag.Diagnostics, err error) {
+	diags.AddError(
+		"Destroy Limit Exceeded",
+		fmt.Sprintf("The run was stopped because %s, which exceeds the provider's max_destroy setting. "+
+			"Replacements count as destroys. Review the plan, then raise or remove max_destroy if the deletions are intended.", err),
+	)
+}
+
+// UpgradeState returns the state upgraders from each previous schema version.
+// When a restructure bumps the schema Version, add an upgrader keyed by the
+// previous version, with that version's schema as its PriorSchema, and
+// record the new version in statecompat.SchemaVersions.
+func (r *MonitorResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
 func (r *MonitorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 func (r *MonitorResource) buildCreateRequest(data *MonitorResourceModel) client.CreateMonitorRequest {
 	req := client.CreateMonitorRequest{
 		Name: data.Name.ValueString(),
-		Type: data.Type.ValueString(),
+		Type: client.MonitorType(data.Type.ValueString()),
 	}
 
 	if !data.IsEnabled.IsNull() {
@@ -365,10 +1573,19 @@ func (r *MonitorResource) buildCreateRequest(data *MonitorResourceModel) client.
 	if !data.SpecificRegion.IsNull() {
 		req.SpecificRegion = data.SpecificRegion.ValueString()
 	}
+	req.Regions = stringsFromSetValue(data.Regions)
+	if !data.MinRegionsFailing.IsNull() {
+		req.MinRegionsFailing = int(data.MinRegionsFailing.ValueInt64())
+	}
+	if !data.IPVersion.IsNull() {
+		req.IPVersion = data.IPVersion.ValueString()
+	}
 
 	// HTTP specific
 	if !data.URL.IsNull() {
 		req.URL = data.URL.ValueString()
+	} else if usesURLComponents(data) {
+		req.URL = composeMonitorURL(data)
 	}
 	if !data.ExpectedStatusCode.IsNull() {
 		req.ExpectedStatusCode = int(data.ExpectedStatusCode.ValueInt64())
@@ -383,10 +1600,37 @@ func (r *MonitorResource) buildCreateRequest(data *MonitorResourceModel) client.
 	}
 	if !data.BodyPattern.IsNull() {
 		req.BodyPattern = data.BodyPattern.ValueString()
+		if !data.BodyPatternMode.IsUnknown() {
+			req.BodyPatternMode = data.BodyPatternMode.ValueString()
+		}
+		if !data.BodyPatternIsRegex.IsNull() {
+			isRegex := data.BodyPatternIsRegex.ValueBool()
+			req.BodyPatternIsRegex = &isRegex
+		}
+	}
+	if !data.ResponseSchema.IsNull() {
+		req.ResponseSchema = data.ResponseSchema.ValueString()
+	}
+	if !data.FollowRedirects.IsNull() {
+		followRedirects := data.FollowRedirects.ValueBool()
+		req.FollowRedirects = &followRedirects
+	}
+	if !data.MaxRedirects.IsNull() {
+		req.MaxRedirects = int(data.MaxRedirects.ValueInt64())
 	}
 	if !data.Headers.IsNull() {
 		req.Headers = data.Headers.ValueString()
 	}
+	if !data.ClientCertPEM.IsNull() {
+		req.ClientCertPEM = data.ClientCertPEM.ValueString()
+		req.ClientKeyPEM = data.ClientKeyPEM.ValueString()
+	}
+	if !data.ResolveToIP.IsNull() {
+		req.ResolveToIP = data.ResolveToIP.ValueString()
+	}
+	if !data.HostHeader.IsNull() {
+		req.HostHeader = data.HostHeader.ASCII()
+	}
 
 	// DNS specific
 	if !data.DNSRecordType.IsNull() {
@@ -395,21 +1639,33 @@ func (r *MonitorResource) buildCreateRequest(data *MonitorResourceModel) client.
 	if !data.ExpectedValue.IsNull() {
 		req.ExpectedValue = data.ExpectedValue.ValueString()
 	}
+	req.ExpectedRecords = expectedRecordsToClient(data.ExpectedRecords)
 	if !data.Nameserver.IsNull() {
 		req.Nameserver = data.Nameserver.ValueString()
 	}
+	if !data.ValidateDNSSEC.IsNull() {
+		validateDNSSEC := data.ValidateDNSSEC.ValueBool()
+		req.ValidateDNSSEC = &validateDNSSEC
+	}
 
 	// TCP specific
-	if !data.Host.IsNull() {
-		req.Host = data.Host.ValueString()
+	if !data.Host.IsNull() && !usesURLComponents(data) {
+		req.Host = data.Host.ASCII()
 	}
-	if !data.Port.IsNull() {
+	if !data.Port.IsNull() && !usesURLComponents(data) {
 		req.Port = int(data.Port.ValueInt64())
 	}
+	if !data.UseTLS.IsNull() {
+		useTLS := data.UseTLS.ValueBool()
+		req.UseTLS = &useTLS
+	}
 
 	// SSL specific
 	if !data.Domain.IsNull() {
-		req.Domain = data.Domain.ValueString()
+		req.Domain = data.Domain.ASCII()
+	}
+	if !data.ServerName.IsNull() {
+		req.ServerName = data.ServerName.ASCII()
 	}
 	if !data.CheckExpirationThreshold.IsNull() {
 		checkExp := data.CheckExpirationThreshold.ValueBool()
@@ -425,6 +1681,44 @@ func (r *MonitorResource) buildCreateRequest(data *MonitorResourceModel) client.
 	if !data.MinimumProtocol.IsNull() {
 		req.MinimumProtocol = data.MinimumProtocol.ValueString()
 	}
+	if !data.CheckChain.IsNull() {
+		checkChain := data.CheckChain.ValueBool()
+		req.CheckChain = &checkChain
+	}
+	if !data.ExpectedIssuer.IsNull() {
+		req.ExpectedIssuer = data.ExpectedIssuer.ValueString()
+	}
+	if !data.CheckRevocation.IsNull() {
+		checkRevocation := data.CheckRevocation.ValueBool()
+		req.CheckRevocation = &checkRevocation
+	}
+	if !data.ExpectedFingerprintSHA256.IsNull() {
+		req.ExpectedFingerprintSHA256 = normalizeFingerprint(data.ExpectedFingerprintSHA256.ValueString())
+	}
+
+	// Result storage
+	req.ResultSampling = resultSamplingToClient(data.ResultSampling)
+	req.PhaseThresholds = phaseThresholdsToClient(data.PhaseThresholds)
+	req.ThresholdWindows = thresholdWindowsToClient(data.ThresholdWindows)
+	req.Schedule = scheduleToClient(data.Schedule)
+
+	// Incident handling
+	req.SeverityMapping = stringMapFromValue(data.SeverityMapping)
+	if !data.AutoResolveAfterMinutes.IsNull() {
+		req.AutoResolveAfterMinutes = int(data.AutoResolveAfterMinutes.ValueInt64())
+	}
+	if !data.ReopenWindowMinutes.IsNull() {
+		req.ReopenWindowMinutes = int(data.ReopenWindowMinutes.ValueInt64())
+	}
+	req.DependsOnMonitorIDs = stringsFromSetValue(data.DependsOnMonitorIDs)
+	if !data.InheritMaintenance.IsNull() {
+		inheritMaintenance := data.InheritMaintenance.ValueBool()
+		req.InheritMaintenance = &inheritMaintenance
+	}
+	if !data.PauseDuringMaintenance.IsNull() {
+		pauseDuringMaintenance := data.PauseDuringMaintenance.ValueBool()
+		req.PauseDuringMaintenance = &pauseDuringMaintenance
+	}
 
 	return req
 }
@@ -432,7 +1726,7 @@ func (r *MonitorResource) buildCreateRequest(data *MonitorResourceModel) client.
 func (r *MonitorResource) buildUpdateRequest(data *MonitorResourceModel) client.UpdateMonitorRequest {
 	req := client.UpdateMonitorRequest{
 		Name: data.Name.ValueString(),
-		Type: data.Type.ValueString(),
+		Type: client.MonitorType(data.Type.ValueString()),
 	}
 
 	if !data.IsEnabled.IsNull() {
@@ -454,10 +1748,19 @@ func (r *MonitorResource) buildUpdateRequest(data *MonitorResourceModel) client.
 	if !data.SpecificRegion.IsNull() {
 		req.SpecificRegion = data.SpecificRegion.ValueString()
 	}
+	req.Regions = stringsFromSetValue(data.Regions)
+	if !data.MinRegionsFailing.IsNull() {
+		req.MinRegionsFailing = int(data.MinRegionsFailing.ValueInt64())
+	}
+	if !data.IPVersion.IsNull() {
+		req.IPVersion = data.IPVersion.ValueString()
+	}
 
 	// HTTP specific
 	if !data.URL.IsNull() {
 		req.URL = data.URL.ValueString()
+	} else if usesURLComponents(data) {
+		req.URL = composeMonitorURL(data)
 	}
 	if !data.ExpectedStatusCode.IsNull() {
 		req.ExpectedStatusCode = int(data.ExpectedStatusCode.ValueInt64())
@@ -472,10 +1775,37 @@ func (r *MonitorResource) buildUpdateRequest(data *MonitorResourceModel) client.
 	}
 	if !data.BodyPattern.IsNull() {
 		req.BodyPattern = data.BodyPattern.ValueString()
+		if !data.BodyPatternMode.IsUnknown() {
+			req.BodyPatternMode = data.BodyPatternMode.ValueString()
+		}
+		if !data.BodyPatternIsRegex.IsNull() {
+			isRegex := data.BodyPatternIsRegex.ValueBool()
+			req.BodyPatternIsRegex = &isRegex
+		}
+	}
+	if !data.ResponseSchema.IsNull() {
+		req.ResponseSchema = data.ResponseSchema.ValueString()
+	}
+	if !data.FollowRedirects.IsNull() {
+		followRedirects := data.FollowRedirects.ValueBool()
+		req.FollowRedirects = &followRedirects
+	}
+	if !data.MaxRedirects.IsNull() {
+		req.MaxRedirects = int(data.MaxRedirects.ValueInt64())
 	}
 	if !data.Headers.IsNull() {
 		req.Headers = data.Headers.ValueString()
 	}
+	if !data.ClientCertPEM.IsNull() {
+		req.ClientCertPEM = data.ClientCertPEM.ValueString()
+		req.ClientKeyPEM = data.ClientKeyPEM.ValueString()
+	}
+	if !data.ResolveToIP.IsNull() {
+		req.ResolveToIP = data.ResolveToIP.ValueString()
+	}
+	if !data.HostHeader.IsNull() {
+		req.HostHeader = data.HostHeader.ASCII()
+	}
 
 	// DNS specific
 	if !data.DNSRecordType.IsNull() {
@@ -484,21 +1814,33 @@ func (r *MonitorResource) buildUpdateRequest(data *MonitorResourceModel) client.
 	if !data.ExpectedValue.IsNull() {
 		req.ExpectedValue = data.ExpectedValue.ValueString()
 	}
+	req.ExpectedRecords = expectedRecordsToClient(data.ExpectedRecords)
 	if !data.Nameserver.IsNull() {
 		req.Nameserver = data.Nameserver.ValueString()
 	}
+	if !data.ValidateDNSSEC.IsNull() {
+		validateDNSSEC := data.ValidateDNSSEC.ValueBool()
+		req.ValidateDNSSEC = &validateDNSSEC
+	}
 
 	// TCP specific
-	if !data.Host.IsNull() {
-		req.Host = data.Host.ValueString()
+	if !data.Host.IsNull() && !usesURLComponents(data) {
+		req.Host = data.Host.ASCII()
 	}
-	if !data.Port.IsNull() {
+	if !data.Port.IsNull() && !usesURLComponents(data) {
 		req.Port = int(data.Port.ValueInt64())
 	}
+	if !data.UseTLS.IsNull() {
+		useTLS := data.UseTLS.ValueBool()
+		req.UseTLS = &useTLS
+	}
 
 	// SSL specific
 	if !data.Domain.IsNull() {
-		req.Domain = data.Domain.ValueString()
+		req.Domain = data.Domain.ASCII()
+	}
+	if !data.ServerName.IsNull() {
+		req.ServerName = data.ServerName.ASCII()
 	}
 	if !data.CheckExpirationThreshold.IsNull() {
 		checkExp := data.CheckExpirationThreshold.ValueBool()
@@ -514,10 +1856,82 @@ func (r *MonitorResource) buildUpdateRequest(data *MonitorResourceModel) client.
 	if !data.MinimumProtocol.IsNull() {
 		req.MinimumProtocol = data.MinimumProtocol.ValueString()
 	}
+	if !data.CheckChain.IsNull() {
+		checkChain := data.CheckChain.ValueBool()
+		req.CheckChain = &checkChain
+	}
+	if !data.ExpectedIssuer.IsNull() {
+		req.ExpectedIssuer = data.ExpectedIssuer.ValueString()
+	}
+	if !data.CheckRevocation.IsNull() {
+		checkRevocation := data.CheckRevocation.ValueBool()
+		req.CheckRevocation = &checkRevocation
+	}
+	if !data.ExpectedFingerprintSHA256.IsNull() {
+		req.ExpectedFingerprintSHA256 = normalizeFingerprint(data.ExpectedFingerprintSHA256.ValueString())
+	}
+
+	// Result storage
+	req.ResultSampling = resultSamplingToClient(data.ResultSampling)
+	req.PhaseThresholds = phaseThresholdsToClient(data.PhaseThresholds)
+	req.ThresholdWindows = thresholdWindowsToClient(data.ThresholdWindows)
+	req.Schedule = scheduleToClient(data.Schedule)
+
+	// Incident handling
+	req.SeverityMapping = stringMapFromValue(data.SeverityMapping)
+	if !data.AutoResolveAfterMinutes.IsNull() {
+		req.AutoResolveAfterMinutes = int(data.AutoResolveAfterMinutes.ValueInt64())
+	}
+	if !data.ReopenWindowMinutes.IsNull() {
+		req.ReopenWindowMinutes = int(data.ReopenWindowMinutes.ValueInt64())
+	}
+	req.DependsOnMonitorIDs = stringsFromSetValue(data.DependsOnMonitorIDs)
+	if !data.InheritMaintenance.IsNull() {
+		inheritMaintenance := data.InheritMaintenance.ValueBool()
+		req.InheritMaintenance = &inheritMaintenance
+	}
+	if !data.PauseDuringMaintenance.IsNull() {
+		pauseDuringMaintenance := data.PauseDuringMaintenance.ValueBool()
+		req.PauseDuringMaintenance = &pauseDuringMaintenance
+	}
 
 	return req
 }
 
+// usesURLComponents reports whether an HTTP monitor is configured with host,
+// port and path instead of a full url.
+func usesURLComponents(data *MonitorResourceModel) bool {
+	return client.MonitorType(data.Type.ValueString()) == client.MonitorTypeHTTP && data.URL.IsNull() && !data.Host.IsNull()
+}
+
+// composeMonitorURL builds the URL of an HTTP monitor from its components,
+// leaving out the port when it is the default for the scheme.
+func composeMonitorURL(data *MonitorResourceModel) string {
+	u := url.URL{
+		Scheme: "https",
+		Host:   data.Host.ASCII(),
+		Path:   data.Path.ValueString(),
+	}
+	if !data.Scheme.IsNull() {
+		u.Scheme = data.Scheme.ValueString()
+	}
+
+	if !data.Port.IsNull() {
+		port := data.Port.ValueInt64()
+		if !(u.Scheme == "https" && port == 443) && !(u.Scheme == "http" && port == 80) {
+			u.Host = net.JoinHostPort(u.Host, strconv.FormatInt(port, 10))
+		}
+	}
+
+	return u.String()
+}
+
+// normalizeFingerprint converts a certificate fingerprint to lowercase hex
+// without separators.
+func normalizeFingerprint(fingerprint string) string {
+	return strings.ToLower(strings.ReplaceAll(fingerprint, ":", ""))
+}
+
 // normalizeTimestamp parses a timestamp and re-formats it with microsecond
 // precision so that values stored in state always match what the API returns.
 func normalizeTimestamp(ts string) string {
@@ -528,15 +1942,106 @@ func normalizeTimestamp(ts string) string {
 	return t.Round(time.Microsecond).Format("2006-01-02T15:04:05.999999Z07:00")
 }
 
+// readEffectiveSchedule sets effective_schedule from the monitor's health.
+// The schedule is informational, so it is left null on servers without
+// monitor health, and other failures are reported as warnings.
+func (r *MonitorResource) readEffectiveSchedule(ctx context.Context, data *MonitorResourceModel, diags *diag.Diagnostics) {
+	data.EffectiveSchedule = types.ObjectNull(effectiveScheduleAttrTypes)
+	if !r.client.SupportsFeature("monitor_health") {
+		return
+	}
+
+	health, err := r.client.GetMonitorHealth(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFoundError(err) {
+			return
+		}
+		diags.AddWarning("Unable to Read Effective Schedule", fmt.Sprintf("Unable to read effective schedule of monitor %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+	if health.EffectiveFrequencySeconds == 0 {
+		return
+	}
+
+	nextCheckAt := types.StringNull()
+	if health.NextCheckAt != "" {
+		nextCheckAt = types.StringValue(health.NextCheckAt)
+	}
+	throttleReason := types.StringNull()
+	if health.ThrottleReason != "" {
+		throttleReason = types.StringValue(health.ThrottleReason)
+	}
+
+	schedule, d := types.ObjectValue(effectiveScheduleAttrTypes, map[string]attr.Value{
+		"frequency_seconds": types.Int64Value(int64(health.EffectiveFrequencySeconds)),
+		"throttled":         types.BoolValue(health.Throttled),
+		"throttle_reason":   throttleReason,
+		"dampening_level":   types.Int64Value(int64(health.DampeningLevel)),
+		"next_check_at":     nextCheckAt,
+	})
+	diags.Append(d...)
+	data.EffectiveSchedule = schedule
+}
+
+// readUnmanagedAlerts sets unmanaged_alerts to the alerts on the monitor that
+// were not created or last updated by this provider, and reports them as
+// drift when adopt_unmanaged_alerts is false.
+func (r *MonitorResource) readUnmanagedAlerts(ctx context.Context, data *MonitorResourceModel, diags *diag.Diagnostics) {
+	data.UnmanagedAlerts = types.ListNull(types.ObjectType{AttrTypes: unmanagedAlertAttrTypes})
+	if data.AdoptUnmanagedAlerts.IsNull() {
+		return
+	}
+
+	alertsByMonitor, err := r.client.AlertsByMonitor(ctx)
+	if err != nil {
+		diags.AddWarning("Unable to Read Unmanaged Alerts", fmt.Sprintf("Unable to list alerts of monitor %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	var unmanaged []attr.Value
+	var ids []string
+	for _, alert := range alertsByMonitor[data.ID.ValueString()] {
+		if alert.ManagedBy == alertManagedBy {
+			continue
+		}
+		target := types.StringNull()
+		if alert.Target != "" {
+			target = types.StringValue(alert.Target)
+		}
+		value, d := types.ObjectValue(unmanagedAlertAttrTypes, map[string]attr.Value{
+			"id":         types.StringValue(alert.ID),
+			"type":       types.StringValue(string(alert.Type)),
+			"target":     target,
+			"is_enabled": types.BoolValue(alert.IsEnabled),
+		})
+		diags.Append(d...)
+		unmanaged = append(unmanaged, value)
+		ids = append(ids, alert.ID)
+	}
+
+	list, d := types.ListValue(types.ObjectType{AttrTypes: unmanagedAlertAttrTypes}, unmanaged)
+	diags.Append(d...)
+	data.UnmanagedAlerts = list
+
+	if len(ids) > 0 && !data.AdoptUnmanagedAlerts.ValueBool() {
+		diags.AddWarning(
+			"Unmanaged Alerts",
+			fmt.Sprintf("Monitor %s has alerts that are not managed by Terraform: %s. "+
+				"Import them as ackack_alert resources, delete them, or set adopt_unmanaged_alerts to true to accept them.",
+				data.ID.ValueString(), strings.Join(ids, ", ")),
+		)
+	}
+}
+
 func (r *MonitorResource) updateModelFromResponse(data *MonitorResourceModel, monitor *client.Monitor) {
 	data.ID = types.StringValue(monitor.ID)
 	data.Name = types.StringValue(monitor.Name)
-	data.Type = types.StringValue(monitor.Type)
+	data.Type = types.StringValue(string(monitor.Type))
 	data.IsEnabled = types.BoolValue(monitor.IsEnabled)
 	data.FrequencySeconds = types.Int64Value(int64(monitor.FrequencySeconds))
 	data.TimeoutMs = types.Int64Value(int64(monitor.TimeoutMs))
 	data.Retries = types.Int64Value(int64(monitor.Retries))
-	data.Status = types.StringValue(monitor.Status)
+	data.Status = types.StringValue(string(monitor.Status))
 	data.UptimePercentage = types.Float64Value(monitor.UptimePercentage)
 	data.CreatedAt = types.StringValue(normalizeTimestamp(monitor.CreatedAt))
 	data.UpdatedAt = types.StringValue(normalizeTimestamp(monitor.UpdatedAt))
@@ -552,6 +2057,15 @@ func (r *MonitorResource) updateModelFromResponse(data *MonitorResourceModel, mo
 	} else if data.SpecificRegion.IsUnknown() {
 		data.SpecificRegion = types.StringNull()
 	}
+	if len(monitor.Regions) > 0 {
+		data.Regions = stringSetValue(monitor.Regions)
+	}
+	if monitor.MinRegionsFailing != 0 && !data.Regions.IsNull() {
+		data.MinRegionsFailing = types.Int64Value(int64(monitor.MinRegionsFailing))
+	}
+	if monitor.IPVersion != "" && !data.IPVersion.IsNull() {
+		data.IPVersion = types.StringValue(monitor.IPVersion)
+	}
 	// Computed field - must always be set to a known value
 	if monitor.LastChecked != "" {
 		data.LastChecked = types.StringValue(normalizeTimestamp(monitor.LastChecked))
@@ -560,7 +2074,9 @@ func (r *MonitorResource) updateModelFromResponse(data *MonitorResourceModel, mo
 	}
 
 	// HTTP specific
-	if monitor.URL != "" {
+	// Monitors configured with URL components keep them as configured; the
+	// API only stores the composed URL.
+	if monitor.URL != "" && !usesURLComponents(data) {
 		data.URL = types.StringValue(monitor.URL)
 	}
 	if monitor.ExpectedStatusCode != 0 {
@@ -571,9 +2087,37 @@ func (r *MonitorResource) updateModelFromResponse(data *MonitorResourceModel, mo
 	if monitor.BodyPattern != "" {
 		data.BodyPattern = types.StringValue(monitor.BodyPattern)
 	}
+	if monitor.BodyPatternMode != "" {
+		data.BodyPatternMode = types.StringValue(monitor.BodyPatternMode)
+	} else if data.BodyPatternMode.IsUnknown() {
+		data.BodyPatternMode = types.StringNull()
+	}
+	if monitor.BodyPatternIsRegex || !data.BodyPatternIsRegex.IsNull() {
+		data.BodyPatternIsRegex = types.BoolValue(monitor.BodyPatternIsRegex)
+	}
+	if monitor.ResponseSchema != "" && !jsonEqual(data.ResponseSchema.ValueString(), monitor.ResponseSchema) {
+		data.ResponseSchema = types.StringValue(monitor.ResponseSchema)
+	}
+	if monitor.FollowRedirects != nil && !data.FollowRedirects.IsNull() {
+		data.FollowRedirects = types.BoolPointerValue(monitor.FollowRedirects)
+	}
+	if monitor.MaxRedirects != 0 {
+		data.MaxRedirects = types.Int64Value(int64(monitor.MaxRedirects))
+	}
 	if monitor.Headers != "" {
 		data.Headers = types.StringValue(monitor.Headers)
 	}
+	if monitor.ResolveToIP != "" {
+		data.ResolveToIP = types.StringValue(monitor.ResolveToIP)
+	}
+	if monitor.HostHeader != "" {
+		data.HostHeader = NewHostnameValue(monitor.HostHeader)
+	}
+	// The API does not return the private key, so client_key_pem keeps its
+	// configured value.
+	if monitor.ClientCertPEM != "" && strings.TrimSpace(monitor.ClientCertPEM) != strings.TrimSpace(data.ClientCertPEM.ValueString()) {
+		data.ClientCertPEM = types.StringValue(monitor.ClientCertPEM)
+	}
 
 	// DNS specific
 	if monitor.DNSRecordType != "" {
@@ -585,18 +2129,27 @@ func (r *MonitorResource) updateModelFromResponse(data *MonitorResourceModel, mo
 	if monitor.Nameserver != "" {
 		data.Nameserver = types.StringValue(monitor.Nameserver)
 	}
+	if monitor.ValidateDNSSEC || !data.ValidateDNSSEC.IsNull() {
+		data.ValidateDNSSEC = types.BoolValue(monitor.ValidateDNSSEC)
+	}
 
 	// TCP specific
-	if monitor.Host != "" {
-		data.Host = types.StringValue(monitor.Host)
+	if monitor.Host != "" && !usesURLComponents(data) {
+		data.Host = NewHostnameValue(monitor.Host)
 	}
-	if monitor.Port != 0 {
+	if monitor.Port != 0 && !usesURLComponents(data) {
 		data.Port = types.Int64Value(int64(monitor.Port))
 	}
+	if monitor.UseTLS || !data.UseTLS.IsNull() {
+		data.UseTLS = types.BoolValue(monitor.UseTLS)
+	}
 
 	// SSL specific
 	if monitor.Domain != "" {
-		data.Domain = types.StringValue(monitor.Domain)
+		data.Domain = NewHostnameValue(monitor.Domain)
+	}
+	if monitor.ServerName != "" {
+		data.ServerName = NewHostnameValue(monitor.ServerName)
 	}
 	data.CheckExpirationThreshold = types.BoolValue(monitor.CheckExpirationThreshold)
 	if monitor.ExpirationThreshold != 0 {
@@ -606,4 +2159,216 @@ func (r *MonitorResource) updateModelFromResponse(data *MonitorResourceModel, mo
 	if monitor.MinimumProtocol != "" {
 		data.MinimumProtocol = types.StringValue(monitor.MinimumProtocol)
 	}
+	data.CheckChain = types.BoolValue(monitor.CheckChain)
+	if monitor.ExpectedIssuer != "" {
+		data.ExpectedIssuer = types.StringValue(monitor.ExpectedIssuer)
+	}
+	data.CheckRevocation = types.BoolValue(monitor.CheckRevocation)
+	// Keep the configured notation when it matches the API's fingerprint.
+	if monitor.ExpectedFingerprintSHA256 != "" &&
+		normalizeFingerprint(data.ExpectedFingerprintSHA256.ValueString()) != normalizeFingerprint(monitor.ExpectedFingerprintSHA256) {
+		data.ExpectedFingerprintSHA256 = types.StringValue(monitor.ExpectedFingerprintSHA256)
+	}
+
+	// Result storage
+	if monitor.ResultSampling != nil {
+		data.ResultSampling = resultSamplingFromClient(monitor.ResultSampling)
+	}
+	if monitor.PhaseThresholds != nil {
+		data.PhaseThresholds = phaseThresholdsFromClient(monitor.PhaseThresholds)
+	}
+	data.ThresholdWindows = thresholdWindowsFromClient(monitor.ThresholdWindows)
+	data.Schedule = scheduleFromClient(monitor.Schedule)
+	data.ExpectedRecords = expectedRecordsFromClient(monitor.ExpectedRecords)
+
+	// Incident handling
+	if len(monitor.SeverityMapping) > 0 {
+		data.SeverityMapping = stringMapValue(monitor.SeverityMapping)
+	}
+	if monitor.AutoResolveAfterMinutes != 0 {
+		data.AutoResolveAfterMinutes = types.Int64Value(int64(monitor.AutoResolveAfterMinutes))
+	}
+	if monitor.ReopenWindowMinutes != 0 {
+		data.ReopenWindowMinutes = types.Int64Value(int64(monitor.ReopenWindowMinutes))
+	}
+	if len(monitor.DependsOnMonitorIDs) > 0 {
+		data.DependsOnMonitorIDs = stringSetValue(monitor.DependsOnMonitorIDs)
+	}
+	if monitor.InheritMaintenance || !data.InheritMaintenance.IsNull() {
+		data.InheritMaintenance = types.BoolValue(monitor.InheritMaintenance)
+	}
+	if monitor.PauseDuringMaintenance || !data.PauseDuringMaintenance.IsNull() {
+		data.PauseDuringMaintenance = types.BoolValue(monitor.PauseDuringMaintenance)
+	}
+}
+
+// phaseThresholdAttributes are the attributes of phase_thresholds, shared by
+// the monitor and its threshold windows.
+func phaseThresholdAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"dns_ms": schema.Int64Attribute{
+			MarkdownDescription: "Maximum DNS lookup time.",
+			Optional:            true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"connect_ms": schema.Int64Attribute{
+			MarkdownDescription: "Maximum TCP connect time.",
+			Optional:            true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"tls_ms": schema.Int64Attribute{
+			MarkdownDescription: "Maximum TLS handshake time.",
+			Optional:            true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"ttfb_ms": schema.Int64Attribute{
+			MarkdownDescription: "Maximum time to first byte, measured from when the request is sent.",
+			Optional:            true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"transfer_ms": schema.Int64Attribute{
+			MarkdownDescription: "Maximum time to download the response body.",
+			Optional:            true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+	}
+}
+
+func resultSamplingToClient(m *ResultSamplingModel) *client.ResultSampling {
+	if m == nil {
+		return nil
+	}
+	sampling := &client.ResultSampling{
+		SuccessSampleRate: int(m.SuccessSampleRate.ValueInt64()),
+		StoreAllFailures:  true,
+	}
+	if !m.StoreAllFailures.IsNull() && !m.StoreAllFailures.IsUnknown() {
+		sampling.StoreAllFailures = m.StoreAllFailures.ValueBool()
+	}
+	return sampling
+}
+
+func phaseThresholdsToClient(m *PhaseThresholdsModel) *client.PhaseTimings {
+	if m == nil {
+		return nil
+	}
+	return &client.PhaseTimings{
+		DNSMs:      int(m.DNSMs.ValueInt64()),
+		ConnectMs:  int(m.ConnectMs.ValueInt64()),
+		TLSMs:      int(m.TLSMs.ValueInt64()),
+		TTFBMs:     int(m.TTFBMs.ValueInt64()),
+		TransferMs: int(m.TransferMs.ValueInt64()),
+	}
+}
+
+func phaseThresholdsFromClient(thresholds *client.PhaseTimings) *PhaseThresholdsModel {
+	return &PhaseThresholdsModel{
+		DNSMs:      optionalInt64Value(thresholds.DNSMs),
+		ConnectMs:  optionalInt64Value(thresholds.ConnectMs),
+		TLSMs:      optionalInt64Value(thresholds.TLSMs),
+		TTFBMs:     optionalInt64Value(thresholds.TTFBMs),
+		TransferMs: optionalInt64Value(thresholds.TransferMs),
+	}
+}
+
+// optionalInt64Value returns a null value for 0, which the API uses for
+// unset integers.
+func optionalInt64Value(v int) types.Int64 {
+	if v == 0 {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(v))
+}
+
+// stringMapFromValue converts a known map of strings into a Go map, returning
+// nil for null or unknown values.
+func stringMapFromValue(m types.Map) map[string]string {
+	if m.IsNull() || m.IsUnknown() {
+		return nil
+	}
+	result := make(map[string]string, len(m.Elements()))
+	for k, v := range m.Elements() {
+		if s, ok := v.(types.String); ok {
+			result[k] = s.ValueString()
+		}
+	}
+	return result
+}
+
+// stringsFromSetValue converts a known set of strings into a Go slice,
+// returning nil for null or unknown values.
+func stringsFromSetValue(s types.Set) []string {
+	if s.IsNull() || s.IsUnknown() {
+		return nil
+	}
+	result := make([]string, 0, len(s.Elements()))
+	for _, v := range s.Elements() {
+		if str, ok := v.(types.String); ok {
+			result = append(result, str.ValueString())
+		}
+	}
+	return result
+}
+
+// stringMapValue converts a Go map of strings into a map value.
+func stringMapValue(m map[string]string) types.Map {
+	elements := make(map[string]attr.Value, len(m))
+	for k, v := range m {
+		elements[k] = types.StringValue(v)
+	}
+	return types.MapValueMust(types.StringType, elements)
+}
+
+func stringSetValue(s []string) types.Set {
+	elements := make([]attr.Value, len(s))
+	for i, v := range s {
+		elements[i] = types.StringValue(v)
+	}
+	return types.SetValueMust(types.StringType, elements)
+}
+
+func resultSamplingFromClient(sampling *client.ResultSampling) *ResultSamplingModel {
+	return &ResultSamplingModel{
+		SuccessSampleRate: types.Int64Value(int64(sampling.SuccessSampleRate)),
+		StoreAllFailures:  types.BoolValue(sampling.StoreAllFailures),
+	}
+}
+
+// validateCheckTiming checks that a check and all its retries can time out
+// before the next check is due, reporting errors at the timeout_ms path p.
+// Null values take their defaults; retries are then assumed to be 0.
+func validateCheckTiming(p path.Path, frequencySeconds, timeoutMs, retries types.Int64, diags *diag.Diagnostics) {
+	if frequencySeconds.IsUnknown() || timeoutMs.IsUnknown() || retries.IsUnknown() {
+		return
+	}
+
+	frequency := int64(defaultFrequencySeconds)
+	if !frequencySeconds.IsNull() {
+		frequency = frequencySeconds.ValueInt64()
+	}
+	timeout := int64(defaultTimeoutMs)
+	if !timeoutMs.IsNull() {
+		timeout = timeoutMs.ValueInt64()
+	}
+	attempts := retries.ValueInt64() + 1
+
+	if total := timeout * attempts; total > frequency*1000 {
+		diags.AddAttributeError(
+			p,
+			"Invalid Check Timing",
+			fmt.Sprintf("A check with timeout_ms %d and %d retries can take up to %d ms, longer than the frequency_seconds of %d, "+
+				"so it would overlap the next check. Lower timeout_ms or retries, or raise frequency_seconds.",
+				timeout, attempts-1, total, frequency),
+		)
+	}
 }
@@ -0,0 +1,359 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/ackack-io/terraform-provider-ackack/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &MonitorSetResource{}
+var _ resource.ResourceWithImportState = &MonitorSetResource{}
+var _ resource.ResourceWithModifyPlan = &MonitorSetResource{}
+
+func NewMonitorSetResource() resource.Resource {
+	return &MonitorSetResource{}
+}
+
+// MonitorSetResource defines the resource implementation.
+type MonitorSetResource struct {
+	client *client.Client
+}
+
+// MonitorSetResourceModel describes the resource data model.
+type MonitorSetResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	MonitorIDs      types.Set    `tfsdk:"monitor_ids"`
+	DeleteOnDestroy types.Bool   `tfsdk:"delete_on_destroy"`
+	Monitors        types.Map    `tfsdk:"monitors"`
+}
+
+// MonitorSetItemModel describes a monitor of a set.
+type MonitorSetItemModel struct {
+	Name      types.String `tfsdk:"name"`
+	Type      types.String `tfsdk:"type"`
+	Status    types.String `tfsdk:"status"`
+	IsEnabled types.Bool   `tfsdk:"is_enabled"`
+}
+
+// monitorSetItemAttrTypes are the attribute types of a monitors element.
+var monitorSetItemAttrTypes = map[string]attr.Type{
+	"name":       types.StringType,
+	"type":       types.StringType,
+	"status":     types.StringType,
+	"is_enabled": types.BoolType,
+}
+
+// monitorSetImportAll is the import identifier that adopts every monitor of
+// the account.
+const monitorSetImportAll = "all"
+
+func (r *MonitorSetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_monitor_set"
+}
+
+func (r *MonitorSetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Adopts a group of existing monitors with a single import, for bringing a large fleet under Terraform without " +
+			"one `import` block per monitor. Import with a comma-separated list of monitor IDs, or with `all` to adopt every monitor of the account. " +
+			"The set tracks membership only; manage individual monitor settings with `ackack_monitor`. Do not manage the same monitor with both.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the set, derived from the monitors it was created or imported with.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"monitor_ids": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the monitors in the set. Every monitor must already exist.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"delete_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Whether monitors are deleted when they are removed from `monitor_ids` or the set is destroyed. " +
+					"When false, they are only released from the set and keep running. Deletions count against the provider's `max_destroy`. " +
+					"Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"monitors": schema.MapNestedAttribute{
+				MarkdownDescription: "The monitors in the set, keyed by ID.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the monitor.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of monitor.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The current status of the monitor.",
+							Computed:            true,
+						},
+						"is_enabled": schema.BoolAttribute{
+							MarkdownDescription: "Whether the monitor is enabled.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *MonitorSetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *MonitorSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
+	var data MonitorSetResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	monitorIDs := stringsFromSetValue(data.MonitorIDs)
+	data.ID = types.StringValue(monitorSetID(monitorIDs))
+	r.readMonitors(ctx, &data, monitorIDs, false, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MonitorSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
+	var data MonitorSetResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Monitors deleted outside Terraform drop out of the set, so they show
+	// up as drift instead of failing every refresh.
+	r.readMonitors(ctx, &data, stringsFromSetValue(data.MonitorIDs), true, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(data.MonitorIDs.Elements()) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MonitorSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
+	var data, state MonitorSetResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	monitorIDs := stringsFromSetValue(data.MonitorIDs)
+	if state.DeleteOnDestroy.ValueBool() {
+		r.deleteMonitors(ctx, difference(stringsFromSetValue(state.MonitorIDs), monitorIDs), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	r.readMonitors(ctx, &data, monitorIDs, false, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MonitorSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
+	var data MonitorSetResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.DeleteOnDestroy.ValueBool() {
+		r.deleteMonitors(ctx, stringsFromSetValue(data.MonitorIDs), &resp.Diagnostics)
+	}
+}
+
+// ModifyPlan counts the monitors a plan deletes against max_destroy.
+func (r *MonitorSetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || req.State.Raw.IsNull() {
+		return
+	}
+
+	var state MonitorSetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || !state.DeleteOnDestroy.ValueBool() {
+		return
+	}
+
+	deleted := stringsFromSetValue(state.MonitorIDs)
+	if !req.Plan.Raw.IsNull() {
+		var planned types.Set
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("monitor_ids"), &planned)...)
+		if resp.Diagnostics.HasError() || planned.IsUnknown() {
+			return
+		}
+		deleted = difference(deleted, stringsFromSetValue(planned))
+	}
+	if len(deleted) == 0 {
+		return
+	}
+
+	if err := r.client.ReserveDestroy(deleted...); err != nil {
+		addDestroyLimitError(&resp.Diagnostics, err)
+	}
+}
+
+func (r *MonitorSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var monitorIDs []string
+
+	if strings.TrimSpace(req.ID) == monitorSetImportAll {
+		monitors, err := r.client.ListMonitors(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list monitors, got error: %s", err))
+			return
+		}
+		for _, monitor := range monitors {
+			monitorIDs = append(monitorIDs, monitor.ID)
+		}
+	} else {
+		for _, id := range strings.Split(req.ID, ",") {
+			if id = strings.TrimSpace(id); id != "" && !slices.Contains(monitorIDs, id) {
+				monitorIDs = append(monitorIDs, id)
+			}
+		}
+	}
+
+	if len(monitorIDs) == 0 {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected a comma-separated list of monitor IDs or %q, and at least one monitor. Got: %q", monitorSetImportAll, req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), monitorSetID(monitorIDs))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("monitor_ids"), stringSetValue(monitorIDs))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("delete_on_destroy"), false)...)
+}
+
+// readMonitors sets monitors, and monitor_ids when skipMissing drops
+// monitors that no longer exist.
+func (r *MonitorSetResource) readMonitors(ctx context.Context, data *MonitorSetResourceModel, monitorIDs []string, skipMissing bool, diags *diag.Diagnostics) {
+	found := make([]string, 0, len(monitorIDs))
+	items := make(map[string]MonitorSetItemModel, len(monitorIDs))
+
+	// One list serves the whole set, rather than a request per monitor.
+	list, err := r.client.ListMonitors(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list monitors, got error: %s", err))
+		return
+	}
+	byID := make(map[string]client.Monitor, len(list))
+	for _, monitor := range list {
+		byID[monitor.ID] = monitor
+	}
+
+	for _, id := range monitorIDs {
+		monitor, ok := byID[id]
+		if !ok {
+			if skipMissing {
+				continue
+			}
+			diags.AddError("Monitor Not Found", fmt.Sprintf("The monitor %s in monitor_ids does not exist.", id))
+			return
+		}
+		found = append(found, id)
+		items[id] = MonitorSetItemModel{
+			Name:      types.StringValue(monitor.Name),
+			Type:      types.StringValue(string(monitor.Type)),
+			Status:    types.StringValue(string(monitor.Status)),
+			IsEnabled: types.BoolValue(monitor.IsEnabled),
+		}
+	}
+
+	monitors, d := types.MapValueFrom(ctx, types.ObjectType{AttrTypes: monitorSetItemAttrTypes}, items)
+	diags.Append(d...)
+	data.Monitors = monitors
+	if skipMissing {
+		data.MonitorIDs = stringSetValue(found)
+	}
+}
+
+func (r *MonitorSetResource) deleteMonitors(ctx context.Context, monitorIDs []string, diags *diag.Diagnostics) {
+	for _, id := range monitorIDs {
+		if err := r.client.DeleteMonitor(ctx, id); err != nil && !client.IsNotFoundError(err) {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to delete monitor %s, got error: %s", id, err))
+			return
+		}
+	}
+}
+
+// monitorSetID derives a stable identifier from a list of monitor IDs.
+func monitorSetID(monitorIDs []string) string {
+	sorted := slices.Sorted(slices.Values(monitorIDs))
+	sum := sha256.Sum256([]byte(strings.Join(sorted, ",")))
+	return "ms_" + hex.EncodeToString(sum[:8])
+}
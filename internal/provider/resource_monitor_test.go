@@ -36,6 +36,12 @@ func TestAccMonitorResource_HTTP(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"status", "last_checked", "updated_at", "uptime_percentage"},
 			},
+			// Import block with resource identity testing
+			{
+				ResourceName:    "ackack_monitor.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
 			// Update and Read testing
 			{
 				Config: testAccMonitorResourceConfig_HTTPUpdated(rName),
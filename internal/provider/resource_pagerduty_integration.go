@@ -0,0 +1,257 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ackack-io/terraform-provider-ackack/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PagerDutyIntegrationResource{}
+var _ resource.ResourceWithImportState = &PagerDutyIntegrationResource{}
+
+func NewPagerDutyIntegrationResource() resource.Resource {
+	return &PagerDutyIntegrationResource{}
+}
+
+// PagerDutyIntegrationResource defines the resource implementation.
+type PagerDutyIntegrationResource struct {
+	client *client.Client
+}
+
+// PagerDutyIntegrationResourceModel describes the resource data model.
+type PagerDutyIntegrationResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	ServiceName         types.String `tfsdk:"service_name"`
+	RoutingKeyWO        types.String `tfsdk:"routing_key_wo"`
+	RoutingKeyWOVersion types.Int64  `tfsdk:"routing_key_wo_version"`
+	RoutingKeyHint      types.String `tfsdk:"routing_key_hint"`
+	CreatedAt           types.String `tfsdk:"created_at"`
+	UpdatedAt           types.String `tfsdk:"updated_at"`
+}
+
+func (r *PagerDutyIntegrationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pagerduty_integration"
+}
+
+func (r *PagerDutyIntegrationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a PagerDuty integration on ackack.io. The routing key is write-only and is never stored in Terraform state; " +
+			"alerts reference the integration through `integration_id`. Requires Terraform 1.11 or later.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the integration.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the integration.",
+				Required:            true,
+			},
+			"service_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the PagerDuty service the routing key belongs to.",
+				Optional:            true,
+			},
+			"routing_key_wo": schema.StringAttribute{
+				MarkdownDescription: "The PagerDuty Events API v2 routing (integration) key. This value is write-only and is only sent " +
+					"to the API on create, or on update when `routing_key_wo_version` changes.",
+				Required:  true,
+				Sensitive: true,
+				WriteOnly: true,
+			},
+			"routing_key_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Change this value to send an updated `routing_key_wo` to the API.",
+				Optional:            true,
+			},
+			"routing_key_hint": schema.StringAttribute{
+				MarkdownDescription: "The last characters of the stored routing key, for identification.",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the integration was created.",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the integration was last updated.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *PagerDutyIntegrationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	requireFeature(c, "integrations", "PagerDuty integrations", &resp.Diagnostics)
+
+	r.client = c
+}
+
+func (r *PagerDutyIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
+	var data PagerDutyIntegrationResourceModel
+	var routingKey types.String
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only values are only available in the configuration.
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("routing_key_wo"), &routingKey)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createReq := client.CreatePagerDutyIntegrationRequest{
+		Name:       data.Name.ValueString(),
+		RoutingKey: routingKey.ValueString(),
+	}
+
+	if !data.ServiceName.IsNull() {
+		createReq.ServiceName = data.ServiceName.ValueString()
+	}
+
+	integration, err := r.client.CreatePagerDutyIntegration(ctx, createReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create PagerDuty integration, got error: %s", err))
+		return
+	}
+
+	r.updateModelFromResponse(&data, integration)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PagerDutyIntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
+	var data PagerDutyIntegrationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	integration, err := r.client.GetPagerDutyIntegration(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read PagerDuty integration, got error: %s", err))
+		return
+	}
+
+	r.updateModelFromResponse(&data, integration)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PagerDutyIntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
+	var data PagerDutyIntegrationResourceModel
+	var state PagerDutyIntegrationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateReq := client.UpdatePagerDutyIntegrationRequest{
+		Name: data.Name.ValueString(),
+	}
+
+	if !data.ServiceName.IsNull() {
+		updateReq.ServiceName = data.ServiceName.ValueString()
+	}
+
+	// Only resend the routing key when the practitioner bumps the version.
+	if !data.RoutingKeyWOVersion.Equal(state.RoutingKeyWOVersion) {
+		var routingKey types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("routing_key_wo"), &routingKey)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		updateReq.RoutingKey = routingKey.ValueString()
+	}
+
+	integration, err := r.client.UpdatePagerDutyIntegration(ctx, data.ID.ValueString(), updateReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update PagerDuty integration, got error: %s", err))
+		return
+	}
+
+	r.updateModelFromResponse(&data, integration)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PagerDutyIntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
+	var data PagerDutyIntegrationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeletePagerDutyIntegration(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFoundError(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete PagerDuty integration, got error: %s", err))
+		return
+	}
+}
+
+func (r *PagerDutyIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *PagerDutyIntegrationResource) updateModelFromResponse(data *PagerDutyIntegrationResourceModel, integration *client.PagerDutyIntegration) {
+	data.ID = types.StringValue(integration.ID)
+	data.Name = types.StringValue(integration.Name)
+	data.RoutingKeyWO = types.StringNull()
+	data.CreatedAt = types.StringValue(integration.CreatedAt)
+	data.UpdatedAt = types.StringValue(integration.UpdatedAt)
+
+	if integration.ServiceName != "" {
+		data.ServiceName = types.StringValue(integration.ServiceName)
+	}
+	if integration.RoutingKeyHint != "" {
+		data.RoutingKeyHint = types.StringValue(integration.RoutingKeyHint)
+	} else {
+		data.RoutingKeyHint = types.StringNull()
+	}
+}
@@ -6,6 +6,8 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"time"
 
 	"github.com/ackack-io/terraform-provider-ackack/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -21,6 +23,11 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ReportResource{}
 var _ resource.ResourceWithImportState = &ReportResource{}
+var _ resource.ResourceWithValidateConfig = &ReportResource{}
+
+// localeRegexp matches BCP 47 language tags with an optional region, e.g.
+// "de" or "de-DE".
+var localeRegexp = regexp.MustCompile(`^[a-z]{2,3}(-[A-Z]{2})?$`)
 
 func NewReportResource() resource.Resource {
 	return &ReportResource{}
@@ -42,6 +49,8 @@ type ReportResourceModel struct {
 	MonitorIDs  types.Set    `tfsdk:"monitor_ids"`
 	SystemIDs   types.Set    `tfsdk:"system_ids"`
 	Metrics     types.String `tfsdk:"metrics"`
+	Locale      types.String `tfsdk:"locale"`
+	Timezone    types.String `tfsdk:"timezone"`
 	Status      types.String `tfsdk:"status"`
 	FilePath    types.String `tfsdk:"file_path"`
 	CompletedAt types.String `tfsdk:"completed_at"`
@@ -128,6 +137,28 @@ func (r *ReportResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"locale": schema.StringAttribute{
+				MarkdownDescription: "The locale used to format dates and numbers in the report, as a BCP 47 language tag such as `de-DE` or `fr`. " +
+					"When omitted, the API default is used.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(localeRegexp, "must be a language tag such as \"de\" or \"de-DE\""),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"timezone": schema.StringAttribute{
+				MarkdownDescription: "The IANA time zone used to render timestamps in the report, e.g. `Europe/Berlin`. When omitted, the API default is used.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"status": schema.StringAttribute{
 				MarkdownDescription: "The status of the report.",
 				Computed:            true,
@@ -148,6 +179,23 @@ func (r *ReportResource) Schema(ctx context.Context, req resource.SchemaRequest,
 	}
 }
 
+func (r *ReportResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var tz types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("timezone"), &tz)...)
+	if resp.Diagnostics.HasError() || tz.IsNull() || tz.IsUnknown() {
+		return
+	}
+
+	if _, err := time.LoadLocation(tz.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("timezone"),
+			"Invalid Time Zone",
+			fmt.Sprintf("Unknown IANA time zone %q: %s.", tz.ValueString(), err),
+		)
+	}
+}
+
 func (r *ReportResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -162,10 +210,15 @@ func (r *ReportResource) Configure(ctx context.Context, req resource.ConfigureRe
 		return
 	}
 
+	requireFeature(c, "reports", "Reports", &resp.Diagnostics)
+
 	r.client = c
 }
 
 func (r *ReportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
 	var data ReportResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -202,6 +255,12 @@ func (r *ReportResource) Create(ctx context.Context, req resource.CreateRequest,
 	if !data.Metrics.IsNull() {
 		createReq.Metrics = data.Metrics.ValueString()
 	}
+	if !data.Locale.IsNull() && !data.Locale.IsUnknown() {
+		createReq.Locale = data.Locale.ValueString()
+	}
+	if !data.Timezone.IsNull() && !data.Timezone.IsUnknown() {
+		createReq.Timezone = data.Timezone.ValueString()
+	}
 
 	report, err := r.client.CreateReport(ctx, createReq)
 	if err != nil {
@@ -215,6 +274,9 @@ func (r *ReportResource) Create(ctx context.Context, req resource.CreateRequest,
 }
 
 func (r *ReportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
 	var data ReportResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -238,6 +300,9 @@ func (r *ReportResource) Read(ctx context.Context, req resource.ReadRequest, res
 }
 
 func (r *ReportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
 	// Reports cannot be updated - all changes require replacement
 	// This method should never be called due to RequiresReplace modifiers
 	resp.Diagnostics.AddError(
@@ -247,6 +312,9 @@ func (r *ReportResource) Update(ctx context.Context, req resource.UpdateRequest,
 }
 
 func (r *ReportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
 	var data ReportResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -287,6 +355,8 @@ func (r *ReportResource) updateModelFromResponse(data *ReportResourceModel, repo
 	if report.Metrics != "" {
 		data.Metrics = types.StringValue(report.Metrics)
 	}
+	data.Locale = types.StringValue(report.Locale)
+	data.Timezone = types.StringValue(report.Timezone)
 }
 
 // setRequiresReplace returns a plan modifier that requires replacement for set attributes.
@@ -0,0 +1,311 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ackack-io/terraform-provider-ackack/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SLOResource{}
+var _ resource.ResourceWithImportState = &SLOResource{}
+var _ resource.ResourceWithConfigValidators = &SLOResource{}
+
+func NewSLOResource() resource.Resource {
+	return &SLOResource{}
+}
+
+// SLOResource defines the resource implementation.
+type SLOResource struct {
+	client *client.Client
+}
+
+// SLOResourceModel describes the resource data model.
+type SLOResourceModel struct {
+	ID                          types.String  `tfsdk:"id"`
+	Name                        types.String  `tfsdk:"name"`
+	Description                 types.String  `tfsdk:"description"`
+	TargetPercentage            types.Float64 `tfsdk:"target_percentage"`
+	WindowDays                  types.Int64   `tfsdk:"window_days"`
+	MonitorID                   types.String  `tfsdk:"monitor_id"`
+	SystemID                    types.String  `tfsdk:"system_id"`
+	CurrentPercentage           types.Float64 `tfsdk:"current_percentage"`
+	BurnRate                    types.Float64 `tfsdk:"burn_rate"`
+	ErrorBudgetRemainingPercent types.Float64 `tfsdk:"error_budget_remaining_percent"`
+	ErrorBudgetRemainingMinutes types.Float64 `tfsdk:"error_budget_remaining_minutes"`
+	CreatedAt                   types.String  `tfsdk:"created_at"`
+	UpdatedAt                   types.String  `tfsdk:"updated_at"`
+}
+
+func (r *SLOResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_slo"
+}
+
+func (r *SLOResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a service level objective on ackack.io. An SLO tracks the uptime of a monitor or system against a " +
+			"target over a rolling window and reports the remaining error budget.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the SLO.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the SLO.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "A description of the SLO.",
+				Optional:            true,
+			},
+			"target_percentage": schema.Float64Attribute{
+				MarkdownDescription: "The uptime target, e.g. `99.9`.",
+				Required:            true,
+				Validators: []validator.Float64{
+					float64validator.Between(0, 100),
+				},
+			},
+			"window_days": schema.Int64Attribute{
+				MarkdownDescription: "The length of the rolling window in days. Valid values: `7`, `28`, `30`, `90`. Default is `30`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(30),
+				Validators: []validator.Int64{
+					int64validator.OneOf(7, 28, 30, 90),
+				},
+			},
+			"monitor_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the monitor the SLO tracks. Exactly one of `monitor_id` or `system_id` must be set. " +
+					"Changing this forces a new SLO.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"system_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the system the SLO tracks. Exactly one of `monitor_id` or `system_id` must be set. " +
+					"Changing this forces a new SLO.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"current_percentage": schema.Float64Attribute{
+				MarkdownDescription: "The uptime percentage over the current window.",
+				Computed:            true,
+			},
+			"burn_rate": schema.Float64Attribute{
+				MarkdownDescription: "How fast the error budget is being consumed, relative to the rate that would exactly exhaust it " +
+					"by the end of the window. Values above `1` mean the budget runs out early.",
+				Computed: true,
+			},
+			"error_budget_remaining_percent": schema.Float64Attribute{
+				MarkdownDescription: "The percentage of the error budget left in the current window. Negative when the SLO is breached.",
+				Computed:            true,
+			},
+			"error_budget_remaining_minutes": schema.Float64Attribute{
+				MarkdownDescription: "The downtime, in minutes, that can still occur in the current window without breaching the SLO.",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the SLO was created.",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the SLO was last updated.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *SLOResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("monitor_id"),
+			path.MatchRoot("system_id"),
+		),
+	}
+}
+
+func (r *SLOResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	requireFeature(c, "slos", "SLOs", &resp.Diagnostics)
+
+	r.client = c
+}
+
+func (r *SLOResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
+	var data SLOResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createReq := client.CreateSLORequest{
+		Name:             data.Name.ValueString(),
+		TargetPercentage: data.TargetPercentage.ValueFloat64(),
+		WindowDays:       int(data.WindowDays.ValueInt64()),
+	}
+
+	if !data.Description.IsNull() {
+		createReq.Description = data.Description.ValueString()
+	}
+	if !data.MonitorID.IsNull() {
+		createReq.MonitorID = data.MonitorID.ValueString()
+	}
+	if !data.SystemID.IsNull() {
+		createReq.SystemID = data.SystemID.ValueString()
+	}
+
+	slo, err := r.client.CreateSLO(ctx, createReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create SLO, got error: %s", err))
+		return
+	}
+
+	r.updateModelFromResponse(&data, slo)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SLOResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
+	var data SLOResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	slo, err := r.client.GetSLO(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read SLO, got error: %s", err))
+		return
+	}
+
+	r.updateModelFromResponse(&data, slo)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SLOResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
+	var data SLOResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateReq := client.UpdateSLORequest{
+		Name:             data.Name.ValueString(),
+		TargetPercentage: data.TargetPercentage.ValueFloat64(),
+		WindowDays:       int(data.WindowDays.ValueInt64()),
+	}
+
+	if !data.Description.IsNull() {
+		updateReq.Description = data.Description.ValueString()
+	}
+
+	slo, err := r.client.UpdateSLO(ctx, data.ID.ValueString(), updateReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update SLO, got error: %s", err))
+		return
+	}
+
+	r.updateModelFromResponse(&data, slo)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SLOResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
+	var data SLOResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteSLO(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFoundError(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete SLO, got error: %s", err))
+		return
+	}
+}
+
+func (r *SLOResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *SLOResource) updateModelFromResponse(data *SLOResourceModel, slo *client.SLO) {
+	data.ID = types.StringValue(slo.ID)
+	data.Name = types.StringValue(slo.Name)
+	data.TargetPercentage = types.Float64Value(slo.TargetPercentage)
+	data.WindowDays = types.Int64Value(int64(slo.WindowDays))
+	data.CurrentPercentage = types.Float64Value(slo.CurrentPercentage)
+	data.BurnRate = types.Float64Value(slo.BurnRate)
+	data.ErrorBudgetRemainingPercent = types.Float64Value(slo.ErrorBudgetRemainingPercent)
+	data.ErrorBudgetRemainingMinutes = types.Float64Value(slo.ErrorBudgetRemainingMinutes)
+	data.CreatedAt = types.StringValue(slo.CreatedAt)
+	data.UpdatedAt = types.StringValue(slo.UpdatedAt)
+
+	if slo.Description != "" {
+		data.Description = types.StringValue(slo.Description)
+	}
+	if slo.MonitorID != "" {
+		data.MonitorID = types.StringValue(slo.MonitorID)
+	}
+	if slo.SystemID != "" {
+		data.SystemID = types.StringValue(slo.SystemID)
+	}
+}
@@ -5,21 +5,37 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/ackack-io/terraform-provider-ackack/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &SystemResource{}
 var _ resource.ResourceWithImportState = &SystemResource{}
+var _ resource.ResourceWithIdentity = &SystemResource{}
+var _ resource.ResourceWithUpgradeState = &SystemResource{}
+var _ resource.ResourceWithValidateConfig = &SystemResource{}
+var _ resource.ResourceWithModifyPlan = &SystemResource{}
+
+// maxMembershipChangesListed bounds how many monitors the membership change
+// warning names, so large diffs stay readable.
+const maxMembershipChangesListed = 20
 
 func NewSystemResource() resource.Resource {
 	return &SystemResource{}
@@ -32,18 +48,22 @@ type SystemResource struct {
 
 // SystemResourceModel describes the resource data model.
 type SystemResourceModel struct {
-	ID            types.String  `tfsdk:"id"`
-	Name          types.String  `tfsdk:"name"`
-	Description   types.String  `tfsdk:"description"`
-	Priority      types.String  `tfsdk:"priority"`
-	Status        types.String  `tfsdk:"status"`
-	MonitorIDs    types.Set     `tfsdk:"monitor_ids"`
-	ExternalLinks types.List    `tfsdk:"external_links"`
-	MonitorCount  types.Int64   `tfsdk:"monitor_count"`
-	HealthyCount  types.Int64   `tfsdk:"healthy_count"`
-	OverallUptime types.Float64 `tfsdk:"overall_uptime"`
-	CreatedAt     types.String  `tfsdk:"created_at"`
-	UpdatedAt     types.String  `tfsdk:"updated_at"`
+	ID                       types.String  `tfsdk:"id"`
+	Name                     types.String  `tfsdk:"name"`
+	Description              types.String  `tfsdk:"description"`
+	Priority                 types.String  `tfsdk:"priority"`
+	Status                   types.String  `tfsdk:"status"`
+	MonitorIDs               types.Set     `tfsdk:"monitor_ids"`
+	ExternalLinks            types.List    `tfsdk:"external_links"`
+	DefaultAlertChannelIDs   types.Set     `tfsdk:"default_alert_channel_ids"`
+	InheritingMonitorIDs     types.Set     `tfsdk:"inheriting_monitor_ids"`
+	DegradedThresholdPercent types.Int64   `tfsdk:"degraded_threshold_percent"`
+	DownThresholdPercent     types.Int64   `tfsdk:"down_threshold_percent"`
+	MonitorCount             types.Int64   `tfsdk:"monitor_count"`
+	HealthyCount             types.Int64   `tfsdk:"healthy_count"`
+	OverallUptime            types.Float64 `tfsdk:"overall_uptime"`
+	CreatedAt                types.String  `tfsdk:"created_at"`
+	UpdatedAt                types.String  `tfsdk:"updated_at"`
 }
 
 // ExternalLinkModel describes an external link.
@@ -58,6 +78,8 @@ func (r *SystemResource) Metadata(ctx context.Context, req resource.MetadataRequ
 
 func (r *SystemResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 0,
+
 		MarkdownDescription: "Manages a system grouping of monitors on ackack.io.",
 
 		Attributes: map[string]schema.Attribute{
@@ -85,9 +107,10 @@ func (r *SystemResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Computed:            true,
 			},
 			"monitor_ids": schema.SetAttribute{
-				MarkdownDescription: "The IDs of monitors in this system. At least one monitor is required.",
-				Required:            true,
-				ElementType:         types.StringType,
+				MarkdownDescription: "The IDs of monitors in this system. When set, membership is managed exclusively by this resource. " +
+					"Omit it to create an empty system and manage membership with `ackack_system_monitor_attachment` instead.",
+				Optional:    true,
+				ElementType: types.StringType,
 			},
 			"external_links": schema.ListNestedAttribute{
 				MarkdownDescription: "External links associated with this system.",
@@ -105,6 +128,43 @@ func (r *SystemResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					},
 				},
 			},
+			"default_alert_channel_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of `ackack_alert` resources used as default alert channels. Member monitors without alerts of their own " +
+					"are notified through these channels, so large fleets don't need an alert per monitor.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"inheriting_monitor_ids": schema.SetAttribute{
+				MarkdownDescription: "The member monitors without alerts of their own, which are notified through `default_alert_channel_ids`. " +
+					"Refreshed on every read; null when no default channels are set.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"degraded_threshold_percent": schema.Int64Attribute{
+				MarkdownDescription: "The percentage of unhealthy member monitors at which the system is considered `degraded`. " +
+					"When omitted, the API default is used; removing it from the configuration resets it to the default.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"down_threshold_percent": schema.Int64Attribute{
+				MarkdownDescription: "The percentage of unhealthy member monitors at which the system is considered `error`. " +
+					"Must be greater than `degraded_threshold_percent`. When omitted, the API default is used; removing it from " +
+					"the configuration resets it to the default.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
 			"monitor_count": schema.Int64Attribute{
 				MarkdownDescription: "The number of monitors in the system.",
 				Computed:            true,
@@ -129,6 +189,32 @@ func (r *SystemResource) Schema(ctx context.Context, req resource.SchemaRequest,
 	}
 }
 
+func (r *SystemResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SystemResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	degraded, down := data.DegradedThresholdPercent, data.DownThresholdPercent
+	if degraded.IsNull() || degraded.IsUnknown() || down.IsNull() || down.IsUnknown() {
+		return
+	}
+
+	if down.ValueInt64() <= degraded.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("down_threshold_percent"),
+			"Invalid System Thresholds",
+			fmt.Sprintf("The down_threshold_percent (%d) must be greater than degraded_threshold_percent (%d).", down.ValueInt64(), degraded.ValueInt64()),
+		)
+	}
+}
+
+func (r *SystemResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("The unique identifier of the system.")
+}
+
 func (r *SystemResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -147,6 +233,9 @@ func (r *SystemResource) Configure(ctx context.Context, req resource.ConfigureRe
 }
 
 func (r *SystemResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
 	var data SystemResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -154,11 +243,13 @@ func (r *SystemResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	// Extract monitor IDs
-	var monitorIDs []string
-	resp.Diagnostics.Append(data.MonitorIDs.ElementsAs(ctx, &monitorIDs, false)...)
-	if resp.Diagnostics.HasError() {
-		return
+	// Extract monitor IDs; the API accepts an empty system
+	monitorIDs := []string{}
+	if !data.MonitorIDs.IsNull() {
+		resp.Diagnostics.Append(data.MonitorIDs.ElementsAs(ctx, &monitorIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	// Extract external links
@@ -189,6 +280,14 @@ func (r *SystemResource) Create(ctx context.Context, req resource.CreateRequest,
 	if !data.Priority.IsNull() {
 		createReq.Priority = data.Priority.ValueString()
 	}
+	createReq.DegradedThresholdPercent = intPointer(data.DegradedThresholdPercent)
+	createReq.DownThresholdPercent = intPointer(data.DownThresholdPercent)
+	if !data.DefaultAlertChannelIDs.IsNull() {
+		resp.Diagnostics.Append(data.DefaultAlertChannelIDs.ElementsAs(ctx, &createReq.DefaultAlertChannelIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	system, err := r.client.CreateSystem(ctx, createReq)
 	if err != nil {
@@ -204,11 +303,20 @@ func (r *SystemResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	r.updateModelFromResponse(ctx, &data, systemWithStats, monitorIDs)
+	r.reconcileInheritingMonitors(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.ID)...)
+	resp.Diagnostics.Append(setConfiguredThresholds(ctx, req.Config, resp.Private)...)
 }
 
 func (r *SystemResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
 	var data SystemResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -216,15 +324,6 @@ func (r *SystemResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	// Get current monitor IDs from state for comparison
-	var currentMonitorIDs []string
-	if !data.MonitorIDs.IsNull() {
-		resp.Diagnostics.Append(data.MonitorIDs.ElementsAs(ctx, &currentMonitorIDs, false)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-	}
-
 	system, err := r.client.GetSystem(ctx, data.ID.ValueString())
 	if err != nil {
 		if client.IsNotFoundError(err) {
@@ -235,12 +334,32 @@ func (r *SystemResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	r.updateModelFromResponse(ctx, &data, system, currentMonitorIDs)
+	r.updateModelFromResponse(ctx, &data, system, nil)
+
+	// Reconcile membership with the API so out-of-band changes show up as
+	// drift. Membership managed through attachments is not tracked here.
+	if !data.MonitorIDs.IsNull() {
+		monitorIDs, err := r.client.ListSystemMonitorIDs(ctx, data.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read system monitors, got error: %s", err))
+			return
+		}
+		data.MonitorIDs = stringSetValue(monitorIDs)
+	}
+
+	r.reconcileInheritingMonitors(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.ID)...)
 }
 
 func (r *SystemResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
 	var data SystemResourceModel
 	var state SystemResourceModel
 
@@ -252,16 +371,20 @@ func (r *SystemResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	// Extract new monitor IDs
 	var newMonitorIDs []string
-	resp.Diagnostics.Append(data.MonitorIDs.ElementsAs(ctx, &newMonitorIDs, false)...)
-	if resp.Diagnostics.HasError() {
-		return
+	if !data.MonitorIDs.IsNull() {
+		resp.Diagnostics.Append(data.MonitorIDs.ElementsAs(ctx, &newMonitorIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	// Extract old monitor IDs
 	var oldMonitorIDs []string
-	resp.Diagnostics.Append(state.MonitorIDs.ElementsAs(ctx, &oldMonitorIDs, false)...)
-	if resp.Diagnostics.HasError() {
-		return
+	if !state.MonitorIDs.IsNull() {
+		resp.Diagnostics.Append(state.MonitorIDs.ElementsAs(ctx, &oldMonitorIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	// Extract external links
@@ -292,6 +415,15 @@ func (r *SystemResource) Update(ctx context.Context, req resource.UpdateRequest,
 	if !data.Priority.IsNull() {
 		updateReq.Priority = data.Priority.ValueString()
 	}
+	updateReq.DegradedThresholdPercent = intPointer(data.DegradedThresholdPercent)
+	updateReq.DownThresholdPercent = intPointer(data.DownThresholdPercent)
+	updateReq.DefaultAlertChannelIDs = []string{}
+	if !data.DefaultAlertChannelIDs.IsNull() {
+		resp.Diagnostics.Append(data.DefaultAlertChannelIDs.ElementsAs(ctx, &updateReq.DefaultAlertChannelIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	_, err := r.client.UpdateSystem(ctx, data.ID.ValueString(), updateReq)
 	if err != nil {
@@ -299,9 +431,13 @@ func (r *SystemResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	// Calculate monitor changes
-	toAdd := difference(newMonitorIDs, oldMonitorIDs)
-	toRemove := difference(oldMonitorIDs, newMonitorIDs)
+	// Calculate monitor changes. Membership is left alone when monitor_ids
+	// is not configured, so attachments managed elsewhere are kept.
+	var toAdd, toRemove []string
+	if !data.MonitorIDs.IsNull() {
+		toAdd = difference(newMonitorIDs, oldMonitorIDs)
+		toRemove = difference(oldMonitorIDs, newMonitorIDs)
+	}
 
 	// Add new monitors
 	if len(toAdd) > 0 {
@@ -329,11 +465,20 @@ func (r *SystemResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	r.updateModelFromResponse(ctx, &data, system, newMonitorIDs)
+	r.reconcileInheritingMonitors(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.ID)...)
+	resp.Diagnostics.Append(setConfiguredThresholds(ctx, req.Config, resp.Private)...)
 }
 
 func (r *SystemResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, warnRateLimits := trackRateLimits(ctx)
+	defer warnRateLimits(&resp.Diagnostics)
+
 	var data SystemResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -351,8 +496,177 @@ func (r *SystemResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 }
 
+// ModifyPlan resets thresholds removed from config to the API default, and
+// warns with a summary of the monitors a monitor_ids change adds and
+// removes, named rather than as bare IDs, so reviewers can judge the blast
+// radius of a large set diff.
+func (r *SystemResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resetRemovedThresholds(ctx, req, resp)
+	if r.client == nil || resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planned, current types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("monitor_ids"), &planned)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("monitor_ids"), &current)...)
+	if resp.Diagnostics.HasError() || planned.IsNull() || planned.IsUnknown() {
+		return
+	}
+
+	// Elements that reference monitors created in the same apply are unknown
+	// until then; they are counted as additions but can't be listed.
+	newMonitorIDs, pending := knownStringsFromSetValue(planned)
+	oldMonitorIDs := stringsFromSetValue(current)
+	toAdd := difference(newMonitorIDs, oldMonitorIDs)
+	toRemove := difference(oldMonitorIDs, newMonitorIDs)
+	if len(toAdd) == 0 && len(toRemove) == 0 && pending == 0 {
+		return
+	}
+
+	// Names are only for the reader; fall back to IDs when they can't be
+	// resolved.
+	names := make(map[string]string)
+	if monitors, err := r.client.ListMonitors(ctx); err == nil {
+		for _, monitor := range monitors {
+			names[monitor.ID] = monitor.Name
+		}
+	}
+
+	var detail strings.Builder
+	fmt.Fprintf(&detail, "This plan adds %d and removes %d monitors from the system.", len(toAdd)+pending, len(toRemove))
+	writeMembershipChanges(&detail, "Added", toAdd, pending, names)
+	writeMembershipChanges(&detail, "Removed", toRemove, 0, names)
+
+	resp.Diagnostics.AddAttributeWarning(path.Root("monitor_ids"), "System Membership Change", detail.String())
+}
+
+// configuredThresholdsKey is the private state key listing the thresholds
+// set in config at the last apply. Thresholds are computed, so only this
+// tells a threshold removed from config apart from one never set.
+const configuredThresholdsKey = "configured_thresholds"
+
+// systemThresholds are the threshold attributes that fall back to the API
+// default when omitted.
+var systemThresholds = []string{"degraded_threshold_percent", "down_threshold_percent"}
+
+// setConfiguredThresholds records the thresholds config sets.
+func setConfiguredThresholds(ctx context.Context, config tfsdk.Config, private privateStateSetter) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	configured := []string{}
+	for _, name := range systemThresholds {
+		var value types.Int64
+		diags.Append(config.GetAttribute(ctx, path.Root(name), &value)...)
+		if !value.IsNull() {
+			configured = append(configured, name)
+		}
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	data, err := json.Marshal(configured)
+	if err != nil {
+		diags.AddError("Unable to Store Configured Thresholds", err.Error())
+		return diags
+	}
+	diags.Append(private.SetKey(ctx, configuredThresholdsKey, data)...)
+	return diags
+}
+
+// resetRemovedThresholds plans thresholds removed from config as unknown, so
+// the update resets them to the API default instead of keeping the last
+// configured value.
+func resetRemovedThresholds(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	data, diags := req.Private.GetKey(ctx, configuredThresholdsKey)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() || len(data) == 0 {
+		return
+	}
+
+	var configured []string
+	if err := json.Unmarshal(data, &configured); err != nil {
+		return
+	}
+
+	for _, name := range configured {
+		var value types.Int64
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(name), &value)...)
+		if value.IsNull() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(name), types.Int64Unknown())...)
+		}
+	}
+}
+
+// knownStringsFromSetValue returns the known elements of a known set of
+// strings, and the number of elements that are unknown.
+func knownStringsFromSetValue(s types.Set) ([]string, int) {
+	var known []string
+	var unknown int
+	for _, v := range s.Elements() {
+		str, ok := v.(types.String)
+		switch {
+		case !ok:
+		case str.IsUnknown():
+			unknown++
+		default:
+			known = append(known, str.ValueString())
+		}
+	}
+	return known, unknown
+}
+
+// writeMembershipChanges appends a titled list of monitors to b, followed by
+// the number of monitors that are known after apply.
+func writeMembershipChanges(b *strings.Builder, title string, monitorIDs []string, unknown int, names map[string]string) {
+	if len(monitorIDs) == 0 && unknown == 0 {
+		return
+	}
+
+	slices.Sort(monitorIDs)
+	fmt.Fprintf(b, "\n\n%s:", title)
+	for i, id := range monitorIDs {
+		if i == maxMembershipChangesListed {
+			fmt.Fprintf(b, "\n  ... and %d more", len(monitorIDs)-i)
+			break
+		}
+		if name, ok := names[id]; ok {
+			fmt.Fprintf(b, "\n  - %s (%s)", name, id)
+		} else {
+			fmt.Fprintf(b, "\n  - %s", id)
+		}
+	}
+	if unknown > 0 {
+		fmt.Fprintf(b, "\n  - %d (known after apply)", unknown)
+	}
+}
+
+// UpgradeState returns the state upgraders from each previous schema version.
+func (r *SystemResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
 func (r *SystemResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+
+	var id string
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Import the current membership; Read only refreshes monitor_ids when
+	// it is already tracked.
+	monitorIDs, err := r.client.ListSystemMonitorIDs(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read system monitors, got error: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("monitor_ids"), monitorIDs)...)
 }
 
 func (r *SystemResource) updateModelFromResponse(ctx context.Context, data *SystemResourceModel, system *client.SystemWithStats, monitorIDs []string) {
@@ -372,7 +686,8 @@ func (r *SystemResource) updateModelFromResponse(ctx context.Context, data *Syst
 		data.Priority = types.StringValue(system.Priority)
 	}
 
-	// Preserve the monitor_ids from the plan/state since the API doesn't return them
+	// The system response doesn't include membership, so keep the monitor_ids
+	// that were just applied; Read reconciles them with the API.
 	if len(monitorIDs) > 0 {
 		monitorIDsSet, d := types.SetValueFrom(ctx, types.StringType, monitorIDs)
 		if d.HasError() {
@@ -381,6 +696,12 @@ func (r *SystemResource) updateModelFromResponse(ctx context.Context, data *Syst
 		data.MonitorIDs = monitorIDsSet
 	}
 
+	data.DegradedThresholdPercent = optionalInt64Value(system.DegradedThresholdPercent)
+	data.DownThresholdPercent = optionalInt64Value(system.DownThresholdPercent)
+	if len(system.DefaultAlertChannelIDs) > 0 {
+		data.DefaultAlertChannelIDs = stringSetValue(system.DefaultAlertChannelIDs)
+	}
+
 	// Convert external links
 	if len(system.ExternalLinks) > 0 {
 		linkObjects := make([]attr.Value, len(system.ExternalLinks))
@@ -413,6 +734,41 @@ func (r *SystemResource) updateModelFromResponse(ctx context.Context, data *Syst
 	}
 }
 
+// reconcileInheritingMonitors sets inheriting_monitor_ids to the members of
+// the system that have no alerts of their own and so fall back to the
+// default alert channels.
+func (r *SystemResource) reconcileInheritingMonitors(ctx context.Context, data *SystemResourceModel, diags *diag.Diagnostics) {
+	if data.DefaultAlertChannelIDs.IsNull() || len(data.DefaultAlertChannelIDs.Elements()) == 0 {
+		data.InheritingMonitorIDs = types.SetNull(types.StringType)
+		return
+	}
+
+	monitorIDs, err := r.client.ListSystemMonitorIDs(ctx, data.ID.ValueString())
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read system monitors, got error: %s", err))
+		return
+	}
+
+	alerts, err := r.client.ListAlerts(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list alerts, got error: %s", err))
+		return
+	}
+
+	alerted := make(map[string]bool)
+	for _, alert := range alerts {
+		alerted[alert.MonitorID] = true
+	}
+
+	inheriting := []string{}
+	for _, id := range monitorIDs {
+		if !alerted[id] {
+			inheriting = append(inheriting, id)
+		}
+	}
+	data.InheritingMonitorIDs = stringSetValue(inheriting)
+}
+
 // difference returns elements in a that are not in b.
 func difference(a, b []string) []string {
 	bMap := make(map[string]bool)
@@ -0,0 +1,70 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"cmp"
+	"fmt"
+	"time"
+
+	"github.com/ackack-io/terraform-provider-ackack/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// validateSchedule reports schedule windows with an unknown time zone or
+// without any duration. Unlike threshold windows, schedule windows may
+// overlap: the monitor runs during any of them.
+func validateSchedule(windows []ActiveHoursModel, diags *diag.Diagnostics) {
+	for i, window := range windows {
+		windowPath := path.Root("schedule").AtListIndex(i)
+
+		if tz := window.Timezone; !tz.IsNull() && !tz.IsUnknown() {
+			if _, err := time.LoadLocation(tz.ValueString()); err != nil {
+				diags.AddAttributeError(
+					windowPath.AtName("timezone"),
+					"Invalid Time Zone",
+					fmt.Sprintf("Unknown IANA time zone %q: %s.", tz.ValueString(), err),
+				)
+			}
+		}
+		if start, end := window.StartTime, window.EndTime; !start.IsUnknown() && !end.IsUnknown() && !start.IsNull() && start.Equal(end) {
+			diags.AddAttributeError(
+				windowPath.AtName("end_time"),
+				"Invalid Schedule",
+				"The end_time must differ from start_time. Omit schedule to run the monitor at all times.",
+			)
+		}
+	}
+}
+
+func scheduleToClient(windows []ActiveHoursModel) []client.ActiveHours {
+	if len(windows) == 0 {
+		return nil
+	}
+	result := make([]client.ActiveHours, 0, len(windows))
+	for _, m := range windows {
+		result = append(result, client.ActiveHours{
+			Days:      stringsFromSetValue(m.Days),
+			StartTime: m.StartTime.ValueString(),
+			EndTime:   m.EndTime.ValueString(),
+			Timezone:  m.Timezone.ValueString(),
+		})
+	}
+	return result
+}
+
+func scheduleFromClient(windows []client.ActiveHours) []ActiveHoursModel {
+	result := make([]ActiveHoursModel, 0, len(windows))
+	for _, c := range windows {
+		result = append(result, ActiveHoursModel{
+			Days:      stringSetValue(c.Days),
+			StartTime: types.StringValue(c.StartTime),
+			EndTime:   types.StringValue(c.EndTime),
+			Timezone:  types.StringValue(cmp.Or(c.Timezone, "UTC")),
+		})
+	}
+	return result
+}
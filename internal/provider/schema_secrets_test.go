@@ -0,0 +1,83 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// secretAttributeRegexp matches attribute names that hold credentials.
+// Masked hints such as access_key_id_hint are safe to show.
+var secretAttributeRegexp = regexp.MustCompile(`(^|_)(api_key|secret|password|token|access_key_id|key_pem|routing_key)(_wo)?$`)
+
+// secretAttribute is implemented by every schema attribute type.
+type secretAttribute interface {
+	IsSensitive() bool
+	IsWriteOnly() bool
+}
+
+func TestProviderSchema_APIKeySensitive(t *testing.T) {
+	var resp provider.SchemaResponse
+	(&AckackProvider{}).Schema(context.Background(), provider.SchemaRequest{}, &resp)
+
+	if !resp.Schema.Attributes["api_key"].IsSensitive() {
+		t.Error("api_key must be sensitive")
+	}
+}
+
+// TestSchemas_SecretsNotPersisted checks that credential attributes are
+// sensitive or write-only, so they never appear in plan output or state in
+// clear text.
+func TestSchemas_SecretsNotPersisted(t *testing.T) {
+	ctx := context.Background()
+	p := &AckackProvider{}
+
+	for _, newResource := range p.Resources(ctx) {
+		r := newResource()
+		var meta resource.MetadataResponse
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "ackack"}, &meta)
+		var resp resource.SchemaResponse
+		r.Schema(ctx, resource.SchemaRequest{}, &resp)
+		for name, attribute := range resp.Schema.Attributes {
+			checkSecretAttribute(t, meta.TypeName, name, attribute)
+		}
+	}
+
+	for _, newDataSource := range p.DataSources(ctx) {
+		d := newDataSource()
+		var meta datasource.MetadataResponse
+		d.Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: "ackack"}, &meta)
+		var resp datasource.SchemaResponse
+		d.Schema(ctx, datasource.SchemaRequest{}, &resp)
+		for name, attribute := range resp.Schema.Attributes {
+			checkSecretAttribute(t, meta.TypeName, name, attribute)
+		}
+	}
+
+	for _, newEphemeral := range p.EphemeralResources(ctx) {
+		e := newEphemeral()
+		var meta ephemeral.MetadataResponse
+		e.Metadata(ctx, ephemeral.MetadataRequest{ProviderTypeName: "ackack"}, &meta)
+		var resp ephemeral.SchemaResponse
+		e.Schema(ctx, ephemeral.SchemaRequest{}, &resp)
+		for name, attribute := range resp.Schema.Attributes {
+			checkSecretAttribute(t, meta.TypeName, name, attribute)
+		}
+	}
+}
+
+func checkSecretAttribute(t *testing.T, typeName, name string, attribute secretAttribute) {
+	t.Helper()
+
+	if secretAttributeRegexp.MatchString(name) && !attribute.IsSensitive() && !attribute.IsWriteOnly() {
+		t.Errorf("%s.%s holds a credential but is neither sensitive nor write-only", typeName, name)
+	}
+}
@@ -0,0 +1,261 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package statecompat checks that stored resource state can be read by the
+// current provider schemas, running it through the same state upgrade
+// Terraform performs on the first plan after a provider upgrade.
+package statecompat
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// SchemaVersions records the schema version of every resource type. When a
+// schema restructure bumps a resource's version, update it here and add a
+// state upgrader and a testdata fixture for the previous version.
+var SchemaVersions = map[string]int64{
+	"ackack_alert":                     0,
+	"ackack_annotation":                0,
+	"ackack_artifacts_bucket":          0,
+	"ackack_browser_monitor":           0,
+	"ackack_limit_alert":               0,
+	"ackack_maintenance_window":        0,
+	"ackack_monitor":                   0,
+	"ackack_monitor_activation":        0,
+	"ackack_monitor_set":               0,
+	"ackack_pagerduty_integration":     0,
+	"ackack_report":                    0,
+	"ackack_slo":                       0,
+	"ackack_system":                    0,
+	"ackack_system_monitor_attachment": 0,
+}
+
+// Checker upgrades stored state against an unconfigured provider server.
+type Checker struct {
+	server  tfprotov6.ProviderServer
+	schemas map[string]*tfprotov6.Schema
+}
+
+// Result is the outcome of checking the state of one resource instance.
+type Result struct {
+	// Address is the resource instance address, e.g.
+	// module.web.ackack_monitor.api["eu"].
+	Address string
+
+	// SchemaVersion is the schema version the state was written with.
+	SchemaVersion int64
+
+	Diagnostics []*tfprotov6.Diagnostic
+
+	// Dropped lists the attribute paths in the stored state that the current
+	// schema no longer has. Terraform discards them silently on upgrade.
+	Dropped []string
+}
+
+// HasError reports whether the state cannot be upgraded.
+func (r *Result) HasError() bool {
+	for _, d := range r.Diagnostics {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// New returns a checker for the provider.
+func New(ctx context.Context, p provider.Provider) (*Checker, error) {
+	server, err := providerserver.NewProtocol6WithError(p)()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			return nil, fmt.Errorf("%s: %s", d.Summary, d.Detail)
+		}
+	}
+
+	return &Checker{server: server, schemas: resp.ResourceSchemas}, nil
+}
+
+// Upgrade upgrades the JSON attributes of a resource instance written with
+// the given schema version, and returns the upgraded state.
+func (c *Checker) Upgrade(ctx context.Context, typeName string, version int64, attributes []byte) (tftypes.Value, *Result, error) {
+	result := &Result{Address: typeName, SchemaVersion: version}
+
+	schema, ok := c.schemas[typeName]
+	if !ok {
+		result.Diagnostics = append(result.Diagnostics, &tfprotov6.Diagnostic{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  "Unknown Resource Type",
+			Detail:   fmt.Sprintf("This provider version no longer has the %s resource type.", typeName),
+		})
+		return tftypes.Value{}, result, nil
+	}
+	if version > schema.Version {
+		result.Diagnostics = append(result.Diagnostics, &tfprotov6.Diagnostic{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  "State From Newer Provider",
+			Detail: fmt.Sprintf("The state was written with schema version %d, but this provider version supports up to %d. "+
+				"Downgrading the provider is not supported.", version, schema.Version),
+		})
+		return tftypes.Value{}, result, nil
+	}
+
+	resp, err := c.server.UpgradeResourceState(ctx, &tfprotov6.UpgradeResourceStateRequest{
+		TypeName: typeName,
+		Version:  version,
+		RawState: &tfprotov6.RawState{JSON: attributes},
+	})
+	if err != nil {
+		return tftypes.Value{}, nil, err
+	}
+	result.Diagnostics = resp.Diagnostics
+	if result.HasError() || resp.UpgradedState == nil {
+		return tftypes.Value{}, result, nil
+	}
+
+	upgraded, err := resp.UpgradedState.Unmarshal(schema.ValueType())
+	if err != nil {
+		return tftypes.Value{}, nil, err
+	}
+
+	// Attributes only survive an upgrade to the same version if the schema
+	// still has them; an upgrader is responsible for any it moves.
+	if version == schema.Version {
+		var raw any
+		if err := json.Unmarshal(attributes, &raw); err != nil {
+			return tftypes.Value{}, nil, err
+		}
+		result.Dropped = droppedAttributes(schema.ValueType(), raw, "")
+	}
+
+	return upgraded, result, nil
+}
+
+// state is the subset of the Terraform state file format the checker reads.
+type state struct {
+	Version   int `json:"version"`
+	Resources []struct {
+		Module    string `json:"module"`
+		Mode      string `json:"mode"`
+		Type      string `json:"type"`
+		Name      string `json:"name"`
+		Instances []struct {
+			IndexKey      any             `json:"index_key"`
+			SchemaVersion int64           `json:"schema_version"`
+			Attributes    json.RawMessage `json:"attributes"`
+		} `json:"instances"`
+	} `json:"resources"`
+}
+
+// CheckState checks every ackack resource instance in a Terraform state file,
+// as written by `terraform state pull`.
+func (c *Checker) CheckState(ctx context.Context, stateJSON []byte) ([]*Result, error) {
+	var s state
+	if err := json.Unmarshal(stateJSON, &s); err != nil {
+		return nil, fmt.Errorf("unable to parse state: %w", err)
+	}
+	if s.Version != 4 {
+		return nil, fmt.Errorf("unsupported state format version %d, expected 4", s.Version)
+	}
+
+	var results []*Result
+	for _, r := range s.Resources {
+		if r.Mode != "managed" || !strings.HasPrefix(r.Type, "ackack_") {
+			continue
+		}
+		for _, instance := range r.Instances {
+			_, result, err := c.Upgrade(ctx, r.Type, instance.SchemaVersion, instance.Attributes)
+			if err != nil {
+				return nil, err
+			}
+			result.Address = instanceAddress(r.Module, r.Type, r.Name, instance.IndexKey)
+			results = append(results, result)
+		}
+	}
+	return results, nil
+}
+
+func instanceAddress(module, typeName, name string, indexKey any) string {
+	address := typeName + "." + name
+	if module != "" {
+		address = module + "." + address
+	}
+	switch key := indexKey.(type) {
+	case string:
+		address += fmt.Sprintf("[%q]", key)
+	case float64:
+		address += fmt.Sprintf("[%d]", int(key))
+	}
+	return address
+}
+
+// droppedAttributes returns the paths of object attributes in value that typ
+// does not have.
+func droppedAttributes(typ tftypes.Type, value any, path string) []string {
+	var dropped []string
+
+	switch typ := typ.(type) {
+	case tftypes.Object:
+		object, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+		for _, name := range slices.Sorted(maps.Keys(object)) {
+			attributeType, ok := typ.AttributeTypes[name]
+			if !ok {
+				dropped = append(dropped, joinPath(path, name))
+				continue
+			}
+			dropped = append(dropped, droppedAttributes(attributeType, object[name], joinPath(path, name))...)
+		}
+	case tftypes.List:
+		dropped = append(dropped, droppedElements(typ.ElementType, value, path)...)
+	case tftypes.Set:
+		dropped = append(dropped, droppedElements(typ.ElementType, value, path)...)
+	case tftypes.Map:
+		object, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+		for _, key := range slices.Sorted(maps.Keys(object)) {
+			dropped = append(dropped, droppedAttributes(typ.ElementType, object[key], fmt.Sprintf("%s[%q]", path, key))...)
+		}
+	}
+
+	return dropped
+}
+
+func droppedElements(elementType tftypes.Type, value any, path string) []string {
+	elements, ok := value.([]any)
+	if !ok {
+		return nil
+	}
+	var dropped []string
+	for i, element := range elements {
+		dropped = append(dropped, droppedAttributes(elementType, element, fmt.Sprintf("%s[%d]", path, i))...)
+	}
+	return dropped
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
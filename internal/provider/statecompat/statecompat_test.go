@@ -0,0 +1,233 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package statecompat
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/ackack-io/terraform-provider-ackack/internal/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var update = flag.Bool("update", false, "write state fixtures for the current schema versions")
+
+func newChecker(t *testing.T) *Checker {
+	t.Helper()
+
+	c, err := New(context.Background(), provider.New("test")())
+	if err != nil {
+		t.Fatalf("unable to create checker: %s", err)
+	}
+	return c
+}
+
+func TestSchemaVersions(t *testing.T) {
+	c := newChecker(t)
+
+	for typeName, schema := range c.schemas {
+		version, ok := SchemaVersions[typeName]
+		if !ok {
+			t.Errorf("%s is missing from SchemaVersions", typeName)
+			continue
+		}
+		if version != schema.Version {
+			t.Errorf("%s has schema version %d, SchemaVersions records %d", typeName, schema.Version, version)
+		}
+	}
+	for typeName := range SchemaVersions {
+		if _, ok := c.schemas[typeName]; !ok {
+			t.Errorf("SchemaVersions lists %s, which the provider does not have", typeName)
+		}
+	}
+}
+
+// TestStateUpgraders checks that resources with state upgraders have one
+// from every previous schema version, and none from the current or later
+// versions, which Terraform never sends.
+func TestStateUpgraders(t *testing.T) {
+	ctx := context.Background()
+
+	for _, newResource := range provider.New("test")().Resources(ctx) {
+		r, ok := newResource().(resource.ResourceWithUpgradeState)
+		if !ok {
+			continue
+		}
+		var meta resource.MetadataResponse
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "ackack"}, &meta)
+
+		upgraders := r.UpgradeState(ctx)
+		current := SchemaVersions[meta.TypeName]
+		for version := int64(0); version < current; version++ {
+			if _, ok := upgraders[version]; !ok {
+				t.Errorf("%s has no state upgrader from schema version %d", meta.TypeName, version)
+			}
+		}
+		for version := range upgraders {
+			if version >= current {
+				t.Errorf("%s has a state upgrader from schema version %d, but its current version is %d", meta.TypeName, version, current)
+			}
+		}
+	}
+}
+
+// TestStateRoundTrip upgrades a fixture for every schema version of every
+// resource. Values in fixtures of the current version must survive unchanged,
+// so a restructure that would lose state fails here until it ships an
+// upgrader.
+func TestStateRoundTrip(t *testing.T) {
+	c := newChecker(t)
+
+	for _, typeName := range slices.Sorted(maps.Keys(SchemaVersions)) {
+		current := SchemaVersions[typeName]
+
+		if *update {
+			writeFixture(t, typeName, current, sampleValue(c.schemas[typeName].ValueType()))
+		}
+
+		for version := int64(0); version <= current; version++ {
+			t.Run(fmt.Sprintf("%s/v%d", typeName, version), func(t *testing.T) {
+				fixture, err := os.ReadFile(fixturePath(typeName, version))
+				if err != nil {
+					t.Fatalf("missing fixture, run the test with -update to create it: %s", err)
+				}
+
+				upgraded, result, err := c.Upgrade(context.Background(), typeName, version, fixture)
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				if result.HasError() {
+					t.Fatalf("unable to upgrade: %v", result.Diagnostics)
+				}
+				if version != current {
+					return
+				}
+
+				if len(result.Dropped) > 0 {
+					t.Errorf("attributes dropped: %v", result.Dropped)
+				}
+				want, err := tftypes.ValueFromJSON(fixture, c.schemas[typeName].ValueType())
+				if err != nil {
+					t.Fatalf("unable to decode fixture: %s", err)
+				}
+				diffs, err := upgraded.Diff(want)
+				if err != nil {
+					t.Fatalf("unable to compare state: %s", err)
+				}
+				for _, diff := range diffs {
+					// Attributes added since the fixture was written are
+					// absent from it; only values it has must survive.
+					if diff.Value2 == nil || diff.Value2.IsNull() {
+						continue
+					}
+					t.Errorf("state changed on round trip at %s: got %s, want %s", diff.Path, diff.Value1, diff.Value2)
+				}
+			})
+		}
+	}
+}
+
+func TestCheckState(t *testing.T) {
+	c := newChecker(t)
+
+	stateJSON, err := os.ReadFile(filepath.Join("testdata", "terraform.tfstate"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := c.CheckState(context.Background(), stateJSON)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	type outcome struct {
+		errors  []string
+		dropped []string
+	}
+	want := map[string]outcome{
+		`ackack_monitor.website`:                    {},
+		`module.edge.ackack_monitor.regional["eu"]`: {dropped: []string{"legacy_region"}},
+		`ackack_alert.oncall[0]`:                    {errors: []string{"State From Newer Provider"}},
+		`ackack_status_widget.home`:                 {errors: []string{"Unknown Resource Type"}},
+		`ackack_system.checkout`:                    {errors: []string{"Unable to Read Previously Saved State for UpgradeResourceState"}},
+	}
+
+	if len(results) != len(want) {
+		t.Fatalf("expected %d results, got %d", len(want), len(results))
+	}
+	for _, result := range results {
+		expected, ok := want[result.Address]
+		if !ok {
+			t.Errorf("unexpected result for %s", result.Address)
+			continue
+		}
+		var errors []string
+		for _, d := range result.Diagnostics {
+			if d.Severity == tfprotov6.DiagnosticSeverityError {
+				errors = append(errors, d.Summary)
+			}
+		}
+		if !slices.Equal(errors, expected.errors) {
+			t.Errorf("%s: expected errors %q, got %q", result.Address, expected.errors, errors)
+		}
+		if !slices.Equal(result.Dropped, expected.dropped) {
+			t.Errorf("%s: expected dropped %q, got %q", result.Address, expected.dropped, result.Dropped)
+		}
+	}
+}
+
+func fixturePath(typeName string, version int64) string {
+	return filepath.Join("testdata", typeName, fmt.Sprintf("v%d.json", version))
+}
+
+func writeFixture(t *testing.T, typeName string, version int64, value any) {
+	t.Helper()
+
+	data, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join("testdata", typeName), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(fixturePath(typeName, version), append(data, '\n'), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// sampleValue returns a JSON value of the type with every attribute set, so
+// fixtures cover the whole schema.
+func sampleValue(typ tftypes.Type) any {
+	switch typ := typ.(type) {
+	case tftypes.Object:
+		object := make(map[string]any, len(typ.AttributeTypes))
+		for name, attributeType := range typ.AttributeTypes {
+			object[name] = sampleValue(attributeType)
+		}
+		return object
+	case tftypes.List:
+		return []any{sampleValue(typ.ElementType)}
+	case tftypes.Set:
+		return []any{sampleValue(typ.ElementType)}
+	case tftypes.Map:
+		return map[string]any{"key": sampleValue(typ.ElementType)}
+	}
+
+	switch {
+	case typ.Is(tftypes.Bool):
+		return true
+	case typ.Is(tftypes.Number):
+		return 1
+	}
+	return "example"
+}
@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/ackack-io/terraform-provider-ackack/internal/client"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// requestStats totals the API requests of every client configured in this
+// process, for the summary logged when the provider exits.
+var requestStats = &client.RequestStats{}
+
+// requestLogger is the client.RequestObserver of configured clients. It logs
+// each request and adds it to requestStats.
+type requestLogger struct{}
+
+func (requestLogger) ObserveRequest(ctx context.Context, metrics client.RequestMetrics) {
+	requestStats.ObserveRequest(ctx, metrics)
+
+	fields := map[string]any{
+		"method":       metrics.Method,
+		"path":         metrics.Path,
+		"status_code":  metrics.StatusCode,
+		"duration_ms":  metrics.Duration.Milliseconds(),
+		"retries":      metrics.Retries,
+		"rate_limited": metrics.RateLimited,
+	}
+	if metrics.Err != nil {
+		fields["error"] = metrics.Err.Error()
+	}
+	tflog.Debug(ctx, "ackack API request", fields)
+}
+
+// LogRequestSummary logs totals of the API requests made by the provider, so
+// operators can tell whether a slow plan or apply is spent waiting on the API.
+// It logs nothing when no requests were made.
+func LogRequestSummary(ctx context.Context) {
+	summary := requestStats.Summary()
+	if summary.Requests == 0 {
+		return
+	}
+
+	tflog.Info(ctx, "ackack API request summary", map[string]any{
+		"requests":            summary.Requests,
+		"errors":              summary.Errors,
+		"retries":             summary.Retries,
+		"rate_limited":        summary.RateLimited,
+		"total_duration_ms":   summary.TotalDuration.Milliseconds(),
+		"average_duration_ms": (summary.TotalDuration / time.Duration(summary.Requests)).Milliseconds(),
+		"slowest_request":     summary.SlowestPath,
+		"slowest_duration_ms": summary.SlowestDuration.Milliseconds(),
+	})
+}
@@ -0,0 +1,183 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/ackack-io/terraform-provider-ackack/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ThresholdWindowModel describes a recurring time window during which a
+// monitor uses different thresholds.
+type ThresholdWindowModel struct {
+	Days            types.Set             `tfsdk:"days"`
+	StartTime       types.String          `tfsdk:"start_time"`
+	EndTime         types.String          `tfsdk:"end_time"`
+	Timezone        types.String          `tfsdk:"timezone"`
+	TimeoutMs       types.Int64           `tfsdk:"timeout_ms"`
+	PhaseThresholds *PhaseThresholdsModel `tfsdk:"phase_thresholds"`
+}
+
+const minutesPerDay = 24 * 60
+
+// weekInterval is a half-open range of minutes since Monday 00:00.
+type weekInterval struct {
+	start, end int
+}
+
+// weekIntervals returns the minutes of the week covered by a window. A window
+// that ends before it starts spans midnight into the following day, and one
+// that runs past Sunday midnight wraps around to Monday.
+func weekIntervals(days []string, startTime, endTime string) []weekInterval {
+	start, end := clockMinutes(startTime), clockMinutes(endTime)
+	if end <= start {
+		end += minutesPerDay
+	}
+
+	var intervals []weekInterval
+	for _, day := range days {
+		index := slices.Index(weekdays, day)
+		if index < 0 {
+			continue
+		}
+		s, e := index*minutesPerDay+start, index*minutesPerDay+end
+		if week := len(weekdays) * minutesPerDay; e > week {
+			intervals = append(intervals, weekInterval{s, week}, weekInterval{0, e - week})
+			continue
+		}
+		intervals = append(intervals, weekInterval{s, e})
+	}
+	return intervals
+}
+
+// clockMinutes converts an HH:MM time of day to minutes since midnight.
+func clockMinutes(clock string) int {
+	hours, _ := strconv.Atoi(clock[:2])
+	minutes, _ := strconv.Atoi(clock[3:])
+	return hours*60 + minutes
+}
+
+// validateThresholdWindows reports windows that are empty or that overlap an
+// earlier window in the same time zone. Windows with unknown values are
+// skipped.
+func validateThresholdWindows(windows []ThresholdWindowModel, diags *diag.Diagnostics) {
+	type checkedWindow struct {
+		index     int
+		timezone  string
+		intervals []weekInterval
+	}
+	var checked []checkedWindow
+
+	for i, window := range windows {
+		windowPath := path.Root("threshold_window").AtListIndex(i)
+
+		if window.TimeoutMs.IsNull() && window.PhaseThresholds == nil {
+			diags.AddAttributeError(
+				windowPath,
+				"Invalid Threshold Window",
+				"A threshold_window must override timeout_ms, phase_thresholds, or both.",
+			)
+		}
+
+		if window.Days.IsUnknown() || window.StartTime.IsUnknown() || window.EndTime.IsUnknown() || window.Timezone.IsUnknown() ||
+			window.Days.IsNull() || window.StartTime.IsNull() || window.EndTime.IsNull() {
+			continue
+		}
+		if !clockTimeRegexp.MatchString(window.StartTime.ValueString()) || !clockTimeRegexp.MatchString(window.EndTime.ValueString()) {
+			continue
+		}
+		if window.StartTime.Equal(window.EndTime) {
+			diags.AddAttributeError(
+				windowPath.AtName("end_time"),
+				"Invalid Threshold Window",
+				"The end_time must differ from start_time.",
+			)
+			continue
+		}
+
+		current := checkedWindow{
+			index:     i,
+			timezone:  cmp.Or(window.Timezone.ValueString(), "UTC"),
+			intervals: weekIntervals(stringsFromSetValue(window.Days), window.StartTime.ValueString(), window.EndTime.ValueString()),
+		}
+		for _, previous := range checked {
+			if previous.timezone != current.timezone {
+				continue
+			}
+			if overlap, ok := firstOverlap(previous.intervals, current.intervals); ok {
+				diags.AddAttributeError(
+					windowPath,
+					"Overlapping Threshold Windows",
+					fmt.Sprintf("This window overlaps threshold_window[%d] starting %s %s. Threshold windows must not overlap.",
+						previous.index, weekdays[overlap/minutesPerDay], formatClockMinutes(overlap%minutesPerDay)),
+				)
+				break
+			}
+		}
+		checked = append(checked, current)
+	}
+}
+
+// firstOverlap returns the first minute of the week covered by both sets of
+// intervals.
+func firstOverlap(a, b []weekInterval) (int, bool) {
+	first, found := 0, false
+	for _, x := range a {
+		for _, y := range b {
+			start, end := max(x.start, y.start), min(x.end, y.end)
+			if start < end && (!found || start < first) {
+				first, found = start, true
+			}
+		}
+	}
+	return first, found
+}
+
+// formatClockMinutes formats minutes since midnight as HH:MM.
+func formatClockMinutes(minutes int) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
+
+func thresholdWindowsToClient(windows []ThresholdWindowModel) []client.ThresholdWindow {
+	if len(windows) == 0 {
+		return nil
+	}
+	result := make([]client.ThresholdWindow, 0, len(windows))
+	for _, m := range windows {
+		result = append(result, client.ThresholdWindow{
+			Days:            stringsFromSetValue(m.Days),
+			StartTime:       m.StartTime.ValueString(),
+			EndTime:         m.EndTime.ValueString(),
+			Timezone:        m.Timezone.ValueString(),
+			TimeoutMs:       int(m.TimeoutMs.ValueInt64()),
+			PhaseThresholds: phaseThresholdsToClient(m.PhaseThresholds),
+		})
+	}
+	return result
+}
+
+func thresholdWindowsFromClient(windows []client.ThresholdWindow) []ThresholdWindowModel {
+	result := make([]ThresholdWindowModel, 0, len(windows))
+	for _, c := range windows {
+		m := ThresholdWindowModel{
+			Days:      stringSetValue(c.Days),
+			StartTime: types.StringValue(c.StartTime),
+			EndTime:   types.StringValue(c.EndTime),
+			Timezone:  types.StringValue(cmp.Or(c.Timezone, "UTC")),
+			TimeoutMs: optionalInt64Value(c.TimeoutMs),
+		}
+		if c.PhaseThresholds != nil {
+			m.PhaseThresholds = phaseThresholdsFromClient(c.PhaseThresholds)
+		}
+		result = append(result, m)
+	}
+	return result
+}
@@ -0,0 +1,105 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testThresholdWindow(days []string, start, end, timezone string) ThresholdWindowModel {
+	return ThresholdWindowModel{
+		Days:      stringSetValue(days),
+		StartTime: types.StringValue(start),
+		EndTime:   types.StringValue(end),
+		Timezone:  types.StringValue(timezone),
+		TimeoutMs: types.Int64Value(2000),
+	}
+}
+
+func TestValidateThresholdWindows(t *testing.T) {
+	weekdaysOnly := []string{"mon", "tue", "wed", "thu", "fri"}
+
+	testCases := map[string]struct {
+		windows []ThresholdWindowModel
+		errors  int
+	}{
+		"adjacent": {
+			windows: []ThresholdWindowModel{
+				testThresholdWindow(weekdaysOnly, "09:00", "12:00", "UTC"),
+				testThresholdWindow(weekdaysOnly, "12:00", "17:00", "UTC"),
+			},
+		},
+		"overlapping": {
+			windows: []ThresholdWindowModel{
+				testThresholdWindow(weekdaysOnly, "09:00", "17:00", "UTC"),
+				testThresholdWindow([]string{"fri"}, "16:00", "18:00", "UTC"),
+			},
+			errors: 1,
+		},
+		"spans midnight into next day": {
+			windows: []ThresholdWindowModel{
+				testThresholdWindow([]string{"mon"}, "22:00", "02:00", "UTC"),
+				testThresholdWindow([]string{"tue"}, "01:00", "03:00", "UTC"),
+			},
+			errors: 1,
+		},
+		"wraps from sunday to monday": {
+			windows: []ThresholdWindowModel{
+				testThresholdWindow([]string{"sun"}, "23:00", "01:00", "UTC"),
+				testThresholdWindow([]string{"mon"}, "00:30", "08:00", "UTC"),
+			},
+			errors: 1,
+		},
+		"different time zones": {
+			windows: []ThresholdWindowModel{
+				testThresholdWindow(weekdaysOnly, "09:00", "17:00", "Europe/Berlin"),
+				testThresholdWindow(weekdaysOnly, "09:00", "17:00", "America/New_York"),
+			},
+		},
+		"empty window": {
+			windows: []ThresholdWindowModel{
+				testThresholdWindow(weekdaysOnly, "09:00", "09:00", "UTC"),
+			},
+			errors: 1,
+		},
+		"no overrides": {
+			windows: []ThresholdWindowModel{
+				{
+					Days:      stringSetValue(weekdaysOnly),
+					StartTime: types.StringValue("09:00"),
+					EndTime:   types.StringValue("17:00"),
+					Timezone:  types.StringValue("UTC"),
+					TimeoutMs: types.Int64Null(),
+				},
+			},
+			errors: 1,
+		},
+		"unknown days": {
+			windows: []ThresholdWindowModel{
+				testThresholdWindow(weekdaysOnly, "09:00", "17:00", "UTC"),
+				{
+					Days:      types.SetUnknown(types.StringType),
+					StartTime: types.StringValue("09:00"),
+					EndTime:   types.StringValue("17:00"),
+					Timezone:  types.StringValue("UTC"),
+					TimeoutMs: types.Int64Value(2000),
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			validateThresholdWindows(tc.windows, &diags)
+
+			if got := diags.ErrorsCount(); got != tc.errors {
+				t.Errorf("expected %d errors, got %d: %v", tc.errors, got, diags)
+			}
+		})
+	}
+}
@@ -0,0 +1,474 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package validate runs resource and data source configurations through the
+// provider's validation and planning in-process, so schema validators,
+// config validators and plan modifiers can be tested without Terraform or
+// API access. Configure and Apply also run creates, against a provider
+// configured with a test server as its endpoint.
+package validate
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Unknown stands in for a value that is not known until apply, such as a
+// reference to another resource's computed attribute.
+var Unknown = unknown{}
+
+type unknown struct{}
+
+// Values are resource attribute values keyed by attribute name. Each value
+// is a string, bool, int, float64, []any or []string for lists and sets,
+// map[string]any for objects and maps, nil for null, or Unknown.
+type Values map[string]any
+
+// Harness runs configurations against a provider server, which is
+// unconfigured until Configure is called.
+type Harness struct {
+	server            tfprotov6.ProviderServer
+	providerSchema    *tfprotov6.Schema
+	schemas           map[string]*tfprotov6.Schema
+	dataSourceSchemas map[string]*tfprotov6.Schema
+}
+
+// Result is the outcome of validating or planning a configuration.
+type Result struct {
+	Diagnostics []*tfprotov6.Diagnostic
+
+	// Planned is the planned state of a plan, keyed by attribute name.
+	Planned map[string]tftypes.Value
+
+	// RequiresReplace lists the attribute paths that force replacement.
+	RequiresReplace []string
+
+	// State is the new state of an apply, keyed by attribute name, and
+	// Private the private state the provider stored with it.
+	State   map[string]tftypes.Value
+	Private []byte
+
+	// Inconsistent lists the attributes of an apply whose new state differs
+	// from a known planned value, which Terraform rejects as an
+	// inconsistent result.
+	Inconsistent []string
+}
+
+// New returns a harness for the provider.
+func New(ctx context.Context, p provider.Provider) (*Harness, error) {
+	server, err := providerserver.NewProtocol6WithError(p)()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		return nil, err
+	}
+	if err := diagnosticsError(resp.Diagnostics); err != nil {
+		return nil, err
+	}
+
+	return &Harness{
+		server:            server,
+		providerSchema:    resp.Provider,
+		schemas:           resp.ResourceSchemas,
+		dataSourceSchemas: resp.DataSourceSchemas,
+	}, nil
+}
+
+// Configure configures the provider, so that Apply can call the API.
+func (h *Harness) Configure(ctx context.Context, config Values) (*Result, error) {
+	configValue, err := objectValue(h.providerSchema, config)
+	if err != nil {
+		return nil, err
+	}
+	configDV, err := tfprotov6.NewDynamicValue(h.providerSchema.ValueType(), configValue)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{
+		TerraformVersion: "1.14.0",
+		Config:           &configDV,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Result{Diagnostics: resp.Diagnostics}, nil
+}
+
+// Apply plans and applies the create of a resource from config. Planning
+// errors are returned in the result without applying.
+func (h *Harness) Apply(ctx context.Context, typeName string, config Values) (*Result, error) {
+	schema, err := h.schema(typeName)
+	if err != nil {
+		return nil, err
+	}
+	objectType := schema.ValueType()
+
+	configValue, err := objectValue(schema, config)
+	if err != nil {
+		return nil, err
+	}
+	priorDV, err := tfprotov6.NewDynamicValue(objectType, tftypes.NewValue(objectType, nil))
+	if err != nil {
+		return nil, err
+	}
+	configDV, err := tfprotov6.NewDynamicValue(objectType, configValue)
+	if err != nil {
+		return nil, err
+	}
+
+	planResp, err := h.server.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{
+		TypeName:         typeName,
+		PriorState:       &priorDV,
+		ProposedNewState: &configDV,
+		Config:           &configDV,
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := &Result{Diagnostics: planResp.Diagnostics}
+	if result.HasError() {
+		return result, nil
+	}
+
+	planned, err := planResp.PlannedState.Unmarshal(objectType)
+	if err != nil {
+		return nil, err
+	}
+	if err := planned.As(&result.Planned); err != nil {
+		return nil, err
+	}
+
+	applyResp, err := h.server.ApplyResourceChange(ctx, &tfprotov6.ApplyResourceChangeRequest{
+		TypeName:       typeName,
+		PriorState:     &priorDV,
+		PlannedState:   planResp.PlannedState,
+		Config:         &configDV,
+		PlannedPrivate: planResp.PlannedPrivate,
+	})
+	if err != nil {
+		return nil, err
+	}
+	result.Diagnostics = append(result.Diagnostics, applyResp.Diagnostics...)
+	result.Private = applyResp.Private
+
+	if applyResp.NewState != nil {
+		state, err := applyResp.NewState.Unmarshal(objectType)
+		if err != nil {
+			return nil, err
+		}
+		if !state.IsNull() {
+			if err := state.As(&result.State); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for name, value := range result.Planned {
+		if value.IsFullyKnown() && result.State != nil && !value.Equal(result.State[name]) {
+			result.Inconsistent = append(result.Inconsistent, name)
+		}
+	}
+	sort.Strings(result.Inconsistent)
+
+	return result, nil
+}
+
+// Validate runs the config validation of a resource, which covers attribute
+// validators, ConfigValidators and ValidateConfig.
+func (h *Harness) Validate(ctx context.Context, typeName string, config Values) (*Result, error) {
+	schema, err := h.schema(typeName)
+	if err != nil {
+		return nil, err
+	}
+	configValue, err := objectValue(schema, config)
+	if err != nil {
+		return nil, err
+	}
+	configDV, err := tfprotov6.NewDynamicValue(schema.ValueType(), configValue)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.server.ValidateResourceConfig(ctx, &tfprotov6.ValidateResourceConfigRequest{
+		TypeName: typeName,
+		Config:   &configDV,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Result{Diagnostics: resp.Diagnostics}, nil
+}
+
+// ValidateDataSource runs the config validation of a data source.
+func (h *Harness) ValidateDataSource(ctx context.Context, typeName string, config Values) (*Result, error) {
+	schema, ok := h.dataSourceSchemas[typeName]
+	if !ok {
+		return nil, fmt.Errorf("unknown data source type %q", typeName)
+	}
+	configValue, err := objectValue(schema, config)
+	if err != nil {
+		return nil, err
+	}
+	configDV, err := tfprotov6.NewDynamicValue(schema.ValueType(), configValue)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.server.ValidateDataResourceConfig(ctx, &tfprotov6.ValidateDataResourceConfigRequest{
+		TypeName: typeName,
+		Config:   &configDV,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Result{Diagnostics: resp.Diagnostics}, nil
+}
+
+// Plan plans a change from prior to config, which covers defaults, plan
+// modifiers and ModifyPlan. A nil prior plans a create and a nil config
+// plans a destroy. Like Terraform, attributes that are null in config but
+// computed keep their prior value in the proposed new state.
+func (h *Harness) Plan(ctx context.Context, typeName string, prior, config Values) (*Result, error) {
+	return h.PlanWithPrivate(ctx, typeName, prior, nil, config)
+}
+
+// PlanWithPrivate plans a change like Plan, from a prior state stored with
+// the given private state, such as the Private of an Apply result.
+func (h *Harness) PlanWithPrivate(ctx context.Context, typeName string, prior Values, priorPrivate []byte, config Values) (*Result, error) {
+	schema, err := h.schema(typeName)
+	if err != nil {
+		return nil, err
+	}
+	objectType := schema.ValueType()
+
+	priorValue := tftypes.NewValue(objectType, nil)
+	if prior != nil {
+		if priorValue, err = objectValue(schema, prior); err != nil {
+			return nil, err
+		}
+	}
+	configValue := tftypes.NewValue(objectType, nil)
+	proposedValue := tftypes.NewValue(objectType, nil)
+	if config != nil {
+		if configValue, err = objectValue(schema, config); err != nil {
+			return nil, err
+		}
+		proposed := make(Values, len(config))
+		for name, value := range config {
+			proposed[name] = value
+		}
+		for _, attribute := range schema.Block.Attributes {
+			if _, ok := config[attribute.Name]; !ok && attribute.Computed && prior != nil {
+				proposed[attribute.Name] = prior[attribute.Name]
+			}
+		}
+		if proposedValue, err = objectValue(schema, proposed); err != nil {
+			return nil, err
+		}
+	}
+
+	priorDV, err := tfprotov6.NewDynamicValue(objectType, priorValue)
+	if err != nil {
+		return nil, err
+	}
+	configDV, err := tfprotov6.NewDynamicValue(objectType, configValue)
+	if err != nil {
+		return nil, err
+	}
+	proposedDV, err := tfprotov6.NewDynamicValue(objectType, proposedValue)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.server.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{
+		TypeName:         typeName,
+		PriorState:       &priorDV,
+		ProposedNewState: &proposedDV,
+		Config:           &configDV,
+		PriorPrivate:     priorPrivate,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{Diagnostics: resp.Diagnostics}
+	for _, p := range resp.RequiresReplace {
+		result.RequiresReplace = append(result.RequiresReplace, p.String())
+	}
+	sort.Strings(result.RequiresReplace)
+
+	if resp.PlannedState != nil {
+		planned, err := resp.PlannedState.Unmarshal(objectType)
+		if err != nil {
+			return nil, err
+		}
+		if !planned.IsNull() {
+			if err := planned.As(&result.Planned); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// HasError reports whether the result has error diagnostics.
+func (r *Result) HasError() bool {
+	for _, d := range r.Diagnostics {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// Summaries returns the summaries of the diagnostics with the given
+// severity, in order.
+func (r *Result) Summaries(severity tfprotov6.DiagnosticSeverity) []string {
+	var summaries []string
+	for _, d := range r.Diagnostics {
+		if d.Severity == severity {
+			summaries = append(summaries, d.Summary)
+		}
+	}
+	return summaries
+}
+
+func (h *Harness) schema(typeName string) (*tfprotov6.Schema, error) {
+	schema, ok := h.schemas[typeName]
+	if !ok {
+		return nil, fmt.Errorf("unknown resource type %q", typeName)
+	}
+	return schema, nil
+}
+
+// objectValue converts values to an object of the schema's type. Missing
+// attributes are null and missing blocks are empty.
+func objectValue(schema *tfprotov6.Schema, values Values) (tftypes.Value, error) {
+	objectType := schema.ValueType().(tftypes.Object)
+
+	for name := range values {
+		if _, ok := objectType.AttributeTypes[name]; !ok {
+			return tftypes.Value{}, fmt.Errorf("unknown attribute %q", name)
+		}
+	}
+
+	blocks := make(map[string]bool, len(schema.Block.BlockTypes))
+	for _, block := range schema.Block.BlockTypes {
+		blocks[block.TypeName] = true
+	}
+
+	attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, typ := range objectType.AttributeTypes {
+		value, ok := values[name]
+		if !ok && blocks[name] {
+			value = []any{}
+		}
+		v, err := toValue(typ, value)
+		if err != nil {
+			return tftypes.Value{}, fmt.Errorf("attribute %q: %w", name, err)
+		}
+		attributes[name] = v
+	}
+
+	return tftypes.NewValue(objectType, attributes), nil
+}
+
+// toValue converts a Go value to a value of the given type.
+func toValue(typ tftypes.Type, value any) (tftypes.Value, error) {
+	switch value := value.(type) {
+	case nil:
+		return tftypes.NewValue(typ, nil), nil
+	case unknown:
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	case []string:
+		elements := make([]any, len(value))
+		for i, v := range value {
+			elements[i] = v
+		}
+		return toValue(typ, elements)
+	}
+
+	switch typ := typ.(type) {
+	case tftypes.List, tftypes.Set:
+		elements, ok := value.([]any)
+		if !ok {
+			return tftypes.Value{}, fmt.Errorf("expected []any for %s, got %T", typ, value)
+		}
+		var elementType tftypes.Type
+		if list, ok := typ.(tftypes.List); ok {
+			elementType = list.ElementType
+		} else {
+			elementType = typ.(tftypes.Set).ElementType
+		}
+		converted := make([]tftypes.Value, len(elements))
+		for i, element := range elements {
+			v, err := toValue(elementType, element)
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+			converted[i] = v
+		}
+		return tftypes.NewValue(typ, converted), nil
+	case tftypes.Map:
+		entries, ok := value.(map[string]any)
+		if !ok {
+			return tftypes.Value{}, fmt.Errorf("expected map[string]any for %s, got %T", typ, value)
+		}
+		converted := make(map[string]tftypes.Value, len(entries))
+		for key, entry := range entries {
+			v, err := toValue(typ.ElementType, entry)
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+			converted[key] = v
+		}
+		return tftypes.NewValue(typ, converted), nil
+	case tftypes.Object:
+		fields, ok := value.(map[string]any)
+		if !ok {
+			return tftypes.Value{}, fmt.Errorf("expected map[string]any for %s, got %T", typ, value)
+		}
+		for name := range fields {
+			if _, ok := typ.AttributeTypes[name]; !ok {
+				return tftypes.Value{}, fmt.Errorf("unknown attribute %q", name)
+			}
+		}
+		converted := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+		for name, attributeType := range typ.AttributeTypes {
+			v, err := toValue(attributeType, fields[name])
+			if err != nil {
+				return tftypes.Value{}, fmt.Errorf("attribute %q: %w", name, err)
+			}
+			converted[name] = v
+		}
+		return tftypes.NewValue(typ, converted), nil
+	}
+
+	if err := tftypes.ValidateValue(typ, value); err != nil {
+		return tftypes.Value{}, err
+	}
+	return tftypes.NewValue(typ, value), nil
+}
+
+func diagnosticsError(diags []*tfprotov6.Diagnostic) error {
+	for _, d := range diags {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			return fmt.Errorf("%s: %s", d.Summary, d.Detail)
+		}
+	}
+	return nil
+}
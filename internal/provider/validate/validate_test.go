@@ -0,0 +1,787 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validate_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ackack-io/terraform-provider-ackack/internal/provider"
+	"github.com/ackack-io/terraform-provider-ackack/internal/provider/validate"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type validateCase struct {
+	config validate.Values
+	errors []string
+}
+
+func newHarness(t *testing.T) *validate.Harness {
+	t.Helper()
+
+	h, err := validate.New(context.Background(), provider.New("test")())
+	if err != nil {
+		t.Fatalf("unable to create harness: %s", err)
+	}
+	return h
+}
+
+// testAPIKey is the API key newConfiguredHarness configures the provider
+// with.
+const testAPIKey = "ak_live_s3cr3t"
+
+// newConfiguredHarness returns a harness whose provider is configured with
+// testAPIKey and a test server running handler as its endpoint. The server
+// reports a compatible API version; other requests go to handler.
+func newConfiguredHarness(t *testing.T, endpointUserinfo string, handler http.HandlerFunc) *validate.Harness {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/capabilities" {
+			_ = json.NewEncoder(w).Encode(map[string]any{"version": "1.9.0", "features": []string{}})
+			return
+		}
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	endpoint := server.URL
+	if endpointUserinfo != "" {
+		endpoint = strings.Replace(endpoint, "http://", "http://"+endpointUserinfo+"@", 1)
+	}
+
+	h := newHarness(t)
+	result, err := h.Configure(context.Background(), validate.Values{"api_key": testAPIKey, "endpoint": endpoint})
+	if err != nil {
+		t.Fatalf("unable to configure provider: %s", err)
+	}
+	if result.HasError() {
+		t.Fatalf("unable to configure provider: %v", result.Diagnostics)
+	}
+	return h
+}
+
+// echoCreate answers a create with the request body and the given ID.
+func echoCreate(w http.ResponseWriter, r *http.Request, id string) {
+	var body map[string]any
+	_ = json.NewDecoder(r.Body).Decode(&body)
+	body["id"] = id
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func runValidateCases(t *testing.T, typeName string, testCases map[string]validateCase) {
+	t.Helper()
+	h := newHarness(t)
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			result, err := h.Validate(context.Background(), typeName, tc.config)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got := result.Summaries(tfprotov6.DiagnosticSeverityError)
+			slices.Sort(got)
+			want := slices.Sorted(slices.Values(tc.errors))
+			if !slices.Equal(got, want) {
+				t.Errorf("expected errors %q, got %q: %v", want, got, result.Diagnostics)
+			}
+		})
+	}
+}
+
+// withValues returns base with the given values added.
+func withValues(base validate.Values, values validate.Values) validate.Values {
+	merged := make(validate.Values, len(base)+len(values))
+	for k, v := range base {
+		merged[k] = v
+	}
+	for k, v := range values {
+		merged[k] = v
+	}
+	return merged
+}
+
+var (
+	httpMonitor = validate.Values{"name": "Website", "type": "http", "url": "https://example.com"}
+	sslMonitor  = validate.Values{"name": "Certificate", "type": "ssl", "domain": "example.com"}
+	tcpMonitor  = validate.Values{"name": "Port", "type": "tcp", "host": "example.com", "port": 443}
+	dnsMonitor  = validate.Values{"name": "DNS", "type": "dns", "url": "example.com", "dns_record_type": "A"}
+)
+
+var businessHours = map[string]any{
+	"days":       []string{"mon", "tue", "wed", "thu", "fri"},
+	"start_time": "09:00",
+	"end_time":   "17:00",
+	"timeout_ms": 3000,
+}
+
+func TestMonitorResource_Validate(t *testing.T) {
+	runValidateCases(t, "ackack_monitor", map[string]validateCase{
+		// Base configurations
+		"http":                 {config: httpMonitor},
+		"ssl":                  {config: sslMonitor},
+		"tcp":                  {config: tcpMonitor},
+		"dns":                  {config: dnsMonitor},
+		"missing name":         {config: validate.Values{"type": "http", "url": "https://example.com"}, errors: []string{"Missing Configuration for Required Attribute"}},
+		"invalid type":         {config: withValues(httpMonitor, validate.Values{"type": "ftp"}), errors: []string{"Invalid Attribute Value Match"}},
+		"unknown type skipped": {config: withValues(sslMonitor, validate.Values{"type": validate.Unknown, "server_name": "admin.example.com"})},
+
+		// URL components
+		"http with host":         {config: validate.Values{"name": "API", "type": "http", "host": "api.example.com", "path": "/healthz"}},
+		"http with url and host": {config: withValues(httpMonitor, validate.Values{"host": "api.example.com"}), errors: []string{"Invalid Attribute Combination"}},
+		"http path without host": {config: withValues(httpMonitor, validate.Values{"path": "/healthz"}), errors: []string{"Invalid Attribute Combination"}},
+		"tcp with path":          {config: withValues(tcpMonitor, validate.Values{"path": "/healthz"}), errors: []string{"Invalid Attribute Combination"}},
+
+		// Addresses
+		"http url without scheme":  {config: withValues(httpMonitor, validate.Values{"url": "example.com"}), errors: []string{"Invalid URL"}},
+		"http url with ftp scheme": {config: withValues(httpMonitor, validate.Values{"url": "ftp://example.com"}), errors: []string{"Invalid URL"}},
+		"http url unknown":         {config: withValues(httpMonitor, validate.Values{"url": validate.Unknown})},
+		"dns url with scheme":      {config: withValues(dnsMonitor, validate.Values{"url": "https://example.com"}), errors: []string{"Invalid Hostname"}},
+		"invalid host":             {config: withValues(tcpMonitor, validate.Values{"host": "exa mple.com"}), errors: []string{"Invalid Hostname"}},
+		"ip host":                  {config: withValues(tcpMonitor, validate.Values{"host": "192.0.2.10"})},
+		"port out of range":        {config: withValues(tcpMonitor, validate.Values{"port": 70000}), errors: []string{"Invalid Attribute Value"}},
+		"nameserver with port":     {config: withValues(dnsMonitor, validate.Values{"nameserver": "[2001:db8::53]:53"})},
+		"invalid nameserver":       {config: withValues(dnsMonitor, validate.Values{"nameserver": "udp://1.1.1.1"}), errors: []string{"Invalid Nameserver"}},
+
+		// Check timing
+		"retries within frequency":   {config: withValues(httpMonitor, validate.Values{"frequency_seconds": 30, "timeout_ms": 5000, "retries": 5})},
+		"retries exceed frequency":   {config: withValues(httpMonitor, validate.Values{"frequency_seconds": 30, "timeout_ms": 10000, "retries": 3}), errors: []string{"Invalid Check Timing"}},
+		"default timeout too long":   {config: withValues(httpMonitor, validate.Values{"frequency_seconds": 5}), errors: []string{"Invalid Check Timing"}},
+		"unknown frequency":          {config: withValues(httpMonitor, validate.Values{"frequency_seconds": validate.Unknown, "retries": 10})},
+		"too many retries":           {config: withValues(httpMonitor, validate.Values{"timeout_ms": 1000, "retries": 11}), errors: []string{"Invalid Attribute Value"}},
+		"timeout too short":          {config: withValues(httpMonitor, validate.Values{"timeout_ms": 50}), errors: []string{"Invalid Attribute Value"}},
+		"expiration threshold range": {config: withValues(sslMonitor, validate.Values{"expiration_threshold": 400}), errors: []string{"Invalid Attribute Value"}},
+
+		// First check
+		"wait for first check":             {config: withValues(httpMonitor, validate.Values{"wait_for_first_check": map[string]any{"timeout_seconds": 120}})},
+		"wait for first check on disabled": {config: withValues(httpMonitor, validate.Values{"is_enabled": false, "wait_for_first_check": map[string]any{}}), errors: []string{"Invalid Wait For First Check"}},
+		"wait for first check paused":      {config: withValues(httpMonitor, validate.Values{"start_paused": true, "wait_for_first_check": map[string]any{}}), errors: []string{"Invalid Wait For First Check"}},
+		"negative max failed regions":      {config: withValues(httpMonitor, validate.Values{"wait_for_first_check": map[string]any{"max_failed_regions": -1}}), errors: []string{"Invalid Attribute Value"}},
+
+		// Initial apply
+		"start paused":             {config: withValues(httpMonitor, validate.Values{"start_paused": true})},
+		"start paused and enabled": {config: withValues(httpMonitor, validate.Values{"start_paused": true, "is_enabled": true}), errors: []string{"Invalid Attribute Combination"}},
+
+		// Body validation
+		"body pattern mode":                 {config: withValues(httpMonitor, validate.Values{"body_pattern": "ok", "body_pattern_mode": "not_contains"})},
+		"body pattern mode without pattern": {config: withValues(httpMonitor, validate.Values{"body_pattern_mode": "contains"}), errors: []string{"Missing Body Pattern"}},
+		"invalid body pattern mode":         {config: withValues(httpMonitor, validate.Values{"body_pattern": "ok", "body_pattern_mode": "equals"}), errors: []string{"Invalid Attribute Value Match"}},
+		"regex body pattern":                {config: withValues(httpMonitor, validate.Values{"body_pattern": `"status":\s*"ok"`, "body_pattern_is_regex": true})},
+		"response schema":                   {config: withValues(httpMonitor, validate.Values{"response_schema": `{"type": "object", "required": ["status"]}`})},
+		"invalid response schema":           {config: withValues(httpMonitor, validate.Values{"response_schema": `{"type": "dict"}`}), errors: []string{"Invalid Response Schema"}},
+		"response schema on tcp":            {config: withValues(tcpMonitor, validate.Values{"response_schema": `{"type": "object"}`}), errors: []string{"Invalid Attribute Combination"}},
+		"invalid regex body pattern":        {config: withValues(httpMonitor, validate.Values{"body_pattern": "(ok", "body_pattern_is_regex": true}), errors: []string{"Invalid Body Pattern"}},
+		"literal body pattern":              {config: withValues(httpMonitor, validate.Values{"body_pattern": "(ok"})},
+
+		// Regions
+		"regions quorum":                      {config: withValues(httpMonitor, validate.Values{"regions": []string{"us-east", "eu-west"}, "min_regions_failing": 2})},
+		"regions quorum too high":             {config: withValues(httpMonitor, validate.Values{"regions": []string{"us-east", "eu-west"}, "min_regions_failing": 3}), errors: []string{"Invalid Region Quorum"}},
+		"regions quorum without regions":      {config: withValues(httpMonitor, validate.Values{"min_regions_failing": 1}), errors: []string{"Missing Regions"}},
+		"regions quorum with unknown regions": {config: withValues(httpMonitor, validate.Values{"regions": validate.Unknown, "min_regions_failing": 3})},
+		"general region with regions":         {config: withValues(httpMonitor, validate.Values{"general_region": "us", "regions": []string{"us-east"}}), errors: []string{"Invalid Attribute Combination"}},
+
+		// Redirects
+		"max redirects":                      {config: withValues(httpMonitor, validate.Values{"max_redirects": 5})},
+		"max redirects out of range":         {config: withValues(httpMonitor, validate.Values{"max_redirects": 50}), errors: []string{"Invalid Attribute Value"}},
+		"max redirects without redirects":    {config: withValues(httpMonitor, validate.Values{"follow_redirects": false, "max_redirects": 5}), errors: []string{"Invalid Attribute Combination"}},
+		"max redirects with unknown follows": {config: withValues(httpMonitor, validate.Values{"follow_redirects": validate.Unknown, "max_redirects": 5})},
+
+		// Dependencies
+		"inherit maintenance":                    {config: withValues(httpMonitor, validate.Values{"depends_on_monitor_ids": []string{"mon_db"}, "inherit_maintenance": true})},
+		"inherit maintenance without dependency": {config: withValues(httpMonitor, validate.Values{"inherit_maintenance": true}), errors: []string{"Missing Dependencies"}},
+
+		// Type-specific attributes
+		"dnssec on dns":            {config: withValues(dnsMonitor, validate.Values{"validate_dnssec": true})},
+		"dnssec on http":           {config: withValues(httpMonitor, validate.Values{"validate_dnssec": true}), errors: []string{"Invalid Attribute Combination"}},
+		"tls on tcp":               {config: withValues(tcpMonitor, validate.Values{"port": 6514, "use_tls": true, "server_name": "logs.example.com"})},
+		"tls on http":              {config: withValues(httpMonitor, validate.Values{"use_tls": true}), errors: []string{"Invalid Attribute Combination"}},
+		"server name without tls":  {config: withValues(tcpMonitor, validate.Values{"server_name": "logs.example.com"}), errors: []string{"Invalid Attribute Combination"}},
+		"origin override":          {config: withValues(httpMonitor, validate.Values{"resolve_to_ip": "203.0.113.10", "host_header": "www.example.com"})},
+		"invalid resolve ip":       {config: withValues(httpMonitor, validate.Values{"resolve_to_ip": "origin.example.com"}), errors: []string{"Invalid IP Address"}},
+		"resolve ip version":       {config: withValues(httpMonitor, validate.Values{"resolve_to_ip": "2001:db8::10", "ip_version": "ipv4"}), errors: []string{"Invalid Attribute Combination"}},
+		"host header and headers":  {config: withValues(httpMonitor, validate.Values{"host_header": "www.example.com", "headers": `{"host": "example.com"}`}), errors: []string{"Invalid Attribute Combination"}},
+		"resolve ip on tcp":        {config: withValues(tcpMonitor, validate.Values{"resolve_to_ip": "203.0.113.10"}), errors: []string{"Invalid Attribute Combination"}},
+		"ip version on http":       {config: withValues(httpMonitor, validate.Values{"ip_version": "ipv6"})},
+		"ip version on tcp":        {config: withValues(tcpMonitor, validate.Values{"ip_version": "ipv4"})},
+		"ip version on dns":        {config: withValues(dnsMonitor, validate.Values{"ip_version": "ipv4"}), errors: []string{"Invalid Attribute Combination"}},
+		"invalid ip version":       {config: withValues(httpMonitor, validate.Values{"ip_version": "ipv5"}), errors: []string{"Invalid Attribute Value Match"}},
+		"phase thresholds on http": {config: withValues(httpMonitor, validate.Values{"phase_thresholds": map[string]any{"tls_ms": 300}})},
+		"phase thresholds on tcp":  {config: withValues(tcpMonitor, validate.Values{"phase_thresholds": map[string]any{"tls_ms": 300}}), errors: []string{"Invalid Attribute Combination"}},
+		"zero phase threshold":     {config: withValues(httpMonitor, validate.Values{"phase_thresholds": map[string]any{"dns_ms": 0}}), errors: []string{"Invalid Attribute Value"}},
+
+		// SSL
+		"ssl checks": {config: withValues(sslMonitor, validate.Values{
+			"server_name":      "admin.example.com",
+			"check_chain":      true,
+			"expected_issuer":  "Let's Encrypt",
+			"check_revocation": true,
+		})},
+		"ssl checks on http": {config: withValues(httpMonitor, validate.Values{
+			"server_name":      "admin.example.com",
+			"check_chain":      true,
+			"expected_issuer":  "Let's Encrypt",
+			"check_revocation": true,
+		}), errors: []string{
+			"Invalid Attribute Combination",
+			"Invalid Attribute Combination",
+			"Invalid Attribute Combination",
+			"Invalid Attribute Combination",
+		}},
+		"invalid server name": {config: withValues(sslMonitor, validate.Values{"server_name": "xn--a.example.com"}), errors: []string{"Invalid Hostname"}},
+		"fingerprint":         {config: withValues(sslMonitor, validate.Values{"expected_fingerprint_sha256": "5eff56a2af15882529d86aa09f0c35e14f6a4ca481522c532446e6290188b877"})},
+		"colon fingerprint": {config: withValues(sslMonitor, validate.Values{
+			"expected_fingerprint_sha256": "5E:FF:56:A2:AF:15:88:25:29:D8:6A:A0:9F:0C:35:E1:4F:6A:4C:A4:81:52:2C:53:24:46:E6:29:01:88:B8:77",
+		})},
+		"short fingerprint":      {config: withValues(sslMonitor, validate.Values{"expected_fingerprint_sha256": "5eff56a2"}), errors: []string{"Invalid Attribute Value Match"}},
+		"non-hex fingerprint":    {config: withValues(sslMonitor, validate.Values{"expected_fingerprint_sha256": "zzff56a2af15882529d86aa09f0c35e14f6a4ca481522c532446e6290188b877"}), errors: []string{"Invalid Attribute Value Match"}},
+		"fingerprint on tcp":     {config: withValues(tcpMonitor, validate.Values{"expected_fingerprint_sha256": "5eff56a2af15882529d86aa09f0c35e14f6a4ca481522c532446e6290188b877"}), errors: []string{"Invalid Attribute Combination"}},
+		"invalid severity key":   {config: withValues(httpMonitor, validate.Values{"severity_mapping": map[string]any{"slow": "critical"}}), errors: []string{"Invalid Attribute Value Match"}},
+		"invalid severity value": {config: withValues(httpMonitor, validate.Values{"severity_mapping": map[string]any{"timeout": "fatal"}}), errors: []string{"Invalid Attribute Value Match"}},
+		"severity mapping":       {config: withValues(httpMonitor, validate.Values{"severity_mapping": map[string]any{"timeout": "critical", "phase_threshold": "warning"}})},
+
+		// Threshold windows
+		"threshold window": {config: withValues(httpMonitor, validate.Values{"threshold_window": []any{businessHours}})},
+		"overlapping threshold windows": {config: withValues(httpMonitor, validate.Values{"threshold_window": []any{
+			businessHours,
+			map[string]any{"days": []string{"fri"}, "start_time": "16:00", "end_time": "20:00", "timeout_ms": 5000},
+		}}), errors: []string{"Overlapping Threshold Windows"}},
+		"threshold windows in different time zones": {config: withValues(httpMonitor, validate.Values{"threshold_window": []any{
+			businessHours,
+			map[string]any{"days": []string{"fri"}, "start_time": "16:00", "end_time": "20:00", "timezone": "Asia/Tokyo", "timeout_ms": 5000},
+		}})},
+		"threshold window without overrides": {config: withValues(httpMonitor, validate.Values{"threshold_window": []any{
+			map[string]any{"days": []string{"mon"}, "start_time": "09:00", "end_time": "17:00"},
+		}}), errors: []string{"Invalid Threshold Window"}},
+		"threshold window with invalid time zone": {config: withValues(httpMonitor, validate.Values{"threshold_window": []any{
+			map[string]any{"days": []string{"mon"}, "start_time": "09:00", "end_time": "17:00", "timezone": "Mars/Olympus", "timeout_ms": 3000},
+		}}), errors: []string{"Invalid Time Zone"}},
+		"threshold window with invalid day": {config: withValues(httpMonitor, validate.Values{"threshold_window": []any{
+			map[string]any{"days": []string{"monday"}, "start_time": "09:00", "end_time": "17:00", "timeout_ms": 3000},
+		}}), errors: []string{"Invalid Attribute Value Match"}},
+		"threshold window with invalid time": {config: withValues(httpMonitor, validate.Values{"threshold_window": []any{
+			map[string]any{"days": []string{"mon"}, "start_time": "9am", "end_time": "17:00", "timeout_ms": 3000},
+		}}), errors: []string{"Invalid Attribute Value Match"}},
+		"threshold window timeout exceeds frequency": {config: withValues(httpMonitor, validate.Values{"frequency_seconds": 30, "threshold_window": []any{
+			map[string]any{"days": []string{"mon"}, "start_time": "09:00", "end_time": "17:00", "timeout_ms": 45000},
+		}}), errors: []string{"Invalid Check Timing"}},
+		"threshold window phase thresholds on tcp": {config: withValues(tcpMonitor, validate.Values{"threshold_window": []any{
+			map[string]any{"days": []string{"mon"}, "start_time": "09:00", "end_time": "17:00", "phase_thresholds": map[string]any{"connect_ms": 100}},
+		}}), errors: []string{"Invalid Attribute Combination"}},
+
+		// Schedule
+		"schedule": {config: withValues(httpMonitor, validate.Values{"schedule": []any{
+			map[string]any{"days": []string{"mon", "tue", "wed", "thu", "fri"}, "start_time": "08:00", "end_time": "18:00", "timezone": "Europe/Berlin"},
+		}})},
+		"overlapping schedule windows": {config: withValues(httpMonitor, validate.Values{"schedule": []any{
+			map[string]any{"days": []string{"mon", "tue", "wed", "thu", "fri"}, "start_time": "08:00", "end_time": "18:00"},
+			map[string]any{"days": []string{"fri"}, "start_time": "16:00", "end_time": "02:00"},
+		}})},
+		"schedule with invalid time zone": {config: withValues(httpMonitor, validate.Values{"schedule": []any{
+			map[string]any{"days": []string{"mon"}, "start_time": "08:00", "end_time": "18:00", "timezone": "Mars/Olympus"},
+		}}), errors: []string{"Invalid Time Zone"}},
+		"empty schedule window": {config: withValues(httpMonitor, validate.Values{"schedule": []any{
+			map[string]any{"days": []string{"mon"}, "start_time": "08:00", "end_time": "08:00"},
+		}}), errors: []string{"Invalid Schedule"}},
+		"schedule with invalid day": {config: withValues(httpMonitor, validate.Values{"schedule": []any{
+			map[string]any{"days": []string{"weekdays"}, "start_time": "08:00", "end_time": "18:00"},
+		}}), errors: []string{"Invalid Attribute Value Match"}},
+
+		// DNS expected records
+		"srv record": {config: withValues(dnsMonitor, validate.Values{"dns_record_type": "SRV", "expected_record": []any{
+			map[string]any{"value": "sip.example.com", "priority": 10, "weight": 60, "port": 5060},
+		}})},
+		"caa record": {config: withValues(dnsMonitor, validate.Values{"dns_record_type": "CAA", "expected_record": []any{
+			map[string]any{"value": "letsencrypt.org", "flags": 0, "tag": "issue"},
+		}})},
+		"srv record without port": {config: withValues(dnsMonitor, validate.Values{"dns_record_type": "SRV", "expected_record": []any{
+			map[string]any{"value": "sip.example.com", "priority": 10, "weight": 60},
+		}}), errors: []string{"Missing Attribute Configuration"}},
+		"mx record with tag": {config: withValues(dnsMonitor, validate.Values{"dns_record_type": "MX", "expected_record": []any{
+			map[string]any{"value": "mx1.example.com", "priority": 10, "tag": "issue"},
+		}}), errors: []string{"Invalid Attribute Combination"}},
+		"invalid caa tag": {config: withValues(dnsMonitor, validate.Values{"dns_record_type": "CAA", "expected_record": []any{
+			map[string]any{"value": "letsencrypt.org", "flags": 0, "tag": "issuer"},
+		}}), errors: []string{"Invalid Attribute Value Match"}},
+		"expected record and value": {config: withValues(dnsMonitor, validate.Values{"expected_value": "192.0.2.1", "expected_record": []any{
+			map[string]any{"value": "192.0.2.1"},
+		}}), errors: []string{"Invalid Attribute Combination"}},
+		"expected record on http": {config: withValues(httpMonitor, validate.Values{"expected_record": []any{
+			map[string]any{"value": "192.0.2.1"},
+		}}), errors: []string{"Invalid Attribute Combination"}},
+	})
+}
+
+// testClientCertificate returns a self-signed PEM certificate and its key.
+func testClientCertificate(t *testing.T) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("unable to generate key: %s", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "probe.example.com"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("unable to create certificate: %s", err)
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("unable to marshal key: %s", err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}))
+}
+
+func TestMonitorResource_ValidateClientCertificate(t *testing.T) {
+	cert, key := testClientCertificate(t)
+	_, otherKey := testClientCertificate(t)
+
+	runValidateCases(t, "ackack_monitor", map[string]validateCase{
+		"client certificate":             {config: withValues(httpMonitor, validate.Values{"client_cert_pem": cert, "client_key_pem": key})},
+		"client certificate without key": {config: withValues(httpMonitor, validate.Values{"client_cert_pem": cert}), errors: []string{"Invalid Attribute Combination"}},
+		"mismatched client key":          {config: withValues(httpMonitor, validate.Values{"client_cert_pem": cert, "client_key_pem": otherKey}), errors: []string{"Invalid Client Certificate"}},
+		"malformed client certificate":   {config: withValues(httpMonitor, validate.Values{"client_cert_pem": "not a certificate", "client_key_pem": key}), errors: []string{"Invalid Client Certificate"}},
+		"unknown client certificate":     {config: withValues(httpMonitor, validate.Values{"client_cert_pem": validate.Unknown, "client_key_pem": key})},
+		"client certificate on tcp":      {config: withValues(tcpMonitor, validate.Values{"client_cert_pem": cert, "client_key_pem": key}), errors: []string{"Invalid Attribute Combination"}},
+	})
+}
+
+func TestAlertResource_Validate(t *testing.T) {
+	email := validate.Values{"monitor_id": "mon_abc123", "type": "email", "target": "ops@example.com"}
+	sms := validate.Values{"monitor_id": "mon_abc123", "type": "sms", "target": "+14155550123"}
+	webhook := validate.Values{"monitor_id": "mon_abc123", "type": "webhook", "target": "https://hooks.example.com/ackack"}
+
+	runValidateCases(t, "ackack_alert", map[string]validateCase{
+		"email":                    {config: email},
+		"invalid type":             {config: withValues(email, validate.Values{"type": "pager"}), errors: []string{"Invalid Attribute Value Match"}},
+		"missing target":           {config: validate.Values{"monitor_id": "mon_abc123", "type": "email"}, errors: []string{"Missing Attribute Configuration"}},
+		"target and integration":   {config: withValues(email, validate.Values{"integration_id": "pd_abc123"}), errors: []string{"Invalid Attribute Combination"}},
+		"sms":                      {config: sms},
+		"sms invalid number":       {config: withValues(sms, validate.Values{"target": "4155550123"}), errors: []string{"Invalid Phone Number"}},
+		"sender id on email":       {config: withValues(email, validate.Values{"sender_id": "ACKACK"}), errors: []string{"Invalid Attribute Combination"}},
+		"webhook template":         {config: withValues(webhook, validate.Values{"payload_template": `{"monitor": "{{monitor_name}}"}`})},
+		"webhook unknown variable": {config: withValues(webhook, validate.Values{"payload_template": `{"monitor": "{{monitor_title}}"}`}), errors: []string{"Invalid Template Variable"}},
+		"template on email":        {config: withValues(email, validate.Values{"payload_template": `{}`}), errors: []string{"Invalid Attribute Combination"}},
+		"headers on email":         {config: withValues(email, validate.Values{"headers": map[string]any{"X-Token": "abc"}}), errors: []string{"Invalid Attribute Combination"}},
+		"active hours": {config: withValues(email, validate.Values{"active_hours": map[string]any{
+			"days": []string{"mon"}, "start_time": "09:00", "end_time": "17:00", "timezone": "Europe/Berlin",
+		}})},
+		"active hours invalid time zone": {config: withValues(email, validate.Values{"active_hours": map[string]any{
+			"days": []string{"mon"}, "start_time": "09:00", "end_time": "17:00", "timezone": "Europe/Atlantis",
+		}}), errors: []string{"Invalid Time Zone"}},
+		"active hours empty window": {config: withValues(email, validate.Values{"active_hours": map[string]any{
+			"days": []string{"mon"}, "start_time": "09:00", "end_time": "09:00",
+		}}), errors: []string{"Invalid Active Hours"}},
+		"trigger threshold range": {config: withValues(email, validate.Values{"trigger_threshold": 0}), errors: []string{"Invalid Attribute Value"}},
+		"min interval range":      {config: withValues(email, validate.Values{"min_interval_minutes": 1441}), errors: []string{"Invalid Attribute Value"}},
+		"maintenance windows without suppression": {config: withValues(email, validate.Values{
+			"maintenance_window_ids":      []string{"mw_abc123"},
+			"suppress_during_maintenance": false,
+		}), errors: []string{"Invalid Attribute Combination"}},
+	})
+}
+
+func TestBrowserMonitorResource_Validate(t *testing.T) {
+	base := validate.Values{"name": "Checkout", "start_url": "https://shop.example.com"}
+	steps := []any{
+		map[string]any{"action": "click", "selector": "#buy"},
+		map[string]any{"action": "assert_text", "selector": "h1", "value": "Thank you"},
+	}
+
+	runValidateCases(t, "ackack_browser_monitor", map[string]validateCase{
+		"steps":                 {config: withValues(base, validate.Values{"steps": steps})},
+		"recording":             {config: withValues(base, validate.Values{"recording_json": `{"steps": []}`})},
+		"steps and recording":   {config: withValues(base, validate.Values{"steps": steps, "recording_json": `{}`}), errors: []string{"Invalid Attribute Combination"}},
+		"no scenario":           {config: base, errors: []string{"Missing Attribute Configuration"}},
+		"invalid recording":     {config: withValues(base, validate.Values{"recording_json": `{"steps": [`}), errors: []string{"Invalid Recording"}},
+		"invalid action":        {config: withValues(base, validate.Values{"steps": []any{map[string]any{"action": "hover", "selector": "a"}}}), errors: []string{"Invalid Attribute Value Match"}},
+		"missing selector":      {config: withValues(base, validate.Values{"steps": []any{map[string]any{"action": "click"}}}), errors: []string{"Missing Step Selector"}},
+		"missing value":         {config: withValues(base, validate.Values{"steps": []any{map[string]any{"action": "fill", "selector": "#email"}}}), errors: []string{"Missing Step Value"}},
+		"viewport and device":   {config: withValues(base, validate.Values{"steps": steps, "device": "Pixel 7", "viewport": map[string]any{"width": 1280, "height": 720}}), errors: []string{"Invalid Attribute Combination"}},
+		"viewport out of range": {config: withValues(base, validate.Values{"steps": steps, "viewport": map[string]any{"width": 100, "height": 720}}), errors: []string{"Invalid Attribute Value"}},
+	})
+}
+
+func TestMaintenanceWindowResource_Validate(t *testing.T) {
+	base := validate.Values{"name": "Database upgrade", "monitor_ids": []string{"mon_abc123"}}
+
+	runValidateCases(t, "ackack_maintenance_window", map[string]validateCase{
+		"window": {config: withValues(base, validate.Values{"start_time": "2026-01-01T02:00:00Z", "end_time": "2026-01-01T04:00:00Z"})},
+		"end before start": {config: withValues(base, validate.Values{
+			"start_time": "2026-01-01T04:00:00Z", "end_time": "2026-01-01T02:00:00Z",
+		}), errors: []string{"Invalid Maintenance Window"}},
+		"invalid timestamp": {config: withValues(base, validate.Values{
+			"start_time": "tomorrow", "end_time": "2026-01-01T02:00:00Z",
+		}), errors: []string{"Invalid Timestamp"}},
+	})
+}
+
+func TestReportResource_Validate(t *testing.T) {
+	base := validate.Values{
+		"name":        "Monthly uptime",
+		"report_type": "uptime",
+		"format":      "pdf",
+		"start_time":  "2026-01-01T00:00:00Z",
+		"end_time":    "2026-02-01T00:00:00Z",
+	}
+
+	runValidateCases(t, "ackack_report", map[string]validateCase{
+		"report":            {config: base},
+		"invalid format":    {config: withValues(base, validate.Values{"format": "docx"}), errors: []string{"Invalid Attribute Value Match"}},
+		"locale":            {config: withValues(base, validate.Values{"locale": "de-DE"})},
+		"invalid locale":    {config: withValues(base, validate.Values{"locale": "german"}), errors: []string{"Invalid Attribute Value Match"}},
+		"invalid time zone": {config: withValues(base, validate.Values{"timezone": "Berlin"}), errors: []string{"Invalid Time Zone"}},
+	})
+}
+
+func TestMonitorResource_Plan(t *testing.T) {
+	h := newHarness(t)
+	ctx := context.Background()
+
+	created, err := h.Plan(ctx, "ackack_monitor", nil, httpMonitor)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if created.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", created.Diagnostics)
+	}
+
+	for name, want := range map[string]tftypes.Value{
+		"is_enabled":        tftypes.NewValue(tftypes.Bool, true),
+		"frequency_seconds": tftypes.NewValue(tftypes.Number, 60),
+		"timeout_ms":        tftypes.NewValue(tftypes.Number, 10000),
+	} {
+		if got := created.Planned[name]; !got.Equal(want) {
+			t.Errorf("expected default %s = %s, got %s", name, want, got)
+		}
+	}
+	if created.Planned["id"].IsKnown() {
+		t.Errorf("expected id to be unknown on create, got %s", created.Planned["id"])
+	}
+
+	prior := withValues(httpMonitor, validate.Values{
+		"id":                "mon_abc123",
+		"is_enabled":        true,
+		"frequency_seconds": 60,
+		"timeout_ms":        10000,
+		"created_at":        "2026-01-01T00:00:00Z",
+	})
+	updated, err := h.Plan(ctx, "ackack_monitor", prior, withValues(httpMonitor, validate.Values{"name": "Website (prod)"}))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if updated.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", updated.Diagnostics)
+	}
+	if want := tftypes.NewValue(tftypes.String, "mon_abc123"); !updated.Planned["id"].Equal(want) {
+		t.Errorf("expected id to be kept from state, got %s", updated.Planned["id"])
+	}
+	if len(updated.RequiresReplace) != 0 {
+		t.Errorf("expected an in-place update, got replacement for %q", updated.RequiresReplace)
+	}
+}
+
+func TestReportResource_PlanRequiresReplace(t *testing.T) {
+	h := newHarness(t)
+
+	config := validate.Values{
+		"name":        "Monthly uptime",
+		"report_type": "uptime",
+		"format":      "pdf",
+		"start_time":  "2026-01-01T00:00:00Z",
+		"end_time":    "2026-02-01T00:00:00Z",
+	}
+	prior := withValues(config, validate.Values{"id": "rep_abc123", "locale": "en-US", "timezone": "UTC"})
+
+	result, err := h.Plan(context.Background(), "ackack_report", prior, withValues(config, validate.Values{"format": "csv", "locale": "en-US", "timezone": "UTC"}))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if result.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", result.Diagnostics)
+	}
+	if want := []string{tftypes.NewAttributePath().WithAttributeName("format").String()}; !slices.Equal(result.RequiresReplace, want) {
+		t.Errorf("expected replacement for %q, got %q", want, result.RequiresReplace)
+	}
+}
+
+func TestMonitorSetResource_PlanDefaults(t *testing.T) {
+	h := newHarness(t)
+
+	result, err := h.Plan(context.Background(), "ackack_monitor_set", nil, validate.Values{"monitor_ids": []string{"mon_abc123"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if result.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", result.Diagnostics)
+	}
+	if want := tftypes.NewValue(tftypes.Bool, false); !result.Planned["delete_on_destroy"].Equal(want) {
+		t.Errorf("expected delete_on_destroy to default to false, got %s", result.Planned["delete_on_destroy"])
+	}
+}
+
+// TestProviderConfig_NotPersisted checks that the API key and credentials in
+// the endpoint never reach plan, state or private state.
+func TestProviderConfig_NotPersisted(t *testing.T) {
+	const endpointToken = "tok_s3cr3t"
+
+	h := newConfiguredHarness(t, "svc:"+endpointToken, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && r.URL.Path == "/api/v1/monitors" {
+			echoCreate(w, r, "mon_abc123")
+			return
+		}
+		http.NotFound(w, r)
+	})
+
+	result, err := h.Apply(context.Background(), "ackack_monitor", httpMonitor)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if result.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", result.Summaries(tfprotov6.DiagnosticSeverityError))
+	}
+	if result.State == nil {
+		t.Fatal("expected a new state")
+	}
+
+	persisted := map[string]string{
+		"plan":          fmt.Sprint(result.Planned),
+		"state":         fmt.Sprint(result.State),
+		"private state": string(result.Private),
+	}
+	for name, value := range persisted {
+		for _, secret := range []string{testAPIKey, endpointToken} {
+			if strings.Contains(value, secret) {
+				t.Errorf("%s contains %q: %s", name, secret, value)
+			}
+		}
+	}
+}
+
+// TestSystemResource_Thresholds checks that omitted thresholds take the API
+// default without an inconsistent result, and that thresholds removed from
+// config are planned to be reset to it.
+func TestSystemResource_Thresholds(t *testing.T) {
+	var stored map[string]any
+	h := newConfiguredHarness(t, "", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/systems":
+			stored = map[string]any{"degraded_threshold_percent": 25, "down_threshold_percent": 50}
+			_ = json.NewDecoder(r.Body).Decode(&stored)
+			stored["id"] = "sys_abc123"
+			_ = json.NewEncoder(w).Encode(stored)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/systems/sys_abc123":
+			_ = json.NewEncoder(w).Encode(stored)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	ctx := context.Background()
+	system := validate.Values{"name": "Payments"}
+
+	omitted, err := h.Apply(ctx, "ackack_system", system)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if omitted.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", omitted.Summaries(tfprotov6.DiagnosticSeverityError))
+	}
+	if len(omitted.Inconsistent) != 0 {
+		t.Errorf("expected a consistent result, got changes to %q", omitted.Inconsistent)
+	}
+	if want := tftypes.NewValue(tftypes.Number, 25); !omitted.State["degraded_threshold_percent"].Equal(want) {
+		t.Errorf("expected the API default degraded_threshold_percent, got %s", omitted.State["degraded_threshold_percent"])
+	}
+
+	thresholds := validate.Values{"degraded_threshold_percent": 30, "down_threshold_percent": 60}
+	configured, err := h.Apply(ctx, "ackack_system", withValues(system, thresholds))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if configured.HasError() || len(configured.Inconsistent) != 0 {
+		t.Fatalf("unexpected result: %v, inconsistent %q", configured.Summaries(tfprotov6.DiagnosticSeverityError), configured.Inconsistent)
+	}
+
+	prior := withValues(withValues(system, thresholds), validate.Values{"id": "sys_abc123"})
+	for name, tc := range map[string]struct {
+		private []byte
+		reset   bool
+	}{
+		"removed from config": {private: configured.Private, reset: true},
+		"never configured":    {private: omitted.Private},
+	} {
+		t.Run(name, func(t *testing.T) {
+			result, err := h.PlanWithPrivate(ctx, "ackack_system", prior, tc.private, system)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if result.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", result.Diagnostics)
+			}
+			for _, attribute := range []string{"degraded_threshold_percent", "down_threshold_percent"} {
+				if got := result.Planned[attribute]; got.IsKnown() == tc.reset {
+					t.Errorf("expected %s to be reset (%t), got %s", attribute, tc.reset, got)
+				}
+			}
+		})
+	}
+}
+
+func TestSystemResource_PlanMembershipUnknown(t *testing.T) {
+	h := newConfiguredHarness(t, "", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet && r.URL.Path == "/api/v1/monitors" {
+			_ = json.NewEncoder(w).Encode(map[string]any{"monitors": []map[string]any{{"id": "mon_abc123", "name": "Website"}}})
+			return
+		}
+		http.NotFound(w, r)
+	})
+
+	prior := validate.Values{"id": "sys_abc123", "name": "Payments", "monitor_ids": []string{"mon_abc123"}}
+	config := validate.Values{"name": "Payments", "monitor_ids": []any{"mon_abc123", validate.Unknown}}
+
+	result, err := h.Plan(context.Background(), "ackack_system", prior, config)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if result.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", result.Diagnostics)
+	}
+
+	var detail string
+	for _, d := range result.Diagnostics {
+		if d.Summary == "System Membership Change" {
+			detail = d.Detail
+		}
+	}
+	if !strings.Contains(detail, "adds 1 and removes 0 monitors") || !strings.Contains(detail, "1 (known after apply)") {
+		t.Errorf("expected the unknown monitor to be counted as known after apply, got %q", detail)
+	}
+	if strings.Contains(detail, "- \n") || strings.HasSuffix(detail, "- ") {
+		t.Errorf("expected no blank monitor IDs, got %q", detail)
+	}
+}
+
+// TestMonitorResource_EffectiveScheduleUnsupported checks that servers
+// without monitor health are not asked for it and don't cause warnings.
+func TestMonitorResource_EffectiveScheduleUnsupported(t *testing.T) {
+	var healthRequests int
+	h := newConfiguredHarness(t, "", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/monitors":
+			echoCreate(w, r, "mon_abc123")
+		case strings.HasSuffix(r.URL.Path, "/health"):
+			healthRequests++
+			http.NotFound(w, r)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/alerts":
+			_ = json.NewEncoder(w).Encode(map[string]any{"alerts": []any{}})
+		default:
+			http.NotFound(w, r)
+		}
+	})
+
+	result, err := h.Apply(context.Background(), "ackack_monitor", httpMonitor)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if result.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", result.Summaries(tfprotov6.DiagnosticSeverityError))
+	}
+	if healthRequests != 0 {
+		t.Errorf("expected no monitor health requests, got %d", healthRequests)
+	}
+	if warnings := result.Summaries(tfprotov6.DiagnosticSeverityWarning); len(warnings) != 0 {
+		t.Errorf("expected no warnings, got %q", warnings)
+	}
+}
+
+func TestMonitorSetResource_ReadsOneList(t *testing.T) {
+	var requests []string
+	h := newConfiguredHarness(t, "", func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		if r.Method == http.MethodGet && r.URL.Path == "/api/v1/monitors" {
+			_ = json.NewEncoder(w).Encode(map[string]any{"monitors": []map[string]any{
+				{"id": "mon_a", "name": "A", "type": "http"},
+				{"id": "mon_b", "name": "B", "type": "tcp"},
+				{"id": "mon_c", "name": "C", "type": "dns"},
+			}})
+			return
+		}
+		http.NotFound(w, r)
+	})
+
+	result, err := h.Apply(context.Background(), "ackack_monitor_set", validate.Values{"monitor_ids": []string{"mon_a", "mon_b"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if result.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", result.Summaries(tfprotov6.DiagnosticSeverityError))
+	}
+	if want := []string{"GET /api/v1/monitors"}; !slices.Equal(requests, want) {
+		t.Errorf("expected requests %q, got %q", want, requests)
+	}
+	if monitors := result.State["monitors"].String(); !strings.Contains(monitors, "mon_b") || strings.Contains(monitors, "mon_c") {
+		t.Errorf("expected monitors to hold mon_a and mon_b, got %s", monitors)
+	}
+}
+
+func TestAnnotationsDataSource_Validate(t *testing.T) {
+	h := newHarness(t)
+
+	testCases := map[string]struct {
+		config validate.Values
+		errors []string
+		path   string
+	}{
+		"no range":           {config: validate.Values{}},
+		"range":              {config: validate.Values{"since": "2026-01-01T00:00:00Z", "until": "2026-02-01T00:00:00Z"}},
+		"same time":          {config: validate.Values{"since": "2026-01-01T00:00:00Z", "until": "2026-01-01T00:00:00Z"}},
+		"unknown since":      {config: validate.Values{"since": validate.Unknown, "until": "2026-01-01T00:00:00Z"}},
+		"invalid since":      {config: validate.Values{"since": "yesterday"}, errors: []string{"Invalid Timestamp"}, path: "since"},
+		"invalid until":      {config: validate.Values{"until": "2026-02-30"}, errors: []string{"Invalid Timestamp"}, path: "until"},
+		"until before since": {config: validate.Values{"since": "2026-02-01T00:00:00Z", "until": "2026-01-01T00:00:00Z"}, errors: []string{"Invalid Time Range"}, path: "until"},
+		"offset ordered":     {config: validate.Values{"since": "2026-01-01T10:00:00+02:00", "until": "2026-01-01T09:00:00Z"}},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			result, err := h.ValidateDataSource(context.Background(), "ackack_annotations", tc.config)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := result.Summaries(tfprotov6.DiagnosticSeverityError); !slices.Equal(got, tc.errors) {
+				t.Fatalf("expected errors %q, got %q: %v", tc.errors, got, result.Diagnostics)
+			}
+			if tc.path != "" {
+				want := tftypes.NewAttributePath().WithAttributeName(tc.path)
+				if got := result.Diagnostics[0].Attribute; got == nil || !got.Equal(want) {
+					t.Errorf("expected the error on %s, got %v", want, got)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var (
+	_ validator.String = httpURLValidator{}
+	_ validator.String = nameserverValidator{}
+)
+
+// httpURLValidator validates that a string is an absolute http or https URL.
+type httpURLValidator struct{}
+
+// httpURL returns a validator which ensures that a string is an absolute URL
+// with the http or https scheme and a valid host.
+func httpURL() validator.String {
+	return httpURLValidator{}
+}
+
+func (v httpURLValidator) Description(ctx context.Context) string {
+	return "value must be an absolute http or https URL"
+}
+
+func (v httpURLValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v httpURLValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := checkHTTPURL(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid URL",
+			fmt.Sprintf("An absolute http or https URL was expected, got %q: %s", req.ConfigValue.ValueString(), err),
+		)
+	}
+}
+
+func checkHTTPURL(value string) error {
+	u, err := url.Parse(value)
+	if err != nil {
+		return err
+	}
+	if scheme := strings.ToLower(u.Scheme); scheme != "http" && scheme != "https" {
+		return fmt.Errorf("the scheme must be http or https")
+	}
+	if u.Host == "" {
+		return fmt.Errorf("the URL has no host")
+	}
+	if err := checkHostname(u.Hostname()); err != nil {
+		return err
+	}
+	if port := u.Port(); port != "" {
+		return checkPort(port)
+	}
+	return nil
+}
+
+// nameserverValidator validates that a string is a nameserver address.
+type nameserverValidator struct{}
+
+// nameserver returns a validator which ensures that a string is a hostname
+// or IP address, optionally followed by a port, e.g. `1.1.1.1:53` or
+// `[2001:db8::53]:53`.
+func nameserver() validator.String {
+	return nameserverValidator{}
+}
+
+func (v nameserverValidator) Description(ctx context.Context) string {
+	return "value must be a hostname or IP address, optionally with a port"
+}
+
+func (v nameserverValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v nameserverValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := checkNameserver(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Nameserver",
+			fmt.Sprintf("A hostname or IP address, optionally with a port, was expected, got %q: %s", req.ConfigValue.ValueString(), err),
+		)
+	}
+}
+
+func checkNameserver(value string) error {
+	// Bare IPv6 addresses contain colons but no port.
+	if net.ParseIP(value) != nil {
+		return nil
+	}
+
+	host, port, err := net.SplitHostPort(value)
+	if err != nil {
+		return checkHostname(value)
+	}
+	if err := checkHostname(host); err != nil {
+		return err
+	}
+	return checkPort(port)
+}
+
+func checkPort(port string) error {
+	n, err := strconv.Atoi(port)
+	if err != nil || n < 1 || n > 65535 {
+		return fmt.Errorf("the port must be a number between 1 and 65535, got %q", port)
+	}
+	return nil
+}
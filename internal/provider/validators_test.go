@@ -0,0 +1,93 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+)
+
+func TestCheckHTTPURL(t *testing.T) {
+	tests := []struct {
+		value   string
+		wantErr bool
+	}{
+		{"https://example.com", false},
+		{"http://example.com:8080/healthz?full=1", false},
+		{"HTTPS://Bücher.example/", false},
+		{"https://[2001:db8::1]/", false},
+		{"example.com", true},
+		{"ftp://example.com", true},
+		{"https://", true},
+		{"https://exa mple.com", true},
+		{"https://example.com:0/", true},
+		{"https://example.com:99999/", true},
+		{"https://exa*mple.com/", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			err := checkHTTPURL(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error %t, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestCheckNameserver(t *testing.T) {
+	tests := []struct {
+		value   string
+		wantErr bool
+	}{
+		{"1.1.1.1", false},
+		{"1.1.1.1:53", false},
+		{"2001:db8::53", false},
+		{"[2001:db8::53]:5353", false},
+		{"ns1.example.com", false},
+		{"ns1.example.com.:53", false},
+		{"", true},
+		{"ns1..example.com", true},
+		{"1.1.1.1:0", true},
+		{"ns1.example.com:dns", true},
+		{"udp://1.1.1.1", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			err := checkNameserver(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error %t, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestCheckHostname(t *testing.T) {
+	tests := []struct {
+		value   string
+		wantErr bool
+	}{
+		{"example.com", false},
+		{"example.com.", false},
+		{"Bücher.example", false},
+		{"internal_host.corp", false},
+		{"192.0.2.1", false},
+		{"2001:db8::1", false},
+		{"", true},
+		{"foo bar", true},
+		{"a..b", true},
+		{"-a.com", true},
+		{"example.com:443", true},
+		{"http://example.com", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			err := checkHostname(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error %t, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
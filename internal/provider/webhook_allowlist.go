@@ -0,0 +1,61 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/ackack-io/terraform-provider-ackack/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// webhookAlertTypes are the alert types whose target is a URL that receives
+// alert data.
+var webhookAlertTypes = []client.AlertType{client.AlertTypeWebhook, client.AlertTypeSlack, client.AlertTypeDiscord}
+
+// checkWebhookAllowlist adds an error to diags when target is a webhook URL
+// whose host is not covered by allowedDomains. An empty allowlist allows
+// every host; unknown values are checked once they are known.
+func checkWebhookAllowlist(allowedDomains []string, alertType, target types.String, p path.Path, diags *diag.Diagnostics) {
+	if len(allowedDomains) == 0 || alertType.IsUnknown() || target.IsNull() || target.IsUnknown() {
+		return
+	}
+	if !slices.Contains(webhookAlertTypes, client.AlertType(alertType.ValueString())) {
+		return
+	}
+
+	u, err := url.Parse(target.ValueString())
+	if err != nil || u.Hostname() == "" {
+		diags.AddAttributeError(p, "Webhook Not Allowed",
+			fmt.Sprintf("The %s target is not an absolute URL, so it cannot be checked against the provider's allowed_webhook_domains.", alertType.ValueString()))
+		return
+	}
+
+	host, err := toASCIIHostname(u.Hostname())
+	if err != nil || !webhookHostAllowed(host, allowedDomains) {
+		diags.AddAttributeError(p, "Webhook Not Allowed",
+			fmt.Sprintf("The %s target host %q is not in the provider's allowed_webhook_domains (%s).",
+				alertType.ValueString(), u.Hostname(), strings.Join(allowedDomains, ", ")))
+	}
+}
+
+// webhookHostAllowed reports whether host equals one of domains or is a
+// subdomain of one. host must already be in lowercase ASCII form.
+func webhookHostAllowed(host string, domains []string) bool {
+	for _, domain := range domains {
+		domain, err := toASCIIHostname(domain)
+		if err != nil || domain == "" {
+			continue
+		}
+		if host == domain || strings.HasSuffix(host, "."+domain) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,63 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestWebhookHostAllowed(t *testing.T) {
+	domains := []string{"hooks.slack.com", "Example.COM.", "bücher.example"}
+
+	tests := []struct {
+		host     string
+		expected bool
+	}{
+		{"hooks.slack.com", true},
+		{"example.com", true},
+		{"alerts.example.com", true},
+		{"xn--bcher-kva.example", true},
+		{"evilexample.com", false},
+		{"example.com.evil.net", false},
+		{"slack.com", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.host, func(t *testing.T) {
+			if got := webhookHostAllowed(tt.host, domains); got != tt.expected {
+				t.Errorf("expected %t, got %t", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestCheckWebhookAllowlist(t *testing.T) {
+	domains := []string{"example.com"}
+
+	tests := []struct {
+		alertType string
+		target    string
+		allowed   bool
+	}{
+		{"webhook", "https://hooks.example.com/alert", true},
+		{"slack", "https://HOOKS.Example.com/services/x", true},
+		{"discord", "https://discord.com/api/webhooks/1", false},
+		{"webhook", "not a url", false},
+		{"email", "ops@elsewhere.net", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.alertType+"/"+tt.target, func(t *testing.T) {
+			var diags diag.Diagnostics
+			checkWebhookAllowlist(domains, types.StringValue(tt.alertType), types.StringValue(tt.target), path.Root("target"), &diags)
+			if diags.HasError() == tt.allowed {
+				t.Errorf("expected allowed=%t, got diagnostics: %v", tt.allowed, diags)
+			}
+		})
+	}
+}
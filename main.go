@@ -7,9 +7,11 @@ import (
 	"context"
 	"flag"
 	"log"
+	"os"
 
 	"github.com/ackack-io/terraform-provider-ackack/internal/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
 )
 
 var (
@@ -22,6 +24,10 @@ var (
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "migrate-check" {
+		os.Exit(migrateCheck(os.Args[2:], os.Stdout, os.Stderr))
+	}
+
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
@@ -37,6 +43,14 @@ func main() {
 
 	err := providerserver.Serve(context.Background(), provider.New(version), opts)
 
+	// Terraform reads the provider's original stderr until it exits, so the
+	// summary still reaches its logs after the server has stopped.
+	provider.LogRequestSummary(tfsdklog.NewRootProviderLogger(context.Background(),
+		tfsdklog.WithLogName("ackack"),
+		tfsdklog.WithStderrFromInit(),
+		tfsdklog.WithoutLocation(),
+	))
+
 	if err != nil {
 		log.Fatal(err.Error())
 	}
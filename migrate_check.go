@@ -0,0 +1,74 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/ackack-io/terraform-provider-ackack/internal/provider"
+	"github.com/ackack-io/terraform-provider-ackack/internal/provider/statecompat"
+)
+
+// migrateCheck checks that the ackack resources in a Terraform state file
+// can be read by this provider version, without contacting the API:
+//
+//	terraform state pull | terraform-provider-ackack migrate-check -
+//
+// It returns the process exit code.
+func migrateCheck(args []string, stdout, stderr io.Writer) int {
+	path := "terraform.tfstate"
+	if len(args) > 0 {
+		path = args[0]
+	}
+
+	var stateJSON []byte
+	var err error
+	if path == "-" {
+		stateJSON, err = io.ReadAll(os.Stdin)
+	} else {
+		stateJSON, err = os.ReadFile(path)
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "Error: unable to read state: %s\n", err)
+		return 1
+	}
+
+	ctx := context.Background()
+	checker, err := statecompat.New(ctx, provider.New(version)())
+	if err != nil {
+		fmt.Fprintf(stderr, "Error: unable to load provider schemas: %s\n", err)
+		return 1
+	}
+
+	results, err := checker.CheckState(ctx, stateJSON)
+	if err != nil {
+		fmt.Fprintf(stderr, "Error: %s\n", err)
+		return 1
+	}
+
+	failed := 0
+	for _, result := range results {
+		status := "ok"
+		if result.HasError() {
+			status = "error"
+			failed++
+		}
+		fmt.Fprintf(stdout, "%-5s %s (schema version %d)\n", status, result.Address, result.SchemaVersion)
+		for _, d := range result.Diagnostics {
+			fmt.Fprintf(stdout, "      %s: %s\n", d.Summary, d.Detail)
+		}
+		for _, attribute := range result.Dropped {
+			fmt.Fprintf(stdout, "      %s is no longer supported and will be removed from state\n", attribute)
+		}
+	}
+
+	fmt.Fprintf(stdout, "\n%d resource instances checked with provider version %s, %d cannot be upgraded.\n", len(results), version, failed)
+	if failed > 0 {
+		return 1
+	}
+	return 0
+}